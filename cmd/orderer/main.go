@@ -5,13 +5,27 @@ import (
 
 	"github.com/ddr4869/minifab/common/logger"
 	"github.com/ddr4869/minifab/orderer/server"
+	"github.com/spf13/cobra"
 )
 
+// trace --trace 지정 시 로그 레벨을 trace로 낮춤
+var trace bool
+
+func enableTrace() {
+	if trace {
+		if err := logger.SetLevel(logger.TraceLevel); err != nil {
+			logger.Errorf("Failed to enable trace logging: %v", err)
+		}
+	}
+}
+
 func init() {
 	// Initialize logger with development config for CLI
 	if err := logger.InitializeDevelopment(); err != nil {
 		panic("Failed to initialize logger: " + err.Error())
 	}
+	server.RootCmd.PersistentFlags().BoolVar(&trace, "trace", false, "Enable trace level logging")
+	cobra.OnInitialize(enableTrace)
 
 }
 
@@ -5,6 +5,9 @@ import (
 
 	"github.com/ddr4869/minifab/common/logger"
 	"github.com/ddr4869/minifab/peer/channel"
+	"github.com/ddr4869/minifab/peer/identity"
+	"github.com/ddr4869/minifab/peer/ledger"
+	"github.com/ddr4869/minifab/peer/server"
 	"github.com/spf13/cobra"
 )
 
@@ -16,13 +19,29 @@ var rootCmd = &cobra.Command{
 체인코드를 실행하는 peer 노드입니다.`,
 }
 
+// trace --trace 지정 시 로그 레벨을 trace로 낮춤
+var trace bool
+
+func enableTrace() {
+	if trace {
+		if err := logger.SetLevel(logger.TraceLevel); err != nil {
+			logger.Errorf("Failed to enable trace logging: %v", err)
+		}
+	}
+}
+
 func init() {
 	// Initialize logger with development config for CLI
 	if err := logger.InitializeDevelopment(); err != nil {
 		panic("Failed to initialize logger: " + err.Error())
 	}
 	logger.Infof("logger initialized, log level: %s", logger.GetLogger().Level())
+	rootCmd.PersistentFlags().BoolVar(&trace, "trace", false, "Enable trace level logging")
+	cobra.OnInitialize(enableTrace)
 	rootCmd.AddCommand(channel.Cmd())
+	rootCmd.AddCommand(server.Cmd())
+	rootCmd.AddCommand(ledger.Cmd())
+	rootCmd.AddCommand(identity.Cmd())
 
 }
 
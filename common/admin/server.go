@@ -0,0 +1,72 @@
+package admin
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/ddr4869/minifab/common/logger"
+	"github.com/pkg/errors"
+)
+
+const (
+	// LogLevelPath 로그 레벨 조회(GET)/변경(PUT) endpoint
+	LogLevelPath = "/admin/loglevel"
+	// MetricsPath Prometheus metric endpoint
+	MetricsPath = "/metrics"
+
+	shutdownTimeout = 5 * time.Second
+)
+
+// Server orderer/peer 노드 운영을 위한 admin HTTP 서버
+type Server struct {
+	mux    *http.ServeMux
+	server *http.Server
+}
+
+// NewServer 기본 admin endpoint가 등록된 admin HTTP 서버 생성
+func NewServer(address string) *Server {
+	mux := http.NewServeMux()
+	mux.Handle(LogLevelPath, logger.LevelHandler())
+
+	return &Server{
+		mux: mux,
+		server: &http.Server{
+			Addr:              address,
+			Handler:           mux,
+			ReadHeaderTimeout: 5 * time.Second,
+		},
+	}
+}
+
+// Handle admin endpoint 추가 등록
+func (s *Server) Handle(pattern string, handler http.Handler) {
+	s.mux.Handle(pattern, handler)
+}
+
+// Start admin 서버를 백그라운드로 실행
+func (s *Server) Start() error {
+	lis, err := net.Listen("tcp", s.server.Addr)
+	if err != nil {
+		return errors.Wrapf(err, "failed to listen on admin address %s", s.server.Addr)
+	}
+
+	logger.Infof("Admin server listening on %s", s.server.Addr)
+	go func() {
+		if err := s.server.Serve(lis); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			logger.Errorf("Admin server error: %v", err)
+		}
+	}()
+	return nil
+}
+
+// Stop 진행 중인 요청을 마친 뒤 admin 서버 종료
+func (s *Server) Stop() {
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+
+	if err := s.server.Shutdown(ctx); err != nil {
+		logger.Errorf("Failed to stop admin server: %v", err)
+	}
+}
@@ -0,0 +1,37 @@
+package admin
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/ddr4869/minifab/common/logger"
+)
+
+func TestServerLogLevelEndpoint(t *testing.T) {
+	previous := logger.GetLevel()
+	t.Cleanup(func() { logger.SetLevel(previous) })
+	if err := logger.SetLevel(logger.InfoLevel); err != nil {
+		t.Fatal(err)
+	}
+
+	srv := httptest.NewServer(NewServer("").server.Handler)
+	defer srv.Close()
+
+	req, err := http.NewRequest(http.MethodPut, srv.URL+LogLevelPath, strings.NewReader(`{"level":"debug"}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("PUT %s: %v", LogLevelPath, err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("PUT %s status = %d", LogLevelPath, resp.StatusCode)
+	}
+	if logger.GetLevel() != logger.DebugLevel {
+		t.Errorf("log level = %s, want %s", logger.GetLevel(), logger.DebugLevel)
+	}
+}
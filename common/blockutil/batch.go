@@ -0,0 +1,66 @@
+package blockutil
+
+import (
+	"encoding/binary"
+	"math"
+
+	pb_common "github.com/ddr4869/minifab/proto/common"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+)
+
+// batchLengthPrefixSize 각 블록 앞에 붙는 big-endian 길이 prefix 크기
+const batchLengthPrefixSize = 4
+
+// BatchMarshal 블록들을 [4바이트 big-endian 길이][블록 protobuf] 형식으로 이어 붙여 직렬화
+// 출력은 이어 붙일 수 있으므로 여러 BatchMarshal 결과를 합친 데이터도 BatchUnmarshal로 읽을 수 있다.
+func BatchMarshal(blocks []*pb_common.Block) ([]byte, error) {
+	size := 0
+	for i, block := range blocks {
+		if block == nil {
+			return nil, errors.Errorf("block %d is nil", i)
+		}
+		size += batchLengthPrefixSize + proto.Size(block)
+	}
+
+	buf := make([]byte, 0, size)
+	for i, block := range blocks {
+		start := len(buf)
+		buf = append(buf, make([]byte, batchLengthPrefixSize)...)
+		var err error
+		buf, err = proto.MarshalOptions{}.MarshalAppend(buf, block)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to marshal block %d", i)
+		}
+		blockSize := len(buf) - start - batchLengthPrefixSize
+		if uint64(blockSize) > math.MaxUint32 {
+			return nil, errors.Errorf("block %d is too large to frame (%d bytes)", i, blockSize)
+		}
+		binary.BigEndian.PutUint32(buf[start:], uint32(blockSize))
+	}
+	return buf, nil
+}
+
+// BatchUnmarshal BatchMarshal 형식의 데이터에서 블록들을 순서대로 읽어 반환
+func BatchUnmarshal(data []byte) ([]*pb_common.Block, error) {
+	var blocks []*pb_common.Block
+	for offset := 0; offset < len(data); {
+		if len(data)-offset < batchLengthPrefixSize {
+			return nil, errors.Errorf("truncated length prefix at offset %d", offset)
+		}
+		blockSize := int(binary.BigEndian.Uint32(data[offset:]))
+		offset += batchLengthPrefixSize
+		if blockSize > len(data)-offset {
+			return nil, errors.Errorf("truncated block %d at offset %d: need %d bytes, have %d",
+				len(blocks), offset, blockSize, len(data)-offset)
+		}
+
+		block, err := UnmarshalBlockFromProto(data[offset : offset+blockSize])
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to unmarshal block %d", len(blocks))
+		}
+		blocks = append(blocks, block)
+		offset += blockSize
+	}
+	return blocks, nil
+}
@@ -0,0 +1,95 @@
+package blockutil
+
+import (
+	"fmt"
+	"testing"
+
+	pb_common "github.com/ddr4869/minifab/proto/common"
+	"google.golang.org/protobuf/proto"
+)
+
+// newBatchTestBlocks 10번째마다 트랜잭션이 없는 블록을 포함한 count개 블록
+func newBatchTestBlocks(count int) []*pb_common.Block {
+	blocks := make([]*pb_common.Block, 0, count)
+	var previousHash []byte
+	for number := 0; number < count; number++ {
+		var txs [][]byte
+		if number%10 != 0 {
+			for i := 0; i < number%4+1; i++ {
+				txs = append(txs, []byte(fmt.Sprintf("block-%d-tx-%d", number, i)))
+			}
+		}
+		block := &pb_common.Block{
+			Header: &pb_common.BlockHeader{
+				Number:       uint64(number),
+				PreviousHash: previousHash,
+				DataHash:     CalculateDataHash(txs),
+				HeaderType:   pb_common.BlockType_BLOCK_TYPE_DATA,
+			},
+			Data:     &pb_common.BlockData{Transactions: txs},
+			Metadata: &pb_common.BlockMetadata{},
+		}
+		previousHash = CalculateBlockHash(block)
+		blocks = append(blocks, block)
+	}
+	return blocks
+}
+
+func TestBatchMarshalRoundTrip(t *testing.T) {
+	blocks := newBatchTestBlocks(100)
+
+	// 두 BatchMarshal 결과를 이어 붙여도 모든 블록을 읽을 수 있어야 한다
+	first, err := BatchMarshal(blocks[:37])
+	if err != nil {
+		t.Fatalf("BatchMarshal: %v", err)
+	}
+	second, err := BatchMarshal(blocks[37:])
+	if err != nil {
+		t.Fatalf("BatchMarshal: %v", err)
+	}
+	got, err := BatchUnmarshal(append(first, second...))
+	if err != nil {
+		t.Fatalf("BatchUnmarshal: %v", err)
+	}
+	if len(got) != len(blocks) {
+		t.Fatalf("BatchUnmarshal returned %d blocks, want %d", len(got), len(blocks))
+	}
+	for i := range blocks {
+		if !proto.Equal(got[i], blocks[i]) {
+			t.Fatalf("block %d does not match after round trip", i)
+		}
+	}
+	if len(got[0].Data.GetTransactions()) != 0 || len(got[10].Data.GetTransactions()) != 0 {
+		t.Error("zero-transaction blocks gained transactions in the round trip")
+	}
+}
+
+func TestBatchUnmarshalErrors(t *testing.T) {
+	data, err := BatchMarshal(newBatchTestBlocks(3))
+	if err != nil {
+		t.Fatalf("BatchMarshal: %v", err)
+	}
+
+	tests := []struct {
+		name string
+		data []byte
+	}{
+		{"truncated length prefix", data[:2]},
+		{"truncated block", data[:len(data)-1]},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := BatchUnmarshal(tt.data); err == nil {
+				t.Error("BatchUnmarshal succeeded, want error")
+			}
+		})
+	}
+
+	blocks, err := BatchUnmarshal(nil)
+	if err != nil || len(blocks) != 0 {
+		t.Errorf("BatchUnmarshal(nil) = %d blocks, %v, want none", len(blocks), err)
+	}
+	if _, err := BatchMarshal([]*pb_common.Block{nil}); err == nil {
+		t.Error("BatchMarshal accepted a nil block")
+	}
+}
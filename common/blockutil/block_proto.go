@@ -1,8 +1,14 @@
 package blockutil
 
 import (
+	"crypto/rand"
+	"crypto/sha256"
+	"fmt"
+	"time"
+
 	"github.com/ddr4869/minifab/common/msp"
 	pb_common "github.com/ddr4869/minifab/proto/common"
+	"github.com/pkg/errors"
 )
 
 func CreateEnvelope(payload *pb_common.Payload, signature []byte) (*pb_common.Envelope, error) {
@@ -39,3 +45,42 @@ func CreateHeader(signer msp.SigningIdentity, messageType pb_common.MessageType,
 	}
 	return header, nil
 }
+
+// CreateTransaction signer로 서명된 Transaction 생성 (서명 대상: payload의 SHA256 해시)
+func CreateTransaction(signer msp.SigningIdentity, data []byte) (*pb_common.Transaction, error) {
+	return CreateChaincodeTransaction(signer, "", data)
+}
+
+// CreateChaincodeTransaction chaincodeID 체인코드의 트랜잭션으로 표시된 Transaction 생성
+// ChaincodeId도 TxId 해시에 포함된다.
+func CreateChaincodeTransaction(signer msp.SigningIdentity, chaincodeID string, data []byte) (*pb_common.Transaction, error) {
+	tx := &pb_common.Transaction{
+		ChaincodeId: chaincodeID,
+		Payload:     data,
+		Identity: &pb_common.Identity{
+			Creator: signer.GetCertificate().Raw,
+			MspId:   signer.GetIdentifier().Mspid,
+		},
+		Timestamp: time.Now().Unix(),
+		Type:      pb_common.MessageType_MESSAGE_TYPE_TRANSACTION,
+	}
+	txID, err := CalculateTxHash(tx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to calculate transaction hash")
+	}
+	tx.TxId = txID
+
+	payloadHash := sha256.Sum256(data)
+	signature, err := signer.Sign(rand.Reader, payloadHash[:], nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to sign transaction")
+	}
+	tx.Signature = signature
+
+	return tx, nil
+}
+
+// BlockReceiptMessage peer가 블록 수신 확인(ack)을 위해 서명하는 메시지
+func BlockReceiptMessage(channelID string, blockNumber uint64, peerID string) []byte {
+	return []byte(fmt.Sprintf("%s:%d:%s", channelID, blockNumber, peerID))
+}
@@ -31,6 +31,7 @@ func GenerateConfigBlock(channelConfig []byte, channelName string, signer msp.Si
 		Payload:   channelConfig,
 		Identity:  identity,
 		Timestamp: time.Now().Unix(),
+		Type:      pb_common.MessageType_MESSAGE_TYPE_CONFIG,
 	}
 	txID, err := CalculateTxHash(tx)
 	if err != nil {
@@ -55,6 +56,7 @@ func GenerateConfigBlock(channelConfig []byte, channelName string, signer msp.Si
 		Number:       0,
 		PreviousHash: nil,
 		HeaderType:   pb_common.BlockType_BLOCK_TYPE_CONFIG,
+		DataHash:     CalculateDataHash([][]byte{protoTx}),
 	}
 	blockData := &pb_common.BlockData{
 		Transactions: [][]byte{protoTx},
@@ -65,7 +67,7 @@ func GenerateConfigBlock(channelConfig []byte, channelName string, signer msp.Si
 			MspId:   signer.GetIdentifier().Mspid,
 		},
 		ValidationBitmap: []byte{1}, // TODO
-		AccumulatedHash:  []byte{},  // TODO
+		AccumulatedHash:  ComputeAccumulatedHash(nil, header.DataHash),
 	}
 	block := &pb_common.Block{
 		Header:   header,
@@ -77,6 +79,69 @@ func GenerateConfigBlock(channelConfig []byte, channelName string, signer msp.Si
 	return block, nil
 }
 
+// GenerateConfigUpdateBlock 채널 생성 이후의 설정 변경을 담은 설정 블록을 number 번호로 생성
+// AccumulatedHash는 이전 블록을 아는 호출자가 채운다.
+func GenerateConfigUpdateBlock(number uint64, previousHash []byte, channelConfig []byte, channelName string, signer msp.SigningIdentity) (*pb_common.Block, error) {
+	block, err := GenerateConfigBlock(channelConfig, channelName, signer)
+	if err != nil {
+		return nil, err
+	}
+	SetBlockPosition(block, number, previousHash)
+	return block, nil
+}
+
+// GenerateDataBlock 정렬된 트랜잭션들을 protobuf로 직렬화해 channelID 채널의 일반 트랜잭션 블록 생성
+// GenerateConfigBlock과 같이 블록 번호 0으로 생성하므로, 체인에 붙이는 호출자가 SetBlockPosition으로
+// 번호와 PreviousHash를 지정하고 AccumulatedHash를 채운다.
+func GenerateDataBlock(txs []*pb_common.Transaction, channelID string, signer msp.SigningIdentity) (*pb_common.Block, error) {
+	if len(txs) == 0 {
+		return nil, errors.Errorf("data block for channel %s must contain at least one transaction", channelID)
+	}
+	if signer == nil {
+		return nil, errors.New("signer cannot be nil")
+	}
+
+	transactions := make([][]byte, 0, len(txs))
+	for i, tx := range txs {
+		if tx == nil {
+			return nil, errors.Errorf("transaction %d for channel %s is nil", i, channelID)
+		}
+		protoTx, err := MarshalTransactionToProto(tx)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to marshal transaction %s", tx.TxId)
+		}
+		transactions = append(transactions, protoTx)
+	}
+	header := &pb_common.BlockHeader{
+		Number:       0,
+		PreviousHash: nil,
+		HeaderType:   pb_common.BlockType_BLOCK_TYPE_DATA,
+		DataHash:     CalculateDataHash(transactions),
+	}
+	block := &pb_common.Block{
+		Header: header,
+		Data: &pb_common.BlockData{
+			Transactions: transactions,
+		},
+		Metadata: &pb_common.BlockMetadata{
+			Identity: &pb_common.Identity{
+				Creator: signer.GetCertificate().Raw,
+				MspId:   signer.GetIdentifier().Mspid,
+			},
+		},
+	}
+	header.CurrentBlockHash = CalculateBlockHash(block)
+
+	return block, nil
+}
+
+// SetBlockPosition 블록 번호와 PreviousHash를 지정하고 CurrentBlockHash를 다시 계산
+func SetBlockPosition(block *pb_common.Block, number uint64, previousHash []byte) {
+	block.Header.Number = number
+	block.Header.PreviousHash = previousHash
+	block.Header.CurrentBlockHash = CalculateBlockHash(block)
+}
+
 func GetBlockDataFromEnvelope(envelope *pb_common.Envelope) (*pb_common.Block, error) {
 	payload, err := UnmarshalPayloadFromProto(envelope.Payload)
 	if err != nil {
@@ -127,14 +192,7 @@ func SaveBlockFile(blockProto *pb_common.Block, channelName string, FilesystemPa
 		return errors.Wrapf(err, "failed to create directory: %s", channelDir)
 	}
 
-	blockNumber := 0
-	for {
-		blockFile := fmt.Sprintf("%s/blockfile%d", channelDir, blockNumber)
-		if _, err := os.Stat(blockFile); os.IsNotExist(err) {
-			break
-		}
-		blockNumber++
-	}
+	blockNumber := GetBlockHeight(channelName, FilesystemPath)
 
 	blockData, err := MarshalBlockToProto(blockProto)
 	if err != nil {
@@ -147,3 +205,18 @@ func SaveBlockFile(blockProto *pb_common.Block, channelName string, FilesystemPa
 	logger.Infof("✅ Block %d saved successfully at %s", blockNumber, blockFilePath)
 	return nil
 }
+
+// GetBlockHeight 채널 폴더에 연속으로 저장된 블록 파일 개수 (다음에 저장될 블록 번호)
+func GetBlockHeight(channelName string, FilesystemPath string) uint64 {
+	channelDir := fmt.Sprintf("%s/%s", FilesystemPath, channelName)
+
+	var blockNumber uint64
+	for {
+		blockFile := fmt.Sprintf("%s/blockfile%d", channelDir, blockNumber)
+		if _, err := os.Stat(blockFile); os.IsNotExist(err) {
+			break
+		}
+		blockNumber++
+	}
+	return blockNumber
+}
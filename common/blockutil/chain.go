@@ -0,0 +1,42 @@
+package blockutil
+
+import (
+	"bytes"
+
+	pb_common "github.com/ddr4869/minifab/proto/common"
+	"github.com/pkg/errors"
+)
+
+// VerifyBlockChain 블록 0부터 순서대로 나열된 블록들의 연결을 검증
+// 블록 번호, CurrentBlockHash와 PreviousHash 연결(CalculateBlockHash), 트랜잭션에 대한 DataHash, 누적 해시(AccumulatedHash)를 차례로 확인한다.
+func VerifyBlockChain(blocks []*pb_common.Block) error {
+	var previous *pb_common.Block
+	for i, block := range blocks {
+		if block == nil || block.Header == nil {
+			return errors.Errorf("block %d has no header", i)
+		}
+		if block.Header.Number != uint64(i) {
+			return errors.Errorf("block %d has number %d", i, block.Header.Number)
+		}
+		if !bytes.Equal(block.Header.CurrentBlockHash, CalculateBlockHash(block)) {
+			return errors.Errorf("block %d current hash does not match its header", i)
+		}
+		if previous != nil && !bytes.Equal(block.Header.PreviousHash, CalculateBlockHash(previous)) {
+			return errors.Errorf("block %d previous hash does not match block %d", i, i-1)
+		}
+		if dataHash := CalculateDataHash(block.Data.GetTransactions()); !bytes.Equal(block.Header.DataHash, dataHash) {
+			return errors.Errorf("block %d data hash is %x, expected %x", i, block.Header.DataHash, dataHash)
+		}
+
+		var prevAccumulated []byte
+		if previous != nil {
+			prevAccumulated = previous.Metadata.GetAccumulatedHash()
+		}
+		expected := ComputeAccumulatedHash(prevAccumulated, block.Header.DataHash)
+		if accumulated := block.Metadata.GetAccumulatedHash(); !bytes.Equal(accumulated, expected) {
+			return errors.Errorf("block %d accumulated hash is %x, expected %x", i, accumulated, expected)
+		}
+		previous = block
+	}
+	return nil
+}
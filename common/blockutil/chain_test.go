@@ -0,0 +1,86 @@
+package blockutil
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"strings"
+	"testing"
+
+	"github.com/ddr4869/minifab/common/msp/msptest"
+)
+
+func TestCalculateBlockHashKeepsChainHashesFixedSize(t *testing.T) {
+	org := msptest.NewOrg(t, "OrdererMSP")
+	blocks := buildTestChain(t, org.SigningIdentity(), 20)
+
+	for _, block := range blocks {
+		if got := len(block.Header.CurrentBlockHash); got != sha256.Size {
+			t.Fatalf("block %d current hash is %d bytes, want %d", block.Header.Number, got, sha256.Size)
+		}
+		if block.Header.Number > 0 && len(block.Header.PreviousHash) != sha256.Size {
+			t.Fatalf("block %d previous hash is %d bytes, want %d", block.Header.Number, len(block.Header.PreviousHash), sha256.Size)
+		}
+	}
+	if err := VerifyBlockChain(blocks); err != nil {
+		t.Fatalf("VerifyBlockChain: %v", err)
+	}
+}
+
+func TestCalculateBlockHashCoversHeader(t *testing.T) {
+	org := msptest.NewOrg(t, "OrdererMSP")
+	block := buildTestChain(t, org.SigningIdentity(), 2)[1]
+	original := CalculateBlockHash(block)
+
+	block.Header.DataHash = append([]byte{}, block.Header.DataHash...)
+	block.Header.DataHash[0] ^= 0xff
+	if bytes.Equal(CalculateBlockHash(block), original) {
+		t.Fatal("block hash did not change with DataHash")
+	}
+	if CalculateBlockHash(nil) != nil {
+		t.Fatal("expected nil hash for nil block")
+	}
+}
+
+func TestVerifyBlockChainDetectsBrokenLink(t *testing.T) {
+	org := msptest.NewOrg(t, "OrdererMSP")
+	blocks := buildTestChain(t, org.SigningIdentity(), 5)
+
+	blocks[3].Header.PreviousHash = make([]byte, sha256.Size)
+	blocks[3].Header.CurrentBlockHash = CalculateBlockHash(blocks[3])
+	if err := VerifyBlockChain(blocks); err == nil {
+		t.Fatal("expected broken previous hash link to be rejected")
+	}
+}
+
+func TestAccumulatedHashChain(t *testing.T) {
+	org := msptest.NewOrg(t, "OrdererMSP")
+	blocks := buildTestChain(t, org.SigningIdentity(), 10)
+
+	// 제네시스 블록은 빈 누적 해시에서 시작한다
+	want := sha256.Sum256(blocks[0].Header.DataHash)
+	if !bytes.Equal(blocks[0].Metadata.AccumulatedHash, want[:]) {
+		t.Fatalf("genesis accumulated hash = %x, want SHA256(DataHash) %x", blocks[0].Metadata.AccumulatedHash, want)
+	}
+	for i := 1; i < len(blocks); i++ {
+		want := sha256.Sum256(append(append([]byte{}, blocks[i-1].Metadata.AccumulatedHash...), blocks[i].Header.DataHash...))
+		if !bytes.Equal(blocks[i].Metadata.AccumulatedHash, want[:]) {
+			t.Fatalf("block %d accumulated hash = %x, want %x", i, blocks[i].Metadata.AccumulatedHash, want)
+		}
+	}
+	if err := VerifyBlockChain(blocks); err != nil {
+		t.Fatalf("VerifyBlockChain: %v", err)
+	}
+
+	// 트랜잭션을 바꾸면 DataHash 검증이 실패한다
+	blocks[5].Data.Transactions[0] = []byte("tampered")
+	if err := VerifyBlockChain(blocks); err == nil || !strings.Contains(err.Error(), "block 5 data hash") {
+		t.Fatalf("VerifyBlockChain after tampering = %v, want block 5 data hash error", err)
+	}
+
+	// DataHash와 헤더 해시까지 다시 계산해도 누적 해시 검증이 실패한다
+	blocks[5].Header.DataHash = CalculateDataHash(blocks[5].Data.Transactions)
+	blocks[5].Header.CurrentBlockHash = CalculateBlockHash(blocks[5])
+	if err := VerifyBlockChain(blocks); err == nil || !strings.Contains(err.Error(), "block 5 accumulated hash") {
+		t.Fatalf("VerifyBlockChain after recomputing DataHash = %v, want block 5 accumulated hash error", err)
+	}
+}
@@ -13,16 +13,49 @@ import (
 )
 
 func LoadSystemChannelConfig(blockPath string) (*configtx.SystemChannelInfo, error) {
-	Block, err := LoadBlock(blockPath)
+	genesisBlock, err := ReadGenesisBlock(blockPath)
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to load block")
+		return nil, err
 	}
 
-	if Block.Header.HeaderType != pb_common.BlockType_BLOCK_TYPE_CONFIG {
-		return nil, errors.New("block is not a config block")
+	return ExtractSystemChannelConfigFromGenesisBlock(genesisBlock)
+}
+
+// ReadGenesisBlock 제네시스 블록 파일을 읽고 제네시스 블록 형식인지 검증
+// 제네시스 블록은 별도 wrapper 없이 0번 설정 블록(pb_common.Block)으로 저장된다.
+func ReadGenesisBlock(path string) (*pb_common.Block, error) {
+	block, err := LoadBlock(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load genesis block")
+	}
+	if err := validateGenesisBlock(block); err != nil {
+		return nil, errors.Wrapf(err, "invalid genesis block %s", path)
 	}
+	return block, nil
+}
 
-	return ExtractSystemChannelConfigFromBlock(Block)
+// ExtractSystemChannelConfigFromGenesisBlock 제네시스 블록에서 시스템 채널 설정 추출
+func ExtractSystemChannelConfigFromGenesisBlock(block *pb_common.Block) (*configtx.SystemChannelInfo, error) {
+	if err := validateGenesisBlock(block); err != nil {
+		return nil, errors.Wrap(err, "invalid genesis block")
+	}
+	return ExtractSystemChannelConfigFromBlock(block)
+}
+
+func validateGenesisBlock(block *pb_common.Block) error {
+	if block == nil || block.Header == nil {
+		return errors.New("block header is empty")
+	}
+	if block.Header.Number != 0 {
+		return errors.Errorf("block number is %d, expected 0", block.Header.Number)
+	}
+	if block.Header.HeaderType != pb_common.BlockType_BLOCK_TYPE_CONFIG {
+		return errors.New("block is not a config block")
+	}
+	if GetBlockTransactionCount(block) == 0 {
+		return errors.New("no transactions found in block")
+	}
+	return nil
 }
 
 func LoadAppChannelConfigs(filesystemPath string) (map[string]*configtx.ChannelConfig, error) {
@@ -66,10 +99,15 @@ func LoadChannelConfigDataFromBlock(blockPath string) (*configtx.ChannelConfig,
 		return nil, errors.Wrap(err, "failed to load block")
 	}
 
+	return ExtractChannelConfigFromBlock(block)
+}
+
+// ExtractChannelConfigFromBlock orderer가 생성한 채널 설정 블록에서 ChannelConfig 추출
+func ExtractChannelConfigFromBlock(block *pb_common.Block) (*configtx.ChannelConfig, error) {
 	if block.Header.HeaderType != pb_common.BlockType_BLOCK_TYPE_CONFIG {
 		return nil, errors.New("block is not a config block")
 	}
-	if len(block.Data.Transactions) == 0 {
+	if GetBlockTransactionCount(block) == 0 {
 		return nil, errors.New("no transactions found in block")
 	}
 
@@ -90,7 +128,7 @@ func LoadChannelConfigDataFromBlock(blockPath string) (*configtx.ChannelConfig,
 }
 
 func ExtractSystemChannelConfigFromBlock(block *pb_common.Block) (*configtx.SystemChannelInfo, error) {
-	if len(block.Data.Transactions) == 0 {
+	if GetBlockTransactionCount(block) == 0 {
 		return nil, errors.New("no transactions found in block")
 	}
 
@@ -109,7 +147,7 @@ func ExtractSystemChannelConfigFromBlock(block *pb_common.Block) (*configtx.Syst
 }
 
 func ExtractAppChannelConfigFromBlock(block *pb_common.Block) (*configtx.AppChannelConfig, error) {
-	if len(block.Data.Transactions) == 0 {
+	if GetBlockTransactionCount(block) == 0 {
 		return nil, errors.New("no transactions found in block")
 	}
 
@@ -127,15 +165,30 @@ func ExtractAppChannelConfigFromBlock(block *pb_common.Block) (*configtx.AppChan
 	return &systemChannelInfo, nil
 }
 
+// GetConfigTxFromBlock 블록의 첫 번째 설정 트랜잭션 반환
+// 트랜잭션 Type이 도입되기 전의 설정 블록은 트랜잭션 Type이 UNSPECIFIED(0)이므로,
+// 헤더 타입이 CONFIG인 블록에서는 UNSPECIFIED 트랜잭션도 설정 트랜잭션으로 본다.
+// 다른 Type이 지정된 트랜잭션은 CONFIG 블록에 있어도 설정 트랜잭션이 아니다.
 func GetConfigTxFromBlock(block *pb_common.Block) (*pb_common.Transaction, error) {
-	if len(block.Data.Transactions) == 0 {
-		return nil, errors.New("no transactions found in block")
+	legacyConfigBlock := block.GetHeader().GetHeaderType() == pb_common.BlockType_BLOCK_TYPE_CONFIG
+	configBlock := FilterTransactions(block, func(tx *pb_common.Transaction) bool {
+		if isConfigTx(tx) {
+			return true
+		}
+		return legacyConfigBlock && tx.Type == pb_common.MessageType_MESSAGE_TYPE_UNSPECIFIED
+	})
+	if GetBlockTransactionCount(configBlock) == 0 {
+		return nil, errors.New("no config transactions found in block")
 	}
 
-	tx, err := UnmarshalTransactionFromProto(block.Data.Transactions[0])
+	tx, err := UnmarshalTransactionFromProto(configBlock.Data.Transactions[0])
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to unmarshal transaction from block")
 	}
 
 	return tx, nil
 }
+
+func isConfigTx(tx *pb_common.Transaction) bool {
+	return tx.Type == pb_common.MessageType_MESSAGE_TYPE_CONFIG
+}
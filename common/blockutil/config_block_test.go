@@ -0,0 +1,103 @@
+package blockutil
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ddr4869/minifab/common/configtx"
+	"github.com/ddr4869/minifab/common/msp/msptest"
+	pb_common "github.com/ddr4869/minifab/proto/common"
+)
+
+// writeTestBlock block을 dir의 파일로 저장하고 경로 반환
+func writeTestBlock(t *testing.T, dir string, block *pb_common.Block) string {
+	t.Helper()
+
+	data, err := MarshalBlockToProto(block)
+	if err != nil {
+		t.Fatalf("MarshalBlockToProto: %v", err)
+	}
+	path := filepath.Join(dir, "genesis.block")
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestLoadSystemChannelConfig(t *testing.T) {
+	org := msptest.NewOrg(t, "OrdererMSP")
+	scc := &configtx.SystemChannelInfo{
+		Orderer: configtx.SystemChannelConfig{
+			BatchTimeout: "2s",
+			Organization: configtx.Organization{Name: "OrdererOrg", ID: "OrdererMSP"},
+		},
+		Consortiums: []configtx.Organization{{Name: "Org1", ID: "Org1MSP"}},
+	}
+	sccBytes, err := json.Marshal(scc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	genesis, err := GenerateConfigBlock(sccBytes, "system-channel", org.SigningIdentity())
+	if err != nil {
+		t.Fatalf("GenerateConfigBlock: %v", err)
+	}
+	path := writeTestBlock(t, t.TempDir(), genesis)
+
+	block, err := ReadGenesisBlock(path)
+	if err != nil {
+		t.Fatalf("ReadGenesisBlock: %v", err)
+	}
+	if string(block.Header.CurrentBlockHash) != string(genesis.Header.CurrentBlockHash) {
+		t.Error("ReadGenesisBlock returned a different block")
+	}
+
+	info, err := LoadSystemChannelConfig(path)
+	if err != nil {
+		t.Fatalf("LoadSystemChannelConfig: %v", err)
+	}
+	if info.Orderer.Organization.ID != "OrdererMSP" || info.Orderer.BatchTimeout != "2s" {
+		t.Errorf("orderer config = %+v", info.Orderer)
+	}
+	if len(info.Consortiums) != 1 || info.Consortiums[0].ID != "Org1MSP" {
+		t.Errorf("Consortiums = %+v", info.Consortiums)
+	}
+}
+
+func TestReadGenesisBlockRejectsNonGenesisBlock(t *testing.T) {
+	signer := msptest.NewOrg(t, "OrdererMSP").SigningIdentity()
+	chain := buildTestChain(t, signer, 2)
+
+	dataType := buildTestChain(t, signer, 1)[0]
+	dataType.Header.HeaderType = pb_common.BlockType_BLOCK_TYPE_DATA
+
+	empty := buildTestChain(t, signer, 1)[0]
+	empty.Data.Transactions = nil
+
+	tests := []struct {
+		name    string
+		block   *pb_common.Block
+		wantErr string
+	}{
+		{"data block", chain[1], "block number is 1, expected 0"},
+		{"block 0 with data type", dataType, "not a config block"},
+		{"block 0 without transactions", empty, "no transactions found"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := writeTestBlock(t, t.TempDir(), tt.block)
+			if _, err := ReadGenesisBlock(path); err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("ReadGenesisBlock error = %v, want it to contain %q", err, tt.wantErr)
+			}
+			if _, err := ExtractSystemChannelConfigFromGenesisBlock(tt.block); err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("ExtractSystemChannelConfigFromGenesisBlock error = %v, want it to contain %q", err, tt.wantErr)
+			}
+		})
+	}
+
+	if _, err := ReadGenesisBlock(filepath.Join(t.TempDir(), "missing.block")); err == nil {
+		t.Error("ReadGenesisBlock succeeded for a missing file")
+	}
+}
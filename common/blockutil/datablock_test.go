@@ -0,0 +1,80 @@
+package blockutil
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/ddr4869/minifab/common/msp/msptest"
+	pb_common "github.com/ddr4869/minifab/proto/common"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestGenerateDataBlock(t *testing.T) {
+	org := msptest.NewOrg(t, "OrdererMSP")
+	var txs []*pb_common.Transaction
+	for i := 0; i < 5; i++ {
+		txs = append(txs, newTestTransaction(t, i))
+	}
+
+	block, err := GenerateDataBlock(txs, "testchannel", org.SigningIdentity())
+	if err != nil {
+		t.Fatalf("GenerateDataBlock: %v", err)
+	}
+	if block.Header.HeaderType != pb_common.BlockType_BLOCK_TYPE_DATA {
+		t.Errorf("HeaderType = %s, want BLOCK_TYPE_DATA", block.Header.HeaderType)
+	}
+	if !bytes.Equal(block.Header.CurrentBlockHash, CalculateBlockHash(block)) {
+		t.Error("CurrentBlockHash does not match the generated block")
+	}
+	if len(block.Data.Transactions) != len(txs) {
+		t.Fatalf("block has %d transactions, want %d", len(block.Data.Transactions), len(txs))
+	}
+	for i, data := range block.Data.Transactions {
+		tx, err := UnmarshalTransactionFromProto(data)
+		if err != nil {
+			t.Fatalf("UnmarshalTransactionFromProto(%d): %v", i, err)
+		}
+		if !proto.Equal(tx, txs[i]) {
+			t.Errorf("transaction %d = %v, want %v", i, tx, txs[i])
+		}
+	}
+}
+
+func TestGenerateDataBlockRejectsInvalidInput(t *testing.T) {
+	signer := msptest.NewOrg(t, "OrdererMSP").SigningIdentity()
+	tests := []struct {
+		name string
+		txs  []*pb_common.Transaction
+	}{
+		{"empty batch", nil},
+		{"nil transaction", []*pb_common.Transaction{newTestTransaction(t, 0), nil}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := GenerateDataBlock(tt.txs, "testchannel", signer); err == nil {
+				t.Fatal("GenerateDataBlock succeeded, want error")
+			}
+		})
+	}
+
+	if _, err := GenerateDataBlock([]*pb_common.Transaction{newTestTransaction(t, 0)}, "testchannel", nil); err == nil {
+		t.Error("GenerateDataBlock accepted a nil signer")
+	}
+}
+
+func TestSetBlockPosition(t *testing.T) {
+	org := msptest.NewOrg(t, "OrdererMSP")
+	block, err := GenerateDataBlock([]*pb_common.Transaction{newTestTransaction(t, 0)}, "testchannel", org.SigningIdentity())
+	if err != nil {
+		t.Fatalf("GenerateDataBlock: %v", err)
+	}
+	previousHash := bytes.Repeat([]byte{0x01}, 32)
+
+	SetBlockPosition(block, 3, previousHash)
+	if block.Header.Number != 3 || !bytes.Equal(block.Header.PreviousHash, previousHash) {
+		t.Errorf("header number %d previous hash %x, want 3 and %x", block.Header.Number, block.Header.PreviousHash, previousHash)
+	}
+	if !bytes.Equal(block.Header.CurrentBlockHash, CalculateBlockHash(block)) {
+		t.Error("CurrentBlockHash was not recalculated")
+	}
+}
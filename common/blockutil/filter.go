@@ -0,0 +1,42 @@
+package blockutil
+
+import (
+	"github.com/ddr4869/minifab/common/logger"
+	pb_common "github.com/ddr4869/minifab/proto/common"
+	"google.golang.org/protobuf/proto"
+)
+
+// FilterTransactions pred가 true를 반환하는 트랜잭션만 담은 새 블록 반환
+// 원본 블록은 수정하지 않으며, 새 블록의 DataHash는 남은 트랜잭션으로 다시 계산된다.
+// 역직렬화할 수 없는 트랜잭션은 결과에서 제외된다.
+func FilterTransactions(block *pb_common.Block, pred func(*pb_common.Transaction) bool) *pb_common.Block {
+	filtered := &pb_common.Block{
+		Header:   &pb_common.BlockHeader{},
+		Data:     &pb_common.BlockData{},
+		Metadata: &pb_common.BlockMetadata{},
+	}
+	if block == nil {
+		return filtered
+	}
+	if block.Header != nil {
+		filtered.Header = proto.Clone(block.Header).(*pb_common.BlockHeader)
+	}
+	if block.Metadata != nil {
+		filtered.Metadata = proto.Clone(block.Metadata).(*pb_common.BlockMetadata)
+	}
+
+	for i, txBytes := range block.GetData().GetTransactions() {
+		tx, err := UnmarshalTransactionFromProto(txBytes)
+		if err != nil {
+			logger.Warnf("Skipping transaction %d while filtering block: %v", i, err)
+			continue
+		}
+		if pred(tx) {
+			filtered.Data.Transactions = append(filtered.Data.Transactions, txBytes)
+		}
+	}
+
+	filtered.Header.DataHash = CalculateDataHash(filtered.Data.Transactions)
+	filtered.Header.CurrentBlockHash = CalculateBlockHash(filtered)
+	return filtered
+}
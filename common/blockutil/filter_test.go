@@ -0,0 +1,140 @@
+package blockutil
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/ddr4869/minifab/common/msp/msptest"
+	pb_common "github.com/ddr4869/minifab/proto/common"
+)
+
+func marshalTestTransactions(t *testing.T, txs []*pb_common.Transaction) [][]byte {
+	t.Helper()
+
+	txBytes := make([][]byte, len(txs))
+	for i, tx := range txs {
+		data, err := MarshalTransactionToProto(tx)
+		if err != nil {
+			t.Fatalf("MarshalTransactionToProto: %v", err)
+		}
+		txBytes[i] = data
+	}
+	return txBytes
+}
+
+func TestFilterTransactionsKeepsConfigTransactions(t *testing.T) {
+	org := msptest.NewOrg(t, "OrdererMSP")
+	txs := make([]*pb_common.Transaction, 5)
+	for i := range txs {
+		txs[i] = newTestTransaction(t, i)
+	}
+	txs[1].Type = pb_common.MessageType_MESSAGE_TYPE_CONFIG
+	txs[3].Type = pb_common.MessageType_MESSAGE_TYPE_CONFIG
+	txBytes := marshalTestTransactions(t, txs)
+	block, err := GenerateDataBlock(txs, "testchannel", org.SigningIdentity())
+	if err != nil {
+		t.Fatalf("GenerateDataBlock: %v", err)
+	}
+	SetBlockPosition(block, 7, bytes.Repeat([]byte{1}, 32))
+
+	filtered := FilterTransactions(block, isConfigTx)
+	if got := GetBlockTransactionCount(filtered); got != 2 {
+		t.Fatalf("filtered block has %d transactions, want 2", got)
+	}
+	if !bytes.Equal(filtered.Data.Transactions[0], txBytes[1]) || !bytes.Equal(filtered.Data.Transactions[1], txBytes[3]) {
+		t.Fatal("filtered block does not hold config transactions 1 and 3 in order")
+	}
+	if !bytes.Equal(filtered.Header.DataHash, CalculateDataHash([][]byte{txBytes[1], txBytes[3]})) {
+		t.Fatal("filtered block data hash was not recomputed")
+	}
+	if filtered.Header.Number != 7 {
+		t.Fatalf("filtered block number = %d, want 7", filtered.Header.Number)
+	}
+	if GetBlockTransactionCount(block) != 5 {
+		t.Fatal("FilterTransactions modified the source block")
+	}
+
+	configTx, err := GetConfigTxFromBlock(block)
+	if err != nil {
+		t.Fatalf("GetConfigTxFromBlock: %v", err)
+	}
+	if configTx.TxId != txs[1].TxId {
+		t.Fatalf("GetConfigTxFromBlock returned %s, want %s", configTx.TxId, txs[1].TxId)
+	}
+}
+
+func TestGetConfigTxFromLegacyBlock(t *testing.T) {
+	org := msptest.NewOrg(t, "OrdererMSP")
+	block, err := GenerateConfigBlock([]byte(`{"legacy":true}`), "testchannel", org.SigningIdentity())
+	if err != nil {
+		t.Fatalf("GenerateConfigBlock: %v", err)
+	}
+
+	// 트랜잭션 Type이 도입되기 전에 기록된 설정 블록은 Type이 UNSPECIFIED(0)이다
+	tx, err := UnmarshalTransactionFromProto(block.Data.Transactions[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	tx.Type = pb_common.MessageType_MESSAGE_TYPE_UNSPECIFIED
+	block.Data.Transactions = marshalTestTransactions(t, []*pb_common.Transaction{tx})
+
+	configTx, err := GetConfigTxFromBlock(block)
+	if err != nil {
+		t.Fatalf("GetConfigTxFromBlock on legacy block: %v", err)
+	}
+	if string(configTx.Payload) != `{"legacy":true}` {
+		t.Fatalf("legacy config payload = %q", configTx.Payload)
+	}
+
+	// 데이터 블록의 UNSPECIFIED 트랜잭션은 설정으로 보지 않는다
+	dataBlock, err := GenerateDataBlock([]*pb_common.Transaction{tx}, "testchannel", org.SigningIdentity())
+	if err != nil {
+		t.Fatalf("GenerateDataBlock: %v", err)
+	}
+	if _, err := GetConfigTxFromBlock(dataBlock); err == nil {
+		t.Fatal("UNSPECIFIED transaction in a data block was treated as config")
+	}
+}
+
+func TestGetConfigTxFromBlockRejectsTypedNonConfigTx(t *testing.T) {
+	org := msptest.NewOrg(t, "OrdererMSP")
+	block, err := GenerateConfigBlock([]byte(`{"legacy":true}`), "testchannel", org.SigningIdentity())
+	if err != nil {
+		t.Fatalf("GenerateConfigBlock: %v", err)
+	}
+
+	// CONFIG 헤더 블록이라도 TRANSACTION으로 지정된 단일 트랜잭션은 설정이 아니다
+	tx, err := UnmarshalTransactionFromProto(block.Data.Transactions[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	tx.Type = pb_common.MessageType_MESSAGE_TYPE_TRANSACTION
+	block.Data.Transactions = marshalTestTransactions(t, []*pb_common.Transaction{tx})
+
+	if _, err := GetConfigTxFromBlock(block); err == nil {
+		t.Fatal("typed non-config transaction in a CONFIG block was treated as config")
+	}
+}
+
+func TestFilterTransactionsOnMultiTransactionBlock(t *testing.T) {
+	block, txs := newMultiTxTestBlock(t, 6)
+
+	keep := map[string]bool{txs[0].TxId: true, txs[2].TxId: true, txs[5].TxId: true}
+	filtered := FilterTransactions(block, func(tx *pb_common.Transaction) bool { return keep[tx.TxId] })
+
+	want := [][]byte{block.Data.Transactions[0], block.Data.Transactions[2], block.Data.Transactions[5]}
+	if len(filtered.Data.Transactions) != len(want) {
+		t.Fatalf("filtered block has %d transactions, want %d", len(filtered.Data.Transactions), len(want))
+	}
+	for i := range want {
+		if !bytes.Equal(filtered.Data.Transactions[i], want[i]) {
+			t.Errorf("filtered transaction %d differs from source transaction", i)
+		}
+	}
+	if !bytes.Equal(filtered.Header.DataHash, CalculateDataHash(want)) {
+		t.Error("filtered DataHash was not recomputed")
+	}
+	if GetBlockTransactionCount(block) != 6 {
+		t.Error("FilterTransactions modified the source block")
+	}
+}
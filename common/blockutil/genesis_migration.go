@@ -0,0 +1,65 @@
+package blockutil
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/ddr4869/minifab/common/configtx"
+	"github.com/ddr4869/minifab/common/logger"
+	pb_common "github.com/ddr4869/minifab/proto/common"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+)
+
+// LegacyGenesisJSONPath protobuf 제네시스 블록 경로에 대응하는 예전 JSON 제네시스 파일 경로 (확장자를 .json으로 교체)
+func LegacyGenesisJSONPath(blockPath string) string {
+	return strings.TrimSuffix(blockPath, filepath.Ext(blockPath)) + ".json"
+}
+
+// LoadSystemChannelConfigWithMigration blockPath의 제네시스 블록에서 시스템 채널 설정 로드
+// blockPath가 없고 예전 JSON 제네시스 파일만 있으면 먼저 protobuf로 변환해 blockPath에 기록한다.
+func LoadSystemChannelConfigWithMigration(blockPath string) (*configtx.SystemChannelInfo, error) {
+	if _, err := os.Stat(blockPath); os.IsNotExist(err) {
+		if err := MigrateGenesisBlockFromJSON(blockPath); err != nil {
+			return nil, err
+		}
+	}
+	return LoadSystemChannelConfig(blockPath)
+}
+
+// MigrateGenesisBlockFromJSON LegacyGenesisJSONPath의 JSON 제네시스 블록을 protobuf로 직렬화해 blockPath에 기록
+// 임시 파일에 기록한 뒤 rename하므로 중간에 실패해도 불완전한 blockPath가 남지 않는다.
+func MigrateGenesisBlockFromJSON(blockPath string) error {
+	jsonPath := LegacyGenesisJSONPath(blockPath)
+	if jsonPath == blockPath {
+		return errors.Errorf("genesis block path %s has no protobuf extension to migrate to", blockPath)
+	}
+	data, err := os.ReadFile(jsonPath)
+	if err != nil {
+		return errors.Wrapf(err, "genesis block %s not found and failed to read legacy JSON genesis", blockPath)
+	}
+
+	block := &pb_common.Block{}
+	if err := json.Unmarshal(data, block); err != nil {
+		return errors.Wrapf(err, "failed to decode legacy JSON genesis %s", jsonPath)
+	}
+	if err := validateGenesisBlock(block); err != nil {
+		return errors.Wrapf(err, "invalid legacy JSON genesis %s", jsonPath)
+	}
+	protoData, err := proto.Marshal(block)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal genesis block")
+	}
+
+	tmpPath := blockPath + ".tmp"
+	if err := os.WriteFile(tmpPath, protoData, 0644); err != nil {
+		return errors.Wrapf(err, "failed to write genesis block %s", blockPath)
+	}
+	if err := os.Rename(tmpPath, blockPath); err != nil {
+		return errors.Wrapf(err, "failed to commit genesis block %s", blockPath)
+	}
+	logger.Infof("✅ Migrated legacy JSON genesis %s to %s", jsonPath, blockPath)
+	return nil
+}
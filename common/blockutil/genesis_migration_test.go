@@ -0,0 +1,97 @@
+package blockutil
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ddr4869/minifab/common/configtx"
+	"github.com/ddr4869/minifab/common/msp/msptest"
+	pb_common "github.com/ddr4869/minifab/proto/common"
+	"google.golang.org/protobuf/proto"
+)
+
+// writeLegacyGenesisJSON 예전 generateGenesisBlock처럼 block을 encoding/json으로 dir/genesis.json에 저장
+func writeLegacyGenesisJSON(t *testing.T, dir string, block *pb_common.Block) {
+	t.Helper()
+
+	data, err := json.Marshal(block)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "genesis.json"), data, 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestLoadSystemChannelConfigWithMigration(t *testing.T) {
+	org := msptest.NewOrg(t, "OrdererMSP")
+	sccBytes, err := json.Marshal(&configtx.SystemChannelInfo{
+		Orderer:     configtx.SystemChannelConfig{Organization: configtx.Organization{Name: "OrdererOrg", ID: "OrdererMSP"}},
+		Consortiums: []configtx.Organization{{Name: "Org1", ID: "Org1MSP"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	genesis, err := GenerateConfigBlock(sccBytes, "system-channel", org.SigningIdentity())
+	if err != nil {
+		t.Fatalf("GenerateConfigBlock: %v", err)
+	}
+	if err := SignBlock(genesis, org.SigningIdentity()); err != nil {
+		t.Fatalf("SignBlock: %v", err)
+	}
+
+	dir := t.TempDir()
+	writeLegacyGenesisJSON(t, dir, genesis)
+	blockPath := filepath.Join(dir, "genesis.block")
+
+	info, err := LoadSystemChannelConfigWithMigration(blockPath)
+	if err != nil {
+		t.Fatalf("LoadSystemChannelConfigWithMigration: %v", err)
+	}
+	if len(info.Consortiums) != 1 || info.Consortiums[0].ID != "Org1MSP" {
+		t.Errorf("Consortiums = %+v, want Org1MSP", info.Consortiums)
+	}
+
+	migrated, err := ReadGenesisBlock(blockPath)
+	if err != nil {
+		t.Fatalf("ReadGenesisBlock of migrated block: %v", err)
+	}
+	if !proto.Equal(migrated, genesis) {
+		t.Error("migrated genesis block differs from the legacy JSON block")
+	}
+	if err := VerifyBlockSignature(migrated, org.MSP); err != nil {
+		t.Errorf("VerifyBlockSignature of migrated block: %v", err)
+	}
+	if _, err := os.Stat(blockPath + ".tmp"); !os.IsNotExist(err) {
+		t.Errorf("temporary migration file was left behind: %v", err)
+	}
+
+	// 이미 protobuf 블록이 있으면 JSON 없이도 그대로 읽는다
+	if err := os.Remove(filepath.Join(dir, "genesis.json")); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := LoadSystemChannelConfigWithMigration(blockPath); err != nil {
+		t.Errorf("LoadSystemChannelConfigWithMigration of existing block: %v", err)
+	}
+}
+
+func TestLoadSystemChannelConfigWithMigrationRejectsInvalidLegacyGenesis(t *testing.T) {
+	dir := t.TempDir()
+	blockPath := filepath.Join(dir, "genesis.block")
+
+	if _, err := LoadSystemChannelConfigWithMigration(blockPath); err == nil || !strings.Contains(err.Error(), "genesis.json") {
+		t.Errorf("migration without any genesis file error = %v, want the missing JSON file named", err)
+	}
+
+	signer := msptest.NewOrg(t, "OrdererMSP").SigningIdentity()
+	writeLegacyGenesisJSON(t, dir, buildTestChain(t, signer, 2)[1])
+	if _, err := LoadSystemChannelConfigWithMigration(blockPath); err == nil {
+		t.Error("migrated a legacy JSON block that is not a genesis block")
+	}
+	if _, err := os.Stat(blockPath); !os.IsNotExist(err) {
+		t.Errorf("genesis block was written for an invalid legacy genesis: %v", err)
+	}
+}
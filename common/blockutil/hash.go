@@ -18,11 +18,41 @@ func CalculateTxHash(tx *pb_common.Transaction) (string, error) {
 	return hex.EncodeToString(hash[:]), nil
 }
 
-// TODO : 블록 해시 계산 로직 추가
+// CalculateDataHash 블록에 담긴 트랜잭션 바이트들을 순서대로 이어 붙인 SHA256 해시
+func CalculateDataHash(transactions [][]byte) []byte {
+	hash := sha256.New()
+	for _, tx := range transactions {
+		hash.Write(tx)
+	}
+	return hash.Sum(nil)
+}
+
+// ComputeAccumulatedHash SHA256(prevAccumulated || currentDataHash)
+// 이전 블록까지의 누적 해시에 현재 블록의 DataHash를 이어 감사 경로를 만든다. (첫 블록은 prevAccumulated가 비어 있음)
+func ComputeAccumulatedHash(prevAccumulated, currentDataHash []byte) []byte {
+	hash := sha256.New()
+	hash.Write(prevAccumulated)
+	hash.Write(currentDataHash)
+	return hash.Sum(nil)
+}
+
+// CalculateBlockHash 블록 헤더 해시 SHA256(Number(8바이트 big-endian) || PreviousHash || DataHash)
+// DataHash가 트랜잭션을 모두 포함하므로 헤더 해시로 블록 전체 내용이 고정된다.
+// 다음 블록의 PreviousHash, CurrentBlockHash, LoadBlockByHash, HashBlock 모두 이 값을 사용한다.
+// 이전에는 PreviousHash 뒤에 SHA256("")를 덧붙인 값을 사용했으므로, 그 방식으로 기록된 원장은
+// VerifyBlockChain을 통과하지 못하며 다시 생성해야 한다.
 func CalculateBlockHash(block *pb_common.Block) []byte {
-	if block == nil {
+	if block.GetHeader() == nil {
 		return nil
 	}
-	hash := sha256.New()
-	return hash.Sum(block.Header.PreviousHash)
+	hash := sha256.Sum256(blockSignedData(block.Header))
+	return hash[:]
+}
+
+// HashBlock 로그/출력용 블록 해시 CalculateBlockHash의 64자 소문자 hex (헤더가 없으면 빈 문자열)
+func HashBlock(block *pb_common.Block) string {
+	if block.GetHeader() == nil {
+		return ""
+	}
+	return hex.EncodeToString(CalculateBlockHash(block))
 }
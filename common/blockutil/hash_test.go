@@ -0,0 +1,46 @@
+package blockutil
+
+import (
+	"encoding/hex"
+	"regexp"
+	"testing"
+
+	"github.com/ddr4869/minifab/common/msp/msptest"
+	pb_common "github.com/ddr4869/minifab/proto/common"
+	"google.golang.org/protobuf/proto"
+)
+
+var lowerHex64 = regexp.MustCompile(`^[0-9a-f]{64}$`)
+
+func TestHashBlockIsHexOfChainHash(t *testing.T) {
+	org := msptest.NewOrg(t, "OrdererMSP")
+	blocks := buildTestChain(t, org.SigningIdentity(), 3)
+
+	for _, block := range blocks {
+		got := HashBlock(block)
+		if !lowerHex64.MatchString(got) {
+			t.Fatalf("HashBlock(block %d) = %q, want 64 lowercase hex characters", block.Header.Number, got)
+		}
+		if got != hex.EncodeToString(CalculateBlockHash(block)) {
+			t.Fatalf("HashBlock(block %d) is not the hex form of CalculateBlockHash", block.Header.Number)
+		}
+	}
+	// 다음 블록의 PreviousHash와 같은 값이어야 로그의 해시로 체인을 따라갈 수 있다
+	if HashBlock(blocks[1]) != hex.EncodeToString(blocks[2].Header.PreviousHash) {
+		t.Fatal("HashBlock differs from the hash chained into the next block")
+	}
+}
+
+func TestHashBlockChangesWithBlockNumber(t *testing.T) {
+	org := msptest.NewOrg(t, "OrdererMSP")
+	block := buildTestChain(t, org.SigningIdentity(), 2)[1]
+
+	renumbered := proto.Clone(block).(*pb_common.Block)
+	renumbered.Header.Number++
+	if HashBlock(block) == HashBlock(renumbered) {
+		t.Fatal("HashBlock did not change with the block number")
+	}
+	if got := HashBlock(&pb_common.Block{}); got != "" {
+		t.Fatalf("HashBlock without header = %q, want empty", got)
+	}
+}
@@ -0,0 +1,80 @@
+package blockutil
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/ddr4869/minifab/common/msp"
+	pb_common "github.com/ddr4869/minifab/proto/common"
+)
+
+// newTestTransaction payload가 "payload-<i>"인 TRANSACTION 타입 트랜잭션
+func newTestTransaction(t testing.TB, i int) *pb_common.Transaction {
+	t.Helper()
+
+	tx := &pb_common.Transaction{
+		Payload:   []byte(fmt.Sprintf("payload-%d", i)),
+		Identity:  &pb_common.Identity{MspId: "Org1MSP", Creator: []byte("creator")},
+		Signature: []byte("signature"),
+		Timestamp: time.Now().Unix(),
+		Type:      pb_common.MessageType_MESSAGE_TYPE_TRANSACTION,
+	}
+	txID, err := CalculateTxHash(tx)
+	if err != nil {
+		t.Fatalf("CalculateTxHash: %v", err)
+	}
+	tx.TxId = txID
+	return tx
+}
+
+// buildTestChain 설정 블록 0과 트랜잭션 하나씩을 담은 데이터 블록으로 이루어진 n개 블록 체인
+func buildTestChain(t *testing.T, signer msp.SigningIdentity, n int) []*pb_common.Block {
+	t.Helper()
+
+	genesis, err := GenerateConfigBlock([]byte(`{}`), "testchannel", signer)
+	if err != nil {
+		t.Fatalf("GenerateConfigBlock: %v", err)
+	}
+	blocks := []*pb_common.Block{genesis}
+	for number := 1; number < n; number++ {
+		previous := blocks[number-1]
+		block, err := GenerateDataBlock([]*pb_common.Transaction{newTestTransaction(t, number)}, "testchannel", signer)
+		if err != nil {
+			t.Fatalf("GenerateDataBlock: %v", err)
+		}
+		SetBlockPosition(block, uint64(number), CalculateBlockHash(previous))
+		block.Metadata.AccumulatedHash = ComputeAccumulatedHash(previous.Metadata.GetAccumulatedHash(), block.Header.DataHash)
+		blocks = append(blocks, block)
+	}
+	return blocks
+}
+
+// newMultiTxTestBlock 트랜잭션 n개를 가진 서명 없는 3번 데이터 블록
+func newMultiTxTestBlock(t *testing.T, n int) (*pb_common.Block, []*pb_common.Transaction) {
+	t.Helper()
+
+	txs := make([]*pb_common.Transaction, n)
+	txBytes := make([][]byte, n)
+	for i := range txs {
+		txs[i] = newTestTransaction(t, i)
+		data, err := MarshalTransactionToProto(txs[i])
+		if err != nil {
+			t.Fatalf("MarshalTransactionToProto: %v", err)
+		}
+		txBytes[i] = data
+	}
+	block := &pb_common.Block{
+		Header: &pb_common.BlockHeader{
+			Number:       3,
+			PreviousHash: bytes.Repeat([]byte{0x02}, 32),
+			HeaderType:   pb_common.BlockType_BLOCK_TYPE_DATA,
+			DataHash:     CalculateDataHash(txBytes),
+		},
+		Data:     &pb_common.BlockData{Transactions: txBytes},
+		Metadata: &pb_common.BlockMetadata{},
+	}
+	block.Header.CurrentBlockHash = CalculateBlockHash(block)
+	return block, txs
+}
@@ -0,0 +1,81 @@
+package blockutil
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	pb_common "github.com/ddr4869/minifab/proto/common"
+	"github.com/pkg/errors"
+)
+
+// BlockIndexFileName 비순차 파일 이름을 쓰는 채널 폴더에서 블록 파일 이름을 블록 번호 순서대로 기록하는 파일
+const BlockIndexFileName = "blockindex"
+
+// ErrBlockNotFound 조건에 맞는 블록이 채널 폴더에 없음
+var ErrBlockNotFound = errors.New("block not found")
+
+// PaddedBlockFileName 블록 번호를 20자리(uint64 최대 자릿수)로 0을 채운 파일 이름 (peer 순차 naming)
+// 파일 이름의 사전순이 블록 번호 순서와 같다.
+func PaddedBlockFileName(blockNumber uint64) string {
+	return fmt.Sprintf("blockfile%020d", blockNumber)
+}
+
+// LegacyBlockFileName 0을 채우지 않은 blockfileN 형식의 파일 이름 (orderer 원장과 이전 peer 원장)
+func LegacyBlockFileName(blockNumber uint64) string {
+	return fmt.Sprintf("blockfile%d", blockNumber)
+}
+
+// LoadBlockByHash 채널 폴더에서 CalculateBlockHash 값이 blockHash인 첫 번째 블록 반환
+// blockHash는 다음 블록의 PreviousHash, 또는 로그에 찍힌 HashBlock 값을 hex 디코딩한 값과 같다.
+// 블록 파일을 번호 순서대로 읽으며, BlockIndexFileName 파일이 있으면 그 순서를, 없으면 blockfileN(또는 0을 채운 이름) 순서를 따른다.
+func LoadBlockByHash(channelPath string, blockHash []byte) (*pb_common.Block, error) {
+	if len(blockHash) == 0 {
+		return nil, errors.New("block hash cannot be empty")
+	}
+
+	blockFiles, err := listBlockFiles(channelPath)
+	if err != nil {
+		return nil, err
+	}
+	for _, blockFile := range blockFiles {
+		block, err := LoadBlock(blockFile)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to load block file %s", blockFile)
+		}
+		if bytes.Equal(CalculateBlockHash(block), blockHash) {
+			return block, nil
+		}
+	}
+	return nil, errors.Wrapf(ErrBlockNotFound, "no block with hash %x in %s", blockHash, channelPath)
+}
+
+// listBlockFiles 채널 폴더의 블록 파일 경로를 블록 번호 순서대로 반환
+func listBlockFiles(channelPath string) ([]string, error) {
+	data, err := os.ReadFile(filepath.Join(channelPath, BlockIndexFileName))
+	if err == nil {
+		fileNames := strings.Fields(string(data))
+		blockFiles := make([]string, 0, len(fileNames))
+		for _, fileName := range fileNames {
+			blockFiles = append(blockFiles, filepath.Join(channelPath, fileName))
+		}
+		return blockFiles, nil
+	}
+	if !os.IsNotExist(err) {
+		return nil, errors.Wrapf(err, "failed to read block index of %s", channelPath)
+	}
+
+	var blockFiles []string
+	for blockNumber := uint64(0); ; blockNumber++ {
+		blockFile := filepath.Join(channelPath, LegacyBlockFileName(blockNumber))
+		if _, err := os.Stat(blockFile); os.IsNotExist(err) {
+			blockFile = filepath.Join(channelPath, PaddedBlockFileName(blockNumber))
+			if _, err := os.Stat(blockFile); os.IsNotExist(err) {
+				return blockFiles, nil
+			}
+		}
+		blockFiles = append(blockFiles, blockFile)
+	}
+}
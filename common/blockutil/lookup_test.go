@@ -0,0 +1,76 @@
+package blockutil
+
+import (
+	"encoding/hex"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ddr4869/minifab/common/msp/msptest"
+)
+
+func TestLoadBlockByHashFindsBlock42(t *testing.T) {
+	org := msptest.NewOrg(t, "OrdererMSP")
+	basePath := t.TempDir()
+	blocks := buildTestChain(t, org.SigningIdentity(), 100)
+	for _, block := range blocks {
+		if err := SaveBlockFile(block, "testchannel", basePath); err != nil {
+			t.Fatalf("SaveBlockFile: %v", err)
+		}
+	}
+	channelPath := filepath.Join(basePath, "testchannel")
+
+	found, err := LoadBlockByHash(channelPath, CalculateBlockHash(blocks[42]))
+	if err != nil {
+		t.Fatalf("LoadBlockByHash: %v", err)
+	}
+	if found.Header.Number != 42 {
+		t.Fatalf("LoadBlockByHash returned block %d, want 42", found.Header.Number)
+	}
+
+	// 로그의 HashBlock 값과 다음 블록의 PreviousHash로도 같은 블록을 찾는다
+	logged, err := hex.DecodeString(HashBlock(blocks[42]))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, hash := range [][]byte{logged, blocks[43].Header.PreviousHash} {
+		found, err := LoadBlockByHash(channelPath, hash)
+		if err != nil || found.Header.Number != 42 {
+			t.Fatalf("LoadBlockByHash(%x) = %v, %v; want block 42", hash, found.GetHeader().GetNumber(), err)
+		}
+	}
+
+	if _, err := LoadBlockByHash(channelPath, make([]byte, 32)); !errors.Is(err, ErrBlockNotFound) {
+		t.Fatalf("unknown hash error = %v, want ErrBlockNotFound", err)
+	}
+}
+
+func TestLoadBlockByHashFollowsBlockIndex(t *testing.T) {
+	org := msptest.NewOrg(t, "OrdererMSP")
+	channelPath := t.TempDir()
+	blocks := buildTestChain(t, org.SigningIdentity(), 3)
+
+	var index string
+	for i, name := range []string{"genesis.block", "first.block", "second.block"} {
+		data, err := MarshalBlockToProto(blocks[i])
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filepath.Join(channelPath, name), data, 0644); err != nil {
+			t.Fatal(err)
+		}
+		index += name + "\n"
+	}
+	if err := os.WriteFile(filepath.Join(channelPath, BlockIndexFileName), []byte(index), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	found, err := LoadBlockByHash(channelPath, CalculateBlockHash(blocks[2]))
+	if err != nil {
+		t.Fatalf("LoadBlockByHash: %v", err)
+	}
+	if found.Header.Number != 2 {
+		t.Fatalf("LoadBlockByHash returned block %d, want 2", found.Header.Number)
+	}
+}
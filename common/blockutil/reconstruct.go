@@ -0,0 +1,38 @@
+//go:build test
+
+package blockutil
+
+import (
+	pb_common "github.com/ddr4869/minifab/proto/common"
+	"github.com/pkg/errors"
+)
+
+// ReconstructBlockFromTransactions 서명 없이 트랜잭션 proto만으로 데이터 블록 구성
+// 블록 구조만 필요한 테스트용 함수로, test build tag가 있을 때만 빌드된다.
+func ReconstructBlockFromTransactions(number uint64, prevHash []byte, txs []*pb_common.Transaction) (*pb_common.Block, error) {
+	transactions := make([][]byte, 0, len(txs))
+	for i, tx := range txs {
+		txBytes, err := MarshalTransactionToProto(tx)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to marshal transaction %d", i)
+		}
+		transactions = append(transactions, txBytes)
+	}
+
+	header := &pb_common.BlockHeader{
+		Number:       number,
+		PreviousHash: prevHash,
+		HeaderType:   pb_common.BlockType_BLOCK_TYPE_DATA,
+		DataHash:     CalculateDataHash(transactions),
+	}
+	block := &pb_common.Block{
+		Header: header,
+		Data: &pb_common.BlockData{
+			Transactions: transactions,
+		},
+		Metadata: &pb_common.BlockMetadata{},
+	}
+	header.CurrentBlockHash = CalculateBlockHash(block)
+
+	return block, nil
+}
@@ -0,0 +1,55 @@
+//go:build test
+
+package blockutil
+
+import (
+	"bytes"
+	"testing"
+
+	pb_common "github.com/ddr4869/minifab/proto/common"
+)
+
+// reconstructTestBlock 트랜잭션 n개를 가진 서명 없는 3번 블록
+func reconstructTestBlock(t *testing.T, n int) (*pb_common.Block, []*pb_common.Transaction) {
+	t.Helper()
+
+	txs := make([]*pb_common.Transaction, n)
+	for i := range txs {
+		txs[i] = newTestTransaction(t, i)
+	}
+	block, err := ReconstructBlockFromTransactions(3, bytes.Repeat([]byte{0x02}, 32), txs)
+	if err != nil {
+		t.Fatalf("ReconstructBlockFromTransactions: %v", err)
+	}
+	return block, txs
+}
+
+func TestReconstructBlockFromTransactions(t *testing.T) {
+	block, txs := reconstructTestBlock(t, 4)
+
+	if block.Header.Number != 3 || !bytes.Equal(block.Header.PreviousHash, bytes.Repeat([]byte{0x02}, 32)) {
+		t.Errorf("header = %+v", block.Header)
+	}
+	if block.Header.HeaderType != pb_common.BlockType_BLOCK_TYPE_DATA {
+		t.Errorf("HeaderType = %v, want DATA", block.Header.HeaderType)
+	}
+	if !bytes.Equal(block.Header.DataHash, CalculateDataHash(block.Data.Transactions)) {
+		t.Error("DataHash does not match the block transactions")
+	}
+	if !bytes.Equal(block.Header.CurrentBlockHash, CalculateBlockHash(block)) {
+		t.Error("CurrentBlockHash does not match the block")
+	}
+	if len(block.Metadata.GetSignature()) != 0 || block.Metadata.GetIdentity() != nil {
+		t.Error("reconstructed block carries signatures")
+	}
+
+	for i, txBytes := range block.Data.Transactions {
+		tx, err := UnmarshalTransactionFromProto(txBytes)
+		if err != nil {
+			t.Fatalf("UnmarshalTransactionFromProto(%d): %v", i, err)
+		}
+		if tx.TxId != txs[i].TxId {
+			t.Errorf("transaction %d = %s, want %s", i, tx.TxId, txs[i].TxId)
+		}
+	}
+}
@@ -0,0 +1,71 @@
+package blockutil
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+
+	"github.com/ddr4869/minifab/common/msp"
+	pb_common "github.com/ddr4869/minifab/proto/common"
+	"github.com/pkg/errors"
+)
+
+// blockSignedData 블록 서명 대상 데이터 (Number(8바이트 big-endian) || PreviousHash || DataHash)
+// DataHash가 트랜잭션을 모두 포함하므로 헤더만으로 블록 전체가 서명된다.
+func blockSignedData(header *pb_common.BlockHeader) []byte {
+	data := make([]byte, 8, 8+len(header.PreviousHash)+len(header.DataHash))
+	binary.BigEndian.PutUint64(data, header.Number)
+	data = append(data, header.PreviousHash...)
+	return append(data, header.DataHash...)
+}
+
+// SignBlock 블록 헤더를 signer로 서명하고 서명과 signer identity를 BlockMetadata에 기록
+func SignBlock(block *pb_common.Block, signer msp.SigningIdentity) error {
+	if block == nil || block.Header == nil {
+		return errors.New("block has no header")
+	}
+	if signer == nil {
+		return errors.New("signer cannot be nil")
+	}
+
+	digest := sha256.Sum256(blockSignedData(block.Header))
+	signature, err := signer.Sign(rand.Reader, digest[:], nil)
+	if err != nil {
+		return errors.Wrap(err, "failed to sign block")
+	}
+	if block.Metadata == nil {
+		block.Metadata = &pb_common.BlockMetadata{}
+	}
+	block.Metadata.Signature = signature
+	block.Metadata.Identity = &pb_common.Identity{
+		Creator: signer.GetCertificate().Raw,
+		MspId:   signer.GetIdentifier().Mspid,
+	}
+	return nil
+}
+
+// VerifyBlockSignature BlockMetadata의 서명을 검증
+// metadata identity 인증서는 mspInstance가 발급한 것이어야 하며 서명은 SignBlock과 같은 헤더 데이터에 대한 것이다.
+func VerifyBlockSignature(block *pb_common.Block, mspInstance msp.MSP) error {
+	if block == nil || block.Header == nil {
+		return errors.New("block has no header")
+	}
+	if block.Metadata == nil || len(block.Metadata.Signature) == 0 {
+		return errors.New("block is not signed")
+	}
+	if block.Metadata.Identity == nil {
+		return errors.New("block metadata has no identity")
+	}
+	if mspInstance == nil {
+		return errors.New("MSP cannot be nil")
+	}
+
+	identity, err := mspInstance.DeserializeIdentity(block.Metadata.Identity.Creator)
+	if err != nil {
+		return errors.Wrap(err, "failed to deserialize block signer")
+	}
+	if err := identity.Verify(blockSignedData(block.Header), block.Metadata.Signature); err != nil {
+		return errors.Wrapf(err, "invalid signature on block %d", block.Header.Number)
+	}
+	return nil
+}
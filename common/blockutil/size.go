@@ -0,0 +1,26 @@
+package blockutil
+
+import (
+	pb_common "github.com/ddr4869/minifab/proto/common"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+)
+
+// BlockHeaderOverhead 블록 크기 추정 시 더하는 헤더 해시 필드와 metadata의 고정 크기
+const BlockHeaderOverhead = 32
+
+// EstimateBlockSize 트랜잭션들을 담은 블록의 직렬화 크기를 마샬링 없이 추정
+// 트랜잭션마다 proto.Size로 크기를 구해 BlockData 인코딩 크기를 계산하고 BlockHeaderOverhead를 더한다.
+func EstimateBlockSize(txs []*pb_common.Transaction) (int, error) {
+	dataSize := 0
+	for i, tx := range txs {
+		if tx == nil {
+			return 0, errors.Errorf("transaction %d is nil", i)
+		}
+		// BlockData.transactions (field 1, bytes)
+		dataSize += protowire.SizeTag(1) + protowire.SizeBytes(proto.Size(tx))
+	}
+	// Block.data (field 2, message)
+	return protowire.SizeTag(2) + protowire.SizeBytes(dataSize) + BlockHeaderOverhead, nil
+}
@@ -0,0 +1,74 @@
+package blockutil
+
+import (
+	"strings"
+	"testing"
+
+	pb_common "github.com/ddr4869/minifab/proto/common"
+)
+
+// marshaledBlockSize 트랜잭션만 담은 블록을 실제로 직렬화한 크기
+func marshaledBlockSize(t testing.TB, txs []*pb_common.Transaction) int {
+	t.Helper()
+
+	data := &pb_common.BlockData{}
+	for _, tx := range txs {
+		txBytes, err := MarshalTransactionToProto(tx)
+		if err != nil {
+			t.Fatalf("MarshalTransactionToProto: %v", err)
+		}
+		data.Transactions = append(data.Transactions, txBytes)
+	}
+	blockBytes, err := MarshalBlockToProto(&pb_common.Block{Data: data})
+	if err != nil {
+		t.Fatalf("MarshalBlockToProto: %v", err)
+	}
+	return len(blockBytes)
+}
+
+func TestEstimateBlockSize(t *testing.T) {
+	for _, count := range []int{0, 1, 10, 200} {
+		txs := make([]*pb_common.Transaction, count)
+		for i := range txs {
+			txs[i] = newTestTransaction(t, i)
+		}
+		// 200번째 트랜잭션은 길이 varint가 2바이트가 되는 큰 payload
+		if count == 200 {
+			txs[199].Payload = []byte(strings.Repeat("x", 300))
+		}
+
+		got, err := EstimateBlockSize(txs)
+		if err != nil {
+			t.Fatalf("EstimateBlockSize(%d txs): %v", count, err)
+		}
+		if want := marshaledBlockSize(t, txs) + BlockHeaderOverhead; got != want {
+			t.Errorf("EstimateBlockSize(%d txs) = %d, want %d", count, got, want)
+		}
+	}
+
+	if _, err := EstimateBlockSize([]*pb_common.Transaction{newTestTransaction(t, 0), nil}); err == nil || !strings.Contains(err.Error(), "transaction 1 is nil") {
+		t.Errorf("EstimateBlockSize with nil transaction error = %v", err)
+	}
+}
+
+// BenchmarkEstimateBlockSize 트랜잭션 100개 블록의 크기 추정과 실제 직렬화 비용 비교
+// 두 방식의 크기 차이는 BlockHeaderOverhead이며 overhead_bytes 지표로 보고한다.
+func BenchmarkEstimateBlockSize(b *testing.B) {
+	txs := make([]*pb_common.Transaction, 100)
+	for i := range txs {
+		txs[i] = newTestTransaction(b, i)
+	}
+
+	b.Run("estimate", func(b *testing.B) {
+		var size int
+		for i := 0; i < b.N; i++ {
+			size, _ = EstimateBlockSize(txs)
+		}
+		b.ReportMetric(float64(size-marshaledBlockSize(b, txs)), "overhead_bytes")
+	})
+	b.Run("marshal", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			marshaledBlockSize(b, txs)
+		}
+	})
+}
@@ -0,0 +1,64 @@
+package blockutil
+
+import (
+	pb_common "github.com/ddr4869/minifab/proto/common"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+)
+
+// SplitBlock 트랜잭션 크기 합이 maxBytes를 넘는 블록을 여러 블록으로 분할
+// 각 블록의 트랜잭션 크기 합은 maxBytes 이하이며, 트랜잭션 순서는 유지된다.
+// 첫 블록은 원본 블록 번호와 PreviousHash를 그대로 사용하고,
+// 이후 블록은 원본 번호 + index 번호와 직전 블록의 해시를 PreviousHash로 가진다.
+// 분할이 필요 없으면 원본 블록 하나만 담아 반환한다.
+func SplitBlock(block *pb_common.Block, maxBytes uint32) ([]*pb_common.Block, error) {
+	if block == nil || block.Header == nil {
+		return nil, errors.New("block cannot be nil")
+	}
+	if maxBytes == 0 {
+		return nil, errors.New("maxBytes must be greater than 0")
+	}
+
+	var groups [][][]byte
+	var current [][]byte
+	var currentSize uint64
+	for i, tx := range block.GetData().GetTransactions() {
+		txSize := uint64(len(tx))
+		if txSize > uint64(maxBytes) {
+			return nil, errors.Errorf("transaction %d (%d bytes) exceeds max block size %d", i, txSize, maxBytes)
+		}
+		if currentSize+txSize > uint64(maxBytes) {
+			groups = append(groups, current)
+			current, currentSize = nil, 0
+		}
+		current = append(current, tx)
+		currentSize += txSize
+	}
+	if len(groups) == 0 {
+		return []*pb_common.Block{block}, nil
+	}
+	groups = append(groups, current)
+
+	blocks := make([]*pb_common.Block, 0, len(groups))
+	previousHash := block.Header.PreviousHash
+	for i, transactions := range groups {
+		header := proto.Clone(block.Header).(*pb_common.BlockHeader)
+		header.Number = block.Header.Number + uint64(i)
+		header.PreviousHash = previousHash
+		header.DataHash = CalculateDataHash(transactions)
+
+		splitBlock := &pb_common.Block{
+			Header:   header,
+			Data:     &pb_common.BlockData{Transactions: transactions},
+			Metadata: &pb_common.BlockMetadata{},
+		}
+		if block.Metadata != nil {
+			splitBlock.Metadata = proto.Clone(block.Metadata).(*pb_common.BlockMetadata)
+		}
+		header.CurrentBlockHash = CalculateBlockHash(splitBlock)
+
+		previousHash = header.CurrentBlockHash
+		blocks = append(blocks, splitBlock)
+	}
+	return blocks, nil
+}
@@ -0,0 +1,133 @@
+package blockutil
+
+import (
+	"bytes"
+	"testing"
+
+	pb_common "github.com/ddr4869/minifab/proto/common"
+)
+
+// newSplitTestBlock 100바이트 트랜잭션 6개를 가진 7번 블록
+func newSplitTestBlock() *pb_common.Block {
+	var txs [][]byte
+	for i := 0; i < 6; i++ {
+		txs = append(txs, bytes.Repeat([]byte{byte('a' + i)}, 100))
+	}
+	return &pb_common.Block{
+		Header: &pb_common.BlockHeader{
+			Number:       7,
+			PreviousHash: bytes.Repeat([]byte{0x01}, 32),
+			DataHash:     CalculateDataHash(txs),
+			HeaderType:   pb_common.BlockType_BLOCK_TYPE_DATA,
+		},
+		Data:     &pb_common.BlockData{Transactions: txs},
+		Metadata: &pb_common.BlockMetadata{},
+	}
+}
+
+func TestSplitBlock(t *testing.T) {
+	tests := []struct {
+		name       string
+		maxBytes   uint32
+		wantBlocks int
+	}{
+		{"no split", 600, 1},
+		{"two blocks", 300, 2},
+		{"three blocks", 250, 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			original := newSplitTestBlock()
+			blocks, err := SplitBlock(original, tt.maxBytes)
+			if err != nil {
+				t.Fatalf("SplitBlock: %v", err)
+			}
+			if len(blocks) != tt.wantBlocks {
+				t.Fatalf("got %d blocks, want %d", len(blocks), tt.wantBlocks)
+			}
+			if tt.wantBlocks == 1 && blocks[0] != original {
+				t.Error("block that fits was not returned as is")
+			}
+
+			var transactions [][]byte
+			for i, block := range blocks {
+				if block.Header.Number != original.Header.Number+uint64(i) {
+					t.Errorf("block %d number = %d, want %d", i, block.Header.Number, original.Header.Number+uint64(i))
+				}
+				wantPrevious := original.Header.PreviousHash
+				if i > 0 {
+					wantPrevious = CalculateBlockHash(blocks[i-1])
+				}
+				if !bytes.Equal(block.Header.PreviousHash, wantPrevious) {
+					t.Errorf("block %d is not linked to its predecessor", i)
+				}
+				if !bytes.Equal(block.Header.DataHash, CalculateDataHash(block.Data.Transactions)) {
+					t.Errorf("block %d data hash does not match its transactions", i)
+				}
+				size := 0
+				for _, tx := range block.Data.Transactions {
+					size += len(tx)
+				}
+				if size > int(tt.maxBytes) {
+					t.Errorf("block %d is %d bytes, over the %d byte limit", i, size, tt.maxBytes)
+				}
+				transactions = append(transactions, block.Data.Transactions...)
+			}
+
+			// 분할된 블록들의 트랜잭션을 이어 붙이면 원본과 같은 순서여야 한다
+			if len(transactions) != len(original.Data.Transactions) {
+				t.Fatalf("split blocks hold %d transactions, want %d", len(transactions), len(original.Data.Transactions))
+			}
+			for i := range transactions {
+				if !bytes.Equal(transactions[i], original.Data.Transactions[i]) {
+					t.Errorf("transaction %d is out of order", i)
+				}
+			}
+		})
+	}
+}
+
+func TestSplitBlockErrors(t *testing.T) {
+	if _, err := SplitBlock(nil, 100); err == nil {
+		t.Error("SplitBlock(nil) succeeded")
+	}
+	if _, err := SplitBlock(newSplitTestBlock(), 0); err == nil {
+		t.Error("SplitBlock with maxBytes 0 succeeded")
+	}
+	if _, err := SplitBlock(newSplitTestBlock(), 99); err == nil {
+		t.Error("SplitBlock succeeded with a transaction larger than maxBytes")
+	}
+}
+
+func TestSplitMultiTransactionBlock(t *testing.T) {
+	block, _ := newMultiTxTestBlock(t, 6)
+
+	// 트랜잭션 두 개씩 담을 수 있는 크기
+	txSize := len(block.Data.Transactions[0])
+	for _, tx := range block.Data.Transactions {
+		txSize = max(txSize, len(tx))
+	}
+	blocks, err := SplitBlock(block, uint32(2*txSize))
+	if err != nil {
+		t.Fatalf("SplitBlock: %v", err)
+	}
+	if len(blocks) != 3 {
+		t.Fatalf("got %d blocks, want 3", len(blocks))
+	}
+
+	var joined [][]byte
+	previousHash := block.Header.PreviousHash
+	for i, split := range blocks {
+		if split.Header.Number != block.Header.Number+uint64(i) {
+			t.Errorf("block %d number = %d", i, split.Header.Number)
+		}
+		if !bytes.Equal(split.Header.PreviousHash, previousHash) {
+			t.Errorf("block %d is not chained to the previous split block", i)
+		}
+		previousHash = CalculateBlockHash(split)
+		joined = append(joined, split.Data.Transactions...)
+	}
+	if !bytes.Equal(CalculateDataHash(joined), block.Header.DataHash) {
+		t.Error("split blocks do not hold the original transactions in order")
+	}
+}
@@ -0,0 +1,42 @@
+package blockutil
+
+import (
+	pb_common "github.com/ddr4869/minifab/proto/common"
+	"github.com/pkg/errors"
+)
+
+// GetBlockTransactionCount 블록에 담긴 트랜잭션 수 (블록이나 데이터가 없으면 0)
+func GetBlockTransactionCount(block *pb_common.Block) int {
+	return len(block.GetData().GetTransactions())
+}
+
+// GetValidTransactionCount ValidationBitmap에서 유효로 표시된 트랜잭션 수
+// 비트맵은 트랜잭션 i를 i/8번째 바이트의 i%8번째 비트(LSB부터)로 표시하며 1이 유효이다.
+// 비트맵이 비어 있으면 검증 결과가 기록되지 않은 것으로 보고 모든 트랜잭션을 유효로 센다.
+func GetValidTransactionCount(block *pb_common.Block) (int, error) {
+	txCount := GetBlockTransactionCount(block)
+	bitmap := block.GetMetadata().GetValidationBitmap()
+	if len(bitmap) == 0 {
+		return txCount, nil
+	}
+	if len(bitmap) < (txCount+7)/8 {
+		return 0, errors.Errorf("validation bitmap has %d bytes, need %d for %d transactions", len(bitmap), (txCount+7)/8, txCount)
+	}
+
+	valid := 0
+	for i := 0; i < txCount; i++ {
+		if bitmap[i/8]&(1<<(i%8)) != 0 {
+			valid++
+		}
+	}
+	return valid, nil
+}
+
+// GetInvalidTransactionCount ValidationBitmap에서 무효로 표시된 트랜잭션 수
+func GetInvalidTransactionCount(block *pb_common.Block) (int, error) {
+	valid, err := GetValidTransactionCount(block)
+	if err != nil {
+		return 0, err
+	}
+	return GetBlockTransactionCount(block) - valid, nil
+}
@@ -0,0 +1,64 @@
+package blockutil
+
+import (
+	"fmt"
+	"testing"
+
+	pb_common "github.com/ddr4869/minifab/proto/common"
+)
+
+// newTxCountTestBlock 트랜잭션 txCount개와 주어진 ValidationBitmap을 가진 블록
+func newTxCountTestBlock(txCount int, bitmap []byte) *pb_common.Block {
+	var txs [][]byte
+	for i := 0; i < txCount; i++ {
+		txs = append(txs, []byte(fmt.Sprintf("tx-%d", i)))
+	}
+	return &pb_common.Block{
+		Header:   &pb_common.BlockHeader{DataHash: CalculateDataHash(txs)},
+		Data:     &pb_common.BlockData{Transactions: txs},
+		Metadata: &pb_common.BlockMetadata{ValidationBitmap: bitmap},
+	}
+}
+
+func TestTransactionCounts(t *testing.T) {
+	tests := []struct {
+		name        string
+		block       *pb_common.Block
+		wantTotal   int
+		wantValid   int
+		wantInvalid int
+	}{
+		{"nil block", nil, 0, 0, 0},
+		{"zero transactions", newTxCountTestBlock(0, nil), 0, 0, 0},
+		{"no bitmap counts all valid", newTxCountTestBlock(5, nil), 5, 5, 0},
+		{"all valid", newTxCountTestBlock(10, []byte{0xff, 0x03}), 10, 10, 0},
+		// 트랜잭션 0, 3, 9만 유효
+		{"mixed", newTxCountTestBlock(10, []byte{0x09, 0x02}), 10, 3, 7},
+		{"bits past the last transaction are ignored", newTxCountTestBlock(3, []byte{0xff}), 3, 3, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := GetBlockTransactionCount(tt.block); got != tt.wantTotal {
+				t.Errorf("GetBlockTransactionCount = %d, want %d", got, tt.wantTotal)
+			}
+			valid, err := GetValidTransactionCount(tt.block)
+			if err != nil || valid != tt.wantValid {
+				t.Errorf("GetValidTransactionCount = %d, %v, want %d", valid, err, tt.wantValid)
+			}
+			invalid, err := GetInvalidTransactionCount(tt.block)
+			if err != nil || invalid != tt.wantInvalid {
+				t.Errorf("GetInvalidTransactionCount = %d, %v, want %d", invalid, err, tt.wantInvalid)
+			}
+		})
+	}
+}
+
+func TestTransactionCountsRejectShortBitmap(t *testing.T) {
+	block := newTxCountTestBlock(10, []byte{0xff})
+	if _, err := GetValidTransactionCount(block); err == nil {
+		t.Error("GetValidTransactionCount accepted a bitmap shorter than the transactions")
+	}
+	if _, err := GetInvalidTransactionCount(block); err == nil {
+		t.Error("GetInvalidTransactionCount accepted a bitmap shorter than the transactions")
+	}
+}
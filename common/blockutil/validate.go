@@ -0,0 +1,93 @@
+package blockutil
+
+import (
+	"crypto/sha256"
+	"regexp"
+	"time"
+
+	pb_common "github.com/ddr4869/minifab/proto/common"
+	"github.com/pkg/errors"
+)
+
+// MaxTimestampSkew 트랜잭션 timestamp와 현재 시각의 기본 허용 오차 (과거/미래 모두)
+const MaxTimestampSkew = 5 * time.Minute
+
+const maxChannelNameLength = 249
+
+var (
+	// CalculateTxHash가 생성하는 SHA256 hex 문자열
+	txIDPattern        = regexp.MustCompile(`^[0-9a-f]{64}$`)
+	channelNamePattern = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9._-]*$`)
+)
+
+// ValidateChannelName 채널 이름 형식 검증
+func ValidateChannelName(channelID string) error {
+	if channelID == "" {
+		return errors.New("channel name cannot be empty")
+	}
+	if len(channelID) > maxChannelNameLength {
+		return errors.Errorf("channel name %s is longer than %d characters", channelID, maxChannelNameLength)
+	}
+	if !channelNamePattern.MatchString(channelID) {
+		return errors.Errorf("invalid channel name %q (must match %s)", channelID, channelNamePattern)
+	}
+	return nil
+}
+
+// ValidateTransaction 트랜잭션 자체의 일관성 검증 (서명 검증은 하지 않음)
+// channelID는 트랜잭션을 담은 envelope header의 채널 ID이다.
+// timestamp가 현재 시각에서 과거나 미래로 maxSkew(0 이하이면 MaxTimestampSkew)보다 멀면 거부한다.
+func ValidateTransaction(tx *pb_common.Transaction, channelID string, maxSkew time.Duration) error {
+	if tx == nil {
+		return errors.New("transaction is nil")
+	}
+	if !txIDPattern.MatchString(tx.TxId) {
+		return errors.Errorf("invalid transaction ID %q", tx.TxId)
+	}
+	if err := ValidateChannelName(channelID); err != nil {
+		return errors.Wrapf(err, "transaction %s", tx.TxId)
+	}
+	if len(tx.Payload) == 0 {
+		return errors.Errorf("transaction %s payload is empty", tx.TxId)
+	}
+	if tx.Timestamp <= 0 {
+		return errors.Errorf("transaction %s timestamp %d is not after the Unix epoch", tx.TxId, tx.Timestamp)
+	}
+	if maxSkew <= 0 {
+		maxSkew = MaxTimestampSkew
+	}
+	if diff := time.Since(time.Unix(tx.Timestamp, 0)); diff > maxSkew || diff < -maxSkew {
+		return errors.Errorf("transaction %s timestamp %d is outside the allowed skew of %s", tx.TxId, tx.Timestamp, maxSkew)
+	}
+	if tx.Identity == nil {
+		return errors.Errorf("transaction %s identity is empty", tx.TxId)
+	}
+	if len(tx.Signature) == 0 {
+		return errors.Errorf("transaction %s signature is empty", tx.TxId)
+	}
+	return nil
+}
+
+// ValidateBlock 블록 구조 검증 (해시 연결과 서명 검증은 하지 않음)
+// 블록 0은 PreviousHash가 없어야 하고, 그 이후 블록은 CalculateBlockHash 크기(32바이트)의 PreviousHash를 가져야 한다.
+func ValidateBlock(block *pb_common.Block) error {
+	if block == nil {
+		return errors.New("block is nil")
+	}
+	if block.Header == nil {
+		return errors.New("block header is nil")
+	}
+	number := block.Header.Number
+	previousHash := block.Header.PreviousHash
+	if number == 0 {
+		if len(previousHash) != 0 {
+			return errors.Errorf("block 0 must not have a previous hash, got %d bytes", len(previousHash))
+		}
+	} else if len(previousHash) != sha256.Size {
+		return errors.Errorf("block %d previous hash must be %d bytes, got %d bytes", number, sha256.Size, len(previousHash))
+	}
+	if block.Data == nil {
+		return errors.Errorf("block %d data is nil", number)
+	}
+	return nil
+}
@@ -0,0 +1,110 @@
+package blockutil
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	pb_common "github.com/ddr4869/minifab/proto/common"
+)
+
+func TestValidateBlock(t *testing.T) {
+	validHeader := func(number uint64, previousHash []byte) *pb_common.BlockHeader {
+		return &pb_common.BlockHeader{Number: number, PreviousHash: previousHash}
+	}
+	hash := bytes.Repeat([]byte{0xab}, 32)
+
+	tests := []struct {
+		name    string
+		block   *pb_common.Block
+		wantErr string
+	}{
+		{"genesis", &pb_common.Block{Header: validHeader(0, nil), Data: &pb_common.BlockData{}}, ""},
+		{"data block", &pb_common.Block{Header: validHeader(5, hash), Data: &pb_common.BlockData{}}, ""},
+		{"nil block", nil, "block is nil"},
+		{"nil header", &pb_common.Block{Data: &pb_common.BlockData{}}, "header is nil"},
+		{"genesis with previous hash", &pb_common.Block{Header: validHeader(0, hash), Data: &pb_common.BlockData{}}, "must not have a previous hash"},
+		{"missing previous hash", &pb_common.Block{Header: validHeader(1, nil), Data: &pb_common.BlockData{}}, "must be 32 bytes, got 0"},
+		{"short previous hash", &pb_common.Block{Header: validHeader(1, hash[:31]), Data: &pb_common.BlockData{}}, "must be 32 bytes, got 31"},
+		{"concatenated previous hash", &pb_common.Block{Header: validHeader(2, append(hash, hash...)), Data: &pb_common.BlockData{}}, "must be 32 bytes, got 64"},
+		{"nil data", &pb_common.Block{Header: validHeader(1, hash)}, "data is nil"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateBlock(tt.block)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("ValidateBlock: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("ValidateBlock error = %v, want it to contain %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateTransaction(t *testing.T) {
+	tests := []struct {
+		name      string
+		mutate    func(tx *pb_common.Transaction)
+		channelID string
+		wantErr   string
+	}{
+		{"valid", func(tx *pb_common.Transaction) {}, "mychannel", ""},
+		{"empty tx ID", func(tx *pb_common.Transaction) { tx.TxId = "" }, "mychannel", "invalid transaction ID"},
+		{"uppercase tx ID", func(tx *pb_common.Transaction) { tx.TxId = strings.ToUpper(tx.TxId) }, "mychannel", "invalid transaction ID"},
+		{"short tx ID", func(tx *pb_common.Transaction) { tx.TxId = tx.TxId[:63] }, "mychannel", "invalid transaction ID"},
+		{"empty channel", func(tx *pb_common.Transaction) {}, "", "channel name cannot be empty"},
+		{"channel starting with digit", func(tx *pb_common.Transaction) {}, "1channel", "invalid channel name"},
+		{"channel with space", func(tx *pb_common.Transaction) {}, "my channel", "invalid channel name"},
+		{"channel too long", func(tx *pb_common.Transaction) {}, "c" + strings.Repeat("a", maxChannelNameLength), "longer than 249"},
+		{"empty payload", func(tx *pb_common.Transaction) { tx.Payload = nil }, "mychannel", "payload is empty"},
+		{"zero timestamp", func(tx *pb_common.Transaction) { tx.Timestamp = 0 }, "mychannel", "not after the Unix epoch"},
+		{"negative timestamp", func(tx *pb_common.Transaction) { tx.Timestamp = -1 }, "mychannel", "not after the Unix epoch"},
+		{"future timestamp", func(tx *pb_common.Transaction) {
+			tx.Timestamp = time.Now().Add(MaxTimestampSkew + time.Minute).Unix()
+		}, "mychannel", "outside the allowed skew"},
+		{"past timestamp", func(tx *pb_common.Transaction) {
+			tx.Timestamp = time.Now().Add(-MaxTimestampSkew - time.Minute).Unix()
+		}, "mychannel", "outside the allowed skew"},
+		{"timestamp within skew", func(tx *pb_common.Transaction) {
+			tx.Timestamp = time.Now().Add(MaxTimestampSkew - time.Minute).Unix()
+		}, "mychannel", ""},
+		{"nil identity", func(tx *pb_common.Transaction) { tx.Identity = nil }, "mychannel", "identity is empty"},
+		{"empty signature", func(tx *pb_common.Transaction) { tx.Signature = nil }, "mychannel", "signature is empty"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tx := newTestTransaction(t, 0)
+			tt.mutate(tx)
+			err := ValidateTransaction(tx, tt.channelID, 0)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("ValidateTransaction: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("ValidateTransaction error = %v, want it to contain %q", err, tt.wantErr)
+			}
+		})
+	}
+
+	if err := ValidateTransaction(nil, "mychannel", 0); err == nil || !strings.Contains(err.Error(), "transaction is nil") {
+		t.Errorf("ValidateTransaction(nil) error = %v", err)
+	}
+
+	// maxSkew를 넘겨주면 기본값 대신 과거와 미래 모두에 적용된다
+	tx := newTestTransaction(t, 0)
+	tx.Timestamp = time.Now().Add(MaxTimestampSkew + time.Minute).Unix()
+	if err := ValidateTransaction(tx, "mychannel", time.Hour); err != nil {
+		t.Errorf("ValidateTransaction with a one hour skew: %v", err)
+	}
+	tx.Timestamp = time.Now().Add(-2 * time.Minute).Unix()
+	if err := ValidateTransaction(tx, "mychannel", time.Minute); err == nil {
+		t.Error("ValidateTransaction accepted a timestamp older than a one minute skew")
+	}
+}
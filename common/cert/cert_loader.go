@@ -12,8 +12,47 @@ import (
 	"github.com/pkg/errors"
 )
 
+// LoadCaCertFromDir cacerts 폴더의 첫 번째 CA 인증서 로드
+//
+// Deprecated: 중간 CA가 있는 조직은 cacerts에 여러 인증서를 두므로 LoadCaCertsFromDir를 사용한다.
 func LoadCaCertFromDir(dirPath string) (*x509.Certificate, error) {
-	return LoadCertFromDir(dirPath, "cacerts")
+	certs, err := LoadCaCertsFromDir(dirPath)
+	if err != nil {
+		return nil, err
+	}
+	return certs[0], nil
+}
+
+// LoadCaCertsFromDir cacerts 폴더의 모든 PEM 파일에서 CA 인증서를 파일 이름 순서대로 로드
+// 하나의 파일에 여러 인증서가 이어 붙어 있어도 모두 읽는다.
+func LoadCaCertsFromDir(dirPath string) ([]*x509.Certificate, error) {
+	caDir := filepath.Join(dirPath, "cacerts")
+	files, err := os.ReadDir(caDir)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read directory %s", caDir)
+	}
+
+	var certs []*x509.Certificate
+	for _, file := range files {
+		if file.IsDir() {
+			continue
+		}
+		filePath := filepath.Join(caDir, file.Name())
+		data, err := os.ReadFile(filePath)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to read file %s", filePath)
+		}
+		fileCerts, err := ParseCertificatesPEM(data)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to load certificates from %s", filePath)
+		}
+		certs = append(certs, fileCerts...)
+	}
+
+	if len(certs) == 0 {
+		return nil, errors.Errorf("no certificates found in directory %s", caDir)
+	}
+	return certs, nil
 }
 
 func LoadSignCertFromDir(dirPath string) (*x509.Certificate, error) {
@@ -26,19 +65,54 @@ func LoadCertFromDir(dirPath, certType string) (*x509.Certificate, error) {
 		return nil, err
 	}
 
+	cert, err := ParseCertificatePEM(data)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to load certificate from directory %s", dirPath)
+	}
+
+	return cert, nil
+}
+
+// ParseCertificatePEM PEM 인코딩된 x509 인증서 파싱
+func ParseCertificatePEM(data []byte) (*x509.Certificate, error) {
 	block, _ := pem.Decode(data)
 	if block == nil {
-		return nil, errors.Errorf("failed to decode PEM block from directory %s", dirPath)
+		return nil, errors.New("failed to decode PEM block")
 	}
 
 	cert, err := x509.ParseCertificate(block.Bytes)
 	if err != nil {
-		return nil, errors.Wrapf(err, "failed to parse certificate from directory %s", dirPath)
+		return nil, errors.Wrap(err, "failed to parse certificate")
 	}
 
 	return cert, nil
 }
 
+// ParseCertificatesPEM PEM 데이터에 포함된 모든 x509 인증서 파싱
+func ParseCertificatesPEM(data []byte) ([]*x509.Certificate, error) {
+	var certs []*x509.Certificate
+	for {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to parse certificate")
+		}
+		certs = append(certs, cert)
+	}
+
+	if len(certs) == 0 {
+		return nil, errors.New("failed to decode PEM block")
+	}
+	return certs, nil
+}
+
 func LoadPrivateKeyFromDir(dirPath string) (crypto.PrivateKey, error) {
 	prk, err := LoadSingleFileFromDir(dirPath + "/keystore")
 	if err != nil {
@@ -0,0 +1,83 @@
+package cert_test
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ddr4869/minifab/common/cert"
+	"github.com/ddr4869/minifab/common/msp/msptest"
+)
+
+// writeCaCerts dir/cacerts에 파일 이름별 PEM 데이터를 기록
+func writeCaCerts(t *testing.T, dir string, files map[string][]byte) {
+	t.Helper()
+
+	caDir := filepath.Join(dir, "cacerts")
+	if err := os.MkdirAll(caDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	for name, data := range files {
+		if err := os.WriteFile(filepath.Join(caDir, name), data, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestLoadCaCertsFromDir(t *testing.T) {
+	root := msptest.NewOrg(t, "Org1MSP")
+	intermediate := root.NewIntermediate(t, "Org1MSP-ica")
+	other := msptest.NewOrg(t, "Org2MSP")
+
+	dir := t.TempDir()
+	writeCaCerts(t, dir, map[string][]byte{
+		"1-root.pem": root.CACertPEM(),
+		// 한 파일에 이어 붙은 인증서와 인증서가 아닌 PEM 블록
+		"2-chain.pem": bytes.Join([][]byte{
+			intermediate.CACertPEM(),
+			msptest.KeyPEM(t, other.Key),
+			other.CACertPEM(),
+		}, nil),
+	})
+
+	certs, err := cert.LoadCaCertsFromDir(dir)
+	if err != nil {
+		t.Fatalf("LoadCaCertsFromDir: %v", err)
+	}
+	want := [][]byte{root.CACert.Raw, intermediate.CACert.Raw, other.CACert.Raw}
+	if len(certs) != len(want) {
+		t.Fatalf("loaded %d certs, want %d", len(certs), len(want))
+	}
+	for i := range want {
+		if !bytes.Equal(certs[i].Raw, want[i]) {
+			t.Errorf("cert %d is %s", i, certs[i].Subject.CommonName)
+		}
+	}
+
+	first, err := cert.LoadCaCertFromDir(dir)
+	if err != nil {
+		t.Fatalf("LoadCaCertFromDir: %v", err)
+	}
+	if !first.Equal(root.CACert) {
+		t.Errorf("LoadCaCertFromDir returned %s, want the first cert", first.Subject.CommonName)
+	}
+}
+
+func TestLoadCaCertsFromDirErrors(t *testing.T) {
+	if _, err := cert.LoadCaCertsFromDir(t.TempDir()); err == nil {
+		t.Error("LoadCaCertsFromDir succeeded without a cacerts directory")
+	}
+
+	empty := t.TempDir()
+	writeCaCerts(t, empty, nil)
+	if _, err := cert.LoadCaCertsFromDir(empty); err == nil {
+		t.Error("LoadCaCertsFromDir succeeded with an empty cacerts directory")
+	}
+
+	invalid := t.TempDir()
+	writeCaCerts(t, invalid, map[string][]byte{"ca.pem": []byte("not a certificate")})
+	if _, err := cert.LoadCaCertsFromDir(invalid); err == nil {
+		t.Error("LoadCaCertsFromDir succeeded with an invalid PEM file")
+	}
+}
@@ -3,6 +3,7 @@ package cert
 import (
 	"crypto"
 	"crypto/ecdsa"
+	"crypto/ed25519"
 	"crypto/rsa"
 	"crypto/sha256"
 	"encoding/asn1"
@@ -23,6 +24,8 @@ func VerifySignature(pubKey crypto.PublicKey, message []byte, signature []byte)
 		return VerifyECDSA(pubKey, message, signature)
 	case *rsa.PublicKey:
 		return VerifyRSA(pubKey, message, signature)
+	case ed25519.PublicKey:
+		return VerifyEd25519(pubKey, message, signature)
 	default:
 		return false, errors.New("unsupported public key type")
 	}
@@ -33,10 +36,23 @@ func VerifyECDSA(pubKey *ecdsa.PublicKey, message []byte, signature []byte) (boo
 	hash := sha256.Sum256(message)
 
 	ecdsaSignature := &ecdsaSignature{}
-	asn1.Unmarshal(signature, ecdsaSignature)
+	if _, err := asn1.Unmarshal(signature, ecdsaSignature); err != nil {
+		return false, errors.Wrap(err, "failed to unmarshal ECDSA signature")
+	}
 	return ecdsa.Verify(pubKey, hash[:], ecdsaSignature.R, ecdsaSignature.S), nil
 }
 
+// VerifyEd25519 ECDSA/RSA와 같이 SHA256(message)를 서명 대상으로 보고 Ed25519 서명 검증
+// Signer가 Sign에 넘기는 digest를 Ed25519가 그대로 메시지로 서명하기 때문이다.
+func VerifyEd25519(pubKey ed25519.PublicKey, message []byte, signature []byte) (bool, error) {
+	logger.Debug("verifying Ed25519 signature")
+	if len(pubKey) != ed25519.PublicKeySize {
+		return false, errors.Errorf("invalid Ed25519 public key size %d", len(pubKey))
+	}
+	hash := sha256.Sum256(message)
+	return ed25519.Verify(pubKey, hash[:], signature), nil
+}
+
 func VerifyRSA(pubKey *rsa.PublicKey, message []byte, signature []byte) (bool, error) {
 	logger.Debug("verifying RSA signature")
 	hash := sha256.Sum256(message)
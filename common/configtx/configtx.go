@@ -1,10 +1,19 @@
 package configtx
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
+	"math"
 	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/ddr4869/minifab/common/cert"
+	"github.com/ddr4869/minifab/common/msp"
 	"github.com/pkg/errors"
 	"gopkg.in/yaml.v3"
 )
@@ -14,15 +23,54 @@ type Organization struct {
 	ID               string       `yaml:"ID"`
 	MSPDir           string       `yaml:"MSPDir"`
 	MSPCaCert        []byte       `yaml:"-"`
+	MSPCaCerts       [][]byte     `yaml:"-" json:",omitempty"` // 중간 CA를 포함한 cacerts의 모든 인증서 (MSPCaCert는 첫 번째 인증서)
 	OrdererEndpoints []string     `yaml:"OrdererEndpoints,omitempty"`
 	AnchorPeers      []AnchorPeer `yaml:"AnchorPeers,omitempty"`
 }
 
+var mspIDPattern = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_-]*$`)
+
+// Validate 조직 설정과 MSP 디렉토리 구조(cacerts, signcerts, keystore)를 사전 검증
+func (o *Organization) Validate() error {
+	if o.Name == "" {
+		return errors.New("organization name cannot be empty")
+	}
+	if !mspIDPattern.MatchString(o.ID) {
+		return errors.Errorf("organization %s: invalid MSP ID %q (must match %s)", o.Name, o.ID, mspIDPattern)
+	}
+	if o.MSPDir == "" {
+		return errors.Errorf("organization %s: MSPDir cannot be empty", o.Name)
+	}
+	if stat, err := os.Stat(o.MSPDir); err != nil || !stat.IsDir() {
+		return errors.Errorf("organization %s: MSPDir does not exist: %s", o.Name, o.MSPDir)
+	}
+	if err := msp.ValidateMSPStructure(o.MSPDir); err != nil {
+		return errors.Wrapf(err, "organization %s: invalid MSPDir %s", o.Name, o.MSPDir)
+	}
+	for i, anchorPeer := range o.AnchorPeers {
+		if err := anchorPeer.Validate(); err != nil {
+			return errors.Wrapf(err, "organization %s: invalid AnchorPeers[%d]", o.Name, i)
+		}
+	}
+	return nil
+}
+
 type AnchorPeer struct {
 	Host string `yaml:"Host"`
 	Port int    `yaml:"Port"`
 }
 
+// Validate anchor peer의 Host가 비어 있지 않고 Port가 1~65535 범위인지 확인
+func (a AnchorPeer) Validate() error {
+	if strings.TrimSpace(a.Host) == "" {
+		return errors.New("anchor peer host cannot be empty")
+	}
+	if a.Port <= 0 || a.Port > 65535 {
+		return errors.Errorf("anchor peer %s has invalid port %d", a.Host, a.Port)
+	}
+	return nil
+}
+
 type OrdererConfig struct {
 	BatchTimeout string    `yaml:"BatchTimeout"`
 	BatchSize    BatchSize `yaml:"BatchSize"`
@@ -35,12 +83,12 @@ type BatchSize struct {
 }
 
 type AppChannelConfig struct {
-	Policies      interface{}    `yaml:"Policies"` // all 등 단순 문자열일 수도, 정책구조일 수도 있음
-	Organizations []Organization `yaml:"Organizations"`
+	Policies      interface{}    `yaml:"Policies,omitempty"` // all 등 단순 문자열일 수도, 정책구조일 수도 있음
+	Organizations []Organization `yaml:"Organizations,omitempty"`
 }
 
 type Consortium struct {
-	Organizations []Organization `yaml:"Organizations"`
+	Organizations []Organization `yaml:"Organizations,omitempty"`
 }
 
 type SystemChannelConfig struct {
@@ -50,8 +98,12 @@ type SystemChannelConfig struct {
 }
 
 type SystemChannelInfo struct {
-	Orderer     SystemChannelConfig `yaml:"Orderer"`
-	Consortiums []Organization      `yaml:"Consortiums"`
+	Orderer      SystemChannelConfig `yaml:"Orderer"`
+	Consortiums  []Organization      `yaml:"Consortiums"`
+	Capabilities []string            `yaml:"Capabilities,omitempty" json:",omitempty"`
+	Timestamp    *time.Time          `yaml:"-" json:",omitempty"` // 제네시스 설정 생성 시각 (지정한 경우에만 기록)
+	// ConsortiumMembers 이름별 Consortiums로 정의된 경우 consortium 이름별 조직 MSP ID
+	ConsortiumMembers map[string][]string `yaml:"-" json:",omitempty"`
 }
 
 type AppChannelProfile struct {
@@ -64,10 +116,10 @@ type Profiles struct {
 }
 
 type ConfigTx struct {
-	Organizations []Organization         `yaml:"Organizations"`
+	Organizations []Organization         `yaml:"Organizations,omitempty"`
 	Orderer       OrdererConfig          `yaml:"Orderer"`
 	Channel       AppChannelConfig       `yaml:"Channel"`
-	Profiles      map[string]interface{} `yaml:"Profiles"`
+	Profiles      map[string]interface{} `yaml:"Profiles,omitempty"`
 }
 
 type ChannelConfig struct {
@@ -75,12 +127,54 @@ type ChannelConfig struct {
 	SCC *SystemChannelInfo
 }
 
+// Clone 채널 설정의 깊은 복사본 반환 (설정 블록과 같은 JSON 형식으로 직렬화 후 다시 읽음)
+// 복사본을 수정해도 원본 설정의 slice나 인증서 bytes는 바뀌지 않는다.
+func (c *ChannelConfig) Clone() (*ChannelConfig, error) {
+	data, err := json.Marshal(c)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal channel config")
+	}
+	clone := &ChannelConfig{}
+	if err := json.Unmarshal(data, clone); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal channel config")
+	}
+	return clone, nil
+}
+
+// Clone application 채널 설정의 깊은 복사본 반환 (ChannelConfig.Clone과 같은 JSON 형식 사용)
+func (c *AppChannelConfig) Clone() (*AppChannelConfig, error) {
+	clone, err := (&ChannelConfig{CC: c}).Clone()
+	if err != nil {
+		return nil, err
+	}
+	return clone.CC, nil
+}
+
 func (c *ConfigTx) GetSystemChannelInfo(name string) (*SystemChannelInfo, error) {
 	profileData, exists := c.Profiles[name]
 	if !exists {
 		return nil, fmt.Errorf("profile '%s' not found", name)
 	}
 
+	// Consortiums가 consortium 이름별 mapping이면 조직 목록으로 펼치고 이름별 멤버를 따로 기록
+	var consortiumMembers map[string][]string
+	var consortiumOrgs []Organization
+	if profile, ok := profileData.(map[string]interface{}); ok {
+		if byName, ok := profile["Consortiums"].(map[string]interface{}); ok {
+			var err error
+			if consortiumMembers, consortiumOrgs, err = c.flattenConsortiums(byName); err != nil {
+				return nil, errors.Wrapf(err, "profile '%s'", name)
+			}
+			trimmed := make(map[string]interface{}, len(profile))
+			for key, value := range profile {
+				if key != "Consortiums" {
+					trimmed[key] = value
+				}
+			}
+			profileData = trimmed
+		}
+	}
+
 	yamlData, err := yaml.Marshal(profileData)
 	if err != nil {
 		return nil, fmt.Errorf("failed to marshal profile data: %v", err)
@@ -90,17 +184,92 @@ func (c *ConfigTx) GetSystemChannelInfo(name string) (*SystemChannelInfo, error)
 	if err := yaml.Unmarshal(yamlData, &systemProfile); err != nil {
 		return nil, fmt.Errorf("failed to unmarshal as SystemChannelInfo: %v", err)
 	}
+	if consortiumMembers != nil {
+		systemProfile.Consortiums = consortiumOrgs
+		systemProfile.ConsortiumMembers = consortiumMembers
+	}
+	if systemProfile.Orderer.BatchTimeout != "" {
+		if _, err := ParseBatchTimeout(systemProfile.Orderer.BatchTimeout); err != nil {
+			return nil, errors.Wrapf(err, "profile '%s'", name)
+		}
+	}
+
+	// peer가 orderer 서명 블록을 검증할 수 있도록 orderer 조직의 CA 인증서도 기록
+	if ordererOrg := &systemProfile.Orderer.Organization; ordererOrg.MSPDir != "" {
+		caCerts, err := cert.LoadCaCertsFromDir(ordererOrg.MSPDir)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to load orderer organization certificate")
+		}
+		ordererOrg.MSPCaCert = caCerts[0].Raw
+		for _, caCert := range caCerts {
+			ordererOrg.MSPCaCerts = append(ordererOrg.MSPCaCerts, caCert.Raw)
+		}
+	}
 
 	for i, org := range systemProfile.Consortiums {
-		cert, err := cert.LoadCaCertFromDir(org.MSPDir)
+		caCerts, err := cert.LoadCaCertsFromDir(org.MSPDir)
 		if err != nil {
 			return nil, errors.Wrap(err, "failed to load certificate")
 		}
-		systemProfile.Consortiums[i].MSPCaCert = cert.Raw
+		systemProfile.Consortiums[i].MSPCaCert = caCerts[0].Raw
+		for _, caCert := range caCerts {
+			systemProfile.Consortiums[i].MSPCaCerts = append(systemProfile.Consortiums[i].MSPCaCerts, caCert.Raw)
+		}
 	}
 	return &systemProfile, nil
 }
 
+// GetConsortiumOrgs profile의 이름별 Consortiums에서 consortium을 찾아 조직 목록 반환
+func (c *ConfigTx) GetConsortiumOrgs(consortiumName string) ([]Organization, error) {
+	resolver, err := NewProfileResolver(c)
+	if err != nil {
+		return nil, err
+	}
+	resolved, err := resolver.ResolveConsortium(consortiumName)
+	if err != nil {
+		return nil, err
+	}
+
+	orgs := make([]Organization, 0, len(resolved))
+	for _, org := range resolved {
+		orgs = append(orgs, *org)
+	}
+	return orgs, nil
+}
+
+// flattenConsortiums 이름별 consortium을 이름 순으로 펼친 조직 목록(중복 제거)과 consortium별 MSP ID 반환
+func (c *ConfigTx) flattenConsortiums(byName map[string]interface{}) (map[string][]string, []Organization, error) {
+	resolver, err := NewProfileResolver(c)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	names := make([]string, 0, len(byName))
+	for consortiumName := range byName {
+		names = append(names, consortiumName)
+	}
+	sort.Strings(names)
+
+	members := make(map[string][]string, len(names))
+	var orgs []Organization
+	seen := make(map[string]bool)
+	for _, consortiumName := range names {
+		resolved, err := resolver.resolveNamedConsortium(byName, consortiumName)
+		if err != nil {
+			return nil, nil, err
+		}
+		members[consortiumName] = []string{}
+		for _, org := range resolved {
+			members[consortiumName] = append(members[consortiumName], org.ID)
+			if !seen[org.Name] {
+				seen[org.Name] = true
+				orgs = append(orgs, *org)
+			}
+		}
+	}
+	return members, orgs, nil
+}
+
 func (c *ConfigTx) GetAppChannelProfile(profileName string) (*AppChannelProfile, error) {
 	profileData, exists := c.Profiles[profileName]
 	if !exists {
@@ -141,45 +310,113 @@ func ConvertConfigtx(configTxPath string) (*ConfigTx, error) {
 		return nil, errors.Wrap(err, "failed to parse configtx YAML")
 	}
 
+	if configTx.Orderer.BatchTimeout != "" {
+		if _, err := ParseBatchTimeout(configTx.Orderer.BatchTimeout); err != nil {
+			return nil, errors.Wrapf(err, "invalid Orderer section in %s", configTxPath)
+		}
+	}
+
+	var validationErrs []string
+	for i := range configTx.Organizations {
+		if err := configTx.Organizations[i].Validate(); err != nil {
+			validationErrs = append(validationErrs, err.Error())
+		}
+	}
+	if len(validationErrs) > 0 {
+		return nil, errors.Errorf("invalid organizations in %s: %s", configTxPath, strings.Join(validationErrs, "; "))
+	}
+
 	for i, org := range configTx.Organizations {
-		cert, err := cert.LoadCaCertFromDir(org.MSPDir)
+		caCerts, err := cert.LoadCaCertsFromDir(org.MSPDir)
 		if err != nil {
 			return nil, errors.Wrap(err, "failed to load certificate")
 		}
-		configTx.Organizations[i].MSPCaCert = cert.Raw
+		configTx.Organizations[i].MSPCaCert = caCerts[0].Raw
+		for _, caCert := range caCerts {
+			configTx.Organizations[i].MSPCaCerts = append(configTx.Organizations[i].MSPCaCerts, caCert.Raw)
+		}
+	}
+
+	// profile이 참조하는 조직이 모두 정의되어 있는지 확인
+	resolver, err := NewProfileResolver(&configTx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid organizations in %s", configTxPath)
+	}
+	for profileName := range configTx.Profiles {
+		if _, err := resolver.ResolveProfile(profileName); err != nil {
+			return nil, errors.Wrapf(err, "invalid profile in %s", configTxPath)
+		}
 	}
 
 	return &configTx, nil
 }
 
-// parseBatchSizeBytes 크기 문자열을 바이트 수로 변환 ("128 MB" -> 134217728)
+// MarshalToYAML ConfigTx를 ConvertConfigtx로 다시 읽을 수 있는 configtx.yaml 형식으로 직렬화
+// 인증서(MSPCaCert, MSPCaCerts)는 기록하지 않으며 읽을 때 MSPDir에서 다시 로드된다.
+// YAML anchor는 유지되지 않으므로 profile의 조직 참조는 값으로 펼쳐진 채 기록된다.
+func (c *ConfigTx) MarshalToYAML() ([]byte, error) {
+	var buf bytes.Buffer
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(c); err != nil {
+		return nil, errors.Wrap(err, "failed to marshal configtx to YAML")
+	}
+	if err := encoder.Close(); err != nil {
+		return nil, errors.Wrap(err, "failed to marshal configtx to YAML")
+	}
+	return buf.Bytes(), nil
+}
+
+// MaxBatchTimeout BatchTimeout으로 허용하는 최대 값
+const MaxBatchTimeout = 10 * time.Minute
+
+// ParseBatchTimeout BatchTimeout 문자열을 검증하여 time.Duration으로 변환 ("200ms" -> 200ms)
+// 0보다 크고 MaxBatchTimeout 이하인 값만 허용한다.
+func ParseBatchTimeout(s string) (time.Duration, error) {
+	timeout, err := time.ParseDuration(s)
+	if err != nil {
+		return 0, errors.Errorf("invalid BatchTimeout %q: must be a duration such as 200ms or 2s", s)
+	}
+	if timeout <= 0 {
+		return 0, errors.Errorf("invalid BatchTimeout %q: must be positive", s)
+	}
+	if timeout > MaxBatchTimeout {
+		return 0, errors.Errorf("invalid BatchTimeout %q: must not exceed %s", s, MaxBatchTimeout)
+	}
+	return timeout, nil
+}
+
+// ParseBatchSizeBytes 크기 문자열을 바이트 수로 변환 ("128 MB", "128MB" -> 134217728)
 func ParseBatchSizeBytes(sizeStr string) (uint32, error) {
+	sizeStr = strings.TrimSpace(sizeStr)
 	if sizeStr == "" {
 		return 0, errors.New("size string cannot be empty")
 	}
 
-	var value uint32
-	var unit string
-
-	// 숫자와 단위 분리
-	n, err := fmt.Sscanf(sizeStr, "%d %s", &value, &unit)
-	if err != nil || n != 2 {
-		// 단위 없이 숫자만 있는 경우
-		if n, err := fmt.Sscanf(sizeStr, "%d", &value); err != nil || n != 1 {
-			return 0, errors.Errorf("failed to parse size: %s", sizeStr)
-		}
-		return value, nil
+	// 숫자와 단위 분리 (단위 앞 공백은 선택)
+	digits := len(sizeStr) - len(strings.TrimLeft(sizeStr, "0123456789"))
+	value, err := strconv.ParseUint(sizeStr[:digits], 10, 32)
+	if err != nil {
+		return 0, errors.Errorf("failed to parse size: %s", sizeStr)
 	}
+	unit := strings.TrimSpace(sizeStr[digits:])
 
 	// 단위에 따른 배수 적용
+	var multiplier uint64
 	switch unit {
+	case "":
+		multiplier = 1
 	case "KB":
-		return value * 1024, nil
+		multiplier = 1024
 	case "MB":
-		return value * 1024 * 1024, nil
+		multiplier = 1024 * 1024
 	case "GB":
-		return value * 1024 * 1024 * 1024, nil
+		multiplier = 1024 * 1024 * 1024
 	default:
 		return 0, errors.Errorf("unsupported size unit: %s", unit)
 	}
+	if value*multiplier > math.MaxUint32 {
+		return 0, errors.Errorf("size %s exceeds %d bytes", sizeStr, uint32(math.MaxUint32))
+	}
+	return uint32(value * multiplier), nil
 }
@@ -0,0 +1,264 @@
+package configtx
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ddr4869/minifab/common/msp/msptest"
+)
+
+func TestOrganizationValidate(t *testing.T) {
+	mspDir := msptest.NewOrg(t, "Org1MSP").WriteMSPDir(t, filepath.Join(t.TempDir(), "msp"))
+
+	// signcerts가 없는 MSP 디렉터리
+	incompleteDir := t.TempDir()
+	for _, dir := range []string{"cacerts", "keystore"} {
+		if err := os.MkdirAll(filepath.Join(incompleteDir, dir), 0755); err != nil {
+			t.Fatal(err)
+		}
+	}
+	notDir := filepath.Join(t.TempDir(), "file")
+	if err := os.WriteFile(notDir, nil, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		org     Organization
+		wantErr string
+	}{
+		{"valid", Organization{Name: "Org1", ID: "Org1MSP", MSPDir: mspDir}, ""},
+		{"valid with anchor peer", Organization{Name: "Org1", ID: "Org1MSP", MSPDir: mspDir, AnchorPeers: []AnchorPeer{{Host: "127.0.0.1", Port: 7051}}}, ""},
+		{"empty name", Organization{ID: "Org1MSP", MSPDir: mspDir}, "name cannot be empty"},
+		{"empty ID", Organization{Name: "Org1", MSPDir: mspDir}, "invalid MSP ID"},
+		{"ID starting with digit", Organization{Name: "Org1", ID: "1OrgMSP", MSPDir: mspDir}, "invalid MSP ID"},
+		{"ID with space", Organization{Name: "Org1", ID: "Org1 MSP", MSPDir: mspDir}, "invalid MSP ID"},
+		{"empty MSPDir", Organization{Name: "Org1", ID: "Org1MSP"}, "MSPDir cannot be empty"},
+		{"missing MSPDir", Organization{Name: "Org1", ID: "Org1MSP", MSPDir: filepath.Join(mspDir, "missing")}, "MSPDir does not exist"},
+		{"MSPDir is a file", Organization{Name: "Org1", ID: "Org1MSP", MSPDir: notDir}, "MSPDir does not exist"},
+		{"missing signcerts", Organization{Name: "Org1", ID: "Org1MSP", MSPDir: incompleteDir}, "required directory missing: signcerts"},
+		{"invalid anchor peer", Organization{Name: "Org1", ID: "Org1MSP", MSPDir: mspDir, AnchorPeers: []AnchorPeer{{Host: "127.0.0.1"}}}, "invalid AnchorPeers[0]"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.org.Validate()
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("Validate: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("Validate error = %v, want it to contain %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestConvertConfigtxAggregatesOrganizationErrors(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "configtx.yaml")
+	content := `Organizations:
+  - Name: Org1
+    ID: 1BadMSP
+    MSPDir: /nonexistent/org1
+  - Name: Org2
+    ID: Org2MSP
+    MSPDir: /nonexistent/org2
+`
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err := ConvertConfigtx(path)
+	if err == nil {
+		t.Fatal("ConvertConfigtx succeeded with invalid organizations")
+	}
+	for _, want := range []string{"organization Org1: invalid MSP ID", "organization Org2: MSPDir does not exist"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("error %q does not contain %q", err, want)
+		}
+	}
+}
+
+func TestParseBatchTimeout(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    time.Duration
+		wantErr string
+	}{
+		{"200ms", 200 * time.Millisecond, ""},
+		{"2s", 2 * time.Second, ""},
+		{"1m30s", 90 * time.Second, ""},
+		{"10m", MaxBatchTimeout, ""},
+		{"2ss", 0, "must be a duration"},
+		{"two seconds", 0, "must be a duration"},
+		{"", 0, "must be a duration"},
+		{"2", 0, "must be a duration"},
+		{"0s", 0, "must be positive"},
+		{"-1s", 0, "must be positive"},
+		{"10m1s", 0, "must not exceed 10m0s"},
+		{"1h", 0, "must not exceed 10m0s"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := ParseBatchTimeout(tt.input)
+			if tt.wantErr == "" {
+				if err != nil || got != tt.want {
+					t.Fatalf("ParseBatchTimeout(%q) = %v, %v, want %v", tt.input, got, err, tt.want)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) || !strings.Contains(err.Error(), tt.input) {
+				t.Fatalf("ParseBatchTimeout(%q) error = %v, want it to contain %q and the value", tt.input, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestGetSystemChannelInfoRejectsInvalidBatchTimeout(t *testing.T) {
+	content := "Profiles:\n  SystemChannel:\n    Orderer:\n      BatchTimeout: %s\n"
+	configTx := parseTestConfigTx(t, fmt.Sprintf(content, "2ss"))
+	if _, err := configTx.GetSystemChannelInfo("SystemChannel"); err == nil || !strings.Contains(err.Error(), `profile 'SystemChannel': invalid BatchTimeout "2ss"`) {
+		t.Errorf("GetSystemChannelInfo error = %v", err)
+	}
+
+	configTx = parseTestConfigTx(t, fmt.Sprintf(content, "500ms"))
+	info, err := configTx.GetSystemChannelInfo("SystemChannel")
+	if err != nil {
+		t.Fatalf("GetSystemChannelInfo: %v", err)
+	}
+	if info.Orderer.BatchTimeout != "500ms" {
+		t.Errorf("BatchTimeout = %q, want 500ms", info.Orderer.BatchTimeout)
+	}
+}
+
+func TestParseBatchSizeBytes(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    uint32
+		wantErr string
+	}{
+		{"512", 512, ""},
+		{"2 KB", 2048, ""},
+		{"128MB", 128 * 1024 * 1024, ""},
+		{" 10 MB ", 10 * 1024 * 1024, ""},
+		{"3GB", 3 * 1024 * 1024 * 1024, ""},
+		{"", 0, "cannot be empty"},
+		{"MB", 0, "failed to parse size"},
+		{"12 TB", 0, "unsupported size unit"},
+		{"4GB", 0, "exceeds"},
+		{"99999999999", 0, "failed to parse size"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := ParseBatchSizeBytes(tt.input)
+			if tt.wantErr == "" {
+				if err != nil || got != tt.want {
+					t.Fatalf("ParseBatchSizeBytes(%q) = %d, %v, want %d", tt.input, got, err, tt.want)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("ParseBatchSizeBytes(%q) error = %v, want it to contain %q", tt.input, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestGetConsortiumOrgs(t *testing.T) {
+	dir := t.TempDir()
+	orgs := map[string]*msptest.Org{}
+	mspDirs := map[string]string{}
+	for _, mspID := range []string{"OrdererMSP", "Org1MSP", "Org2MSP", "Org3MSP"} {
+		orgs[mspID] = msptest.NewOrg(t, mspID)
+		mspDirs[mspID] = orgs[mspID].WriteMSPDir(t, filepath.Join(dir, mspID))
+	}
+	configTx := parseTestConfigTx(t, fmt.Sprintf(`Organizations:
+  - &OrdererOrg
+    Name: OrdererOrg
+    ID: OrdererMSP
+    MSPDir: %s
+  - &Org1
+    Name: Org1
+    ID: Org1MSP
+    MSPDir: %s
+  - &Org2
+    Name: Org2
+    ID: Org2MSP
+    MSPDir: %s
+  - &Org3
+    Name: Org3
+    ID: Org3MSP
+    MSPDir: %s
+Profiles:
+  SystemChannel:
+    Orderer:
+      BatchTimeout: 2s
+      Organizations:
+        - *OrdererOrg
+    Consortiums:
+      SampleConsortium:
+        Organizations:
+          - *Org1
+          - *Org2
+      AnotherConsortium:
+        Organizations:
+          - *Org2
+          - *Org3
+`, mspDirs["OrdererMSP"], mspDirs["Org1MSP"], mspDirs["Org2MSP"], mspDirs["Org3MSP"]))
+
+	tests := []struct {
+		consortium string
+		want       []string
+	}{
+		{"SampleConsortium", []string{"Org1MSP", "Org2MSP"}},
+		{"AnotherConsortium", []string{"Org2MSP", "Org3MSP"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.consortium, func(t *testing.T) {
+			consortiumOrgs, err := configTx.GetConsortiumOrgs(tt.consortium)
+			if err != nil {
+				t.Fatalf("GetConsortiumOrgs: %v", err)
+			}
+			var got []string
+			for _, org := range consortiumOrgs {
+				got = append(got, org.ID)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetConsortiumOrgs(%s) = %v, want %v", tt.consortium, got, tt.want)
+			}
+		})
+	}
+	if _, err := configTx.GetConsortiumOrgs("MissingConsortium"); err == nil {
+		t.Error("GetConsortiumOrgs(MissingConsortium) succeeded, want error")
+	}
+
+	// 시스템 채널 정보는 consortium들의 조직을 이름 순으로 중복 없이 펼친다
+	info, err := configTx.GetSystemChannelInfo("SystemChannel")
+	if err != nil {
+		t.Fatalf("GetSystemChannelInfo: %v", err)
+	}
+	wantMembers := map[string][]string{
+		"AnotherConsortium": {"Org2MSP", "Org3MSP"},
+		"SampleConsortium":  {"Org1MSP", "Org2MSP"},
+	}
+	if !reflect.DeepEqual(info.ConsortiumMembers, wantMembers) {
+		t.Errorf("ConsortiumMembers = %v, want %v", info.ConsortiumMembers, wantMembers)
+	}
+	var gotIDs []string
+	for _, org := range info.Consortiums {
+		gotIDs = append(gotIDs, org.ID)
+		if !bytes.Equal(org.MSPCaCert, orgs[org.ID].CACert.Raw) {
+			t.Errorf("%s has MSPCaCert from a different CA", org.ID)
+		}
+	}
+	if want := []string{"Org2MSP", "Org3MSP", "Org1MSP"}; !reflect.DeepEqual(gotIDs, want) {
+		t.Errorf("Consortiums = %v, want %v", gotIDs, want)
+	}
+}
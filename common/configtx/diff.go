@@ -0,0 +1,130 @@
+package configtx
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+)
+
+// Diff other와 비교하여 변경된 항목을 사람이 읽을 수 있는 문자열 목록으로 반환 (c가 이전, other가 새 설정)
+// 예: "Organizations[1].MSPDir changed: old/path → new/path", "Policies[Readers].Rule changed"
+func (c *AppChannelConfig) Diff(other *AppChannelConfig) []string {
+	if c == nil {
+		c = &AppChannelConfig{}
+	}
+	if other == nil {
+		other = &AppChannelConfig{}
+	}
+
+	var changes []string
+	changes = append(changes, diffOrganizations(c.Organizations, other.Organizations)...)
+	changes = append(changes, diffPolicies(c.Policies, other.Policies)...)
+	return changes
+}
+
+func diffOrganizations(oldOrgs, newOrgs []Organization) []string {
+	var changes []string
+	for i := 0; i < len(oldOrgs) || i < len(newOrgs); i++ {
+		switch {
+		case i >= len(newOrgs):
+			changes = append(changes, fmt.Sprintf("Organizations[%d] removed: %s", i, oldOrgs[i].Name))
+		case i >= len(oldOrgs):
+			changes = append(changes, fmt.Sprintf("Organizations[%d] added: %s", i, newOrgs[i].Name))
+		default:
+			changes = append(changes, diffFields(fmt.Sprintf("Organizations[%d]", i), oldOrgs[i], newOrgs[i])...)
+		}
+	}
+	return changes
+}
+
+// diffFields 같은 타입 구조체의 export 필드를 하나씩 비교 (문자열/숫자 필드는 이전/새 값을 함께 표시)
+func diffFields(prefix string, oldValue, newValue interface{}) []string {
+	oldStruct := reflect.ValueOf(oldValue)
+	newStruct := reflect.ValueOf(newValue)
+
+	var changes []string
+	for i := 0; i < oldStruct.NumField(); i++ {
+		field := oldStruct.Type().Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		oldField := oldStruct.Field(i).Interface()
+		newField := newStruct.Field(i).Interface()
+		if reflect.DeepEqual(oldField, newField) {
+			continue
+		}
+
+		switch oldStruct.Field(i).Kind() {
+		case reflect.String, reflect.Int, reflect.Int32, reflect.Int64, reflect.Uint, reflect.Uint32, reflect.Uint64, reflect.Bool:
+			changes = append(changes, fmt.Sprintf("%s.%s changed: %v → %v", prefix, field.Name, oldField, newField))
+		default:
+			changes = append(changes, fmt.Sprintf("%s.%s changed", prefix, field.Name))
+		}
+	}
+	return changes
+}
+
+// diffPolicies 정책 이름별로 비교 (정책이 Type/Rule 구조이면 항목별로 표시)
+func diffPolicies(oldPolicies, newPolicies interface{}) []string {
+	if reflect.DeepEqual(oldPolicies, newPolicies) {
+		return nil
+	}
+	oldMap, oldOK := oldPolicies.(map[string]interface{})
+	newMap, newOK := newPolicies.(map[string]interface{})
+	if !oldOK || !newOK {
+		return []string{"Policies changed"}
+	}
+
+	names := make([]string, 0, len(oldMap)+len(newMap))
+	for name := range oldMap {
+		names = append(names, name)
+	}
+	for name := range newMap {
+		if _, exists := oldMap[name]; !exists {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	var changes []string
+	for _, name := range names {
+		oldPolicy, inOld := oldMap[name]
+		newPolicy, inNew := newMap[name]
+		switch {
+		case !inNew:
+			changes = append(changes, fmt.Sprintf("Policies[%s] removed", name))
+		case !inOld:
+			changes = append(changes, fmt.Sprintf("Policies[%s] added", name))
+		case !reflect.DeepEqual(oldPolicy, newPolicy):
+			changes = append(changes, diffPolicy(name, oldPolicy, newPolicy)...)
+		}
+	}
+	return changes
+}
+
+func diffPolicy(name string, oldPolicy, newPolicy interface{}) []string {
+	oldFields, oldOK := oldPolicy.(map[string]interface{})
+	newFields, newOK := newPolicy.(map[string]interface{})
+	if !oldOK || !newOK {
+		return []string{fmt.Sprintf("Policies[%s] changed", name)}
+	}
+
+	keys := make([]string, 0, len(oldFields)+len(newFields))
+	for key := range oldFields {
+		keys = append(keys, key)
+	}
+	for key := range newFields {
+		if _, exists := oldFields[key]; !exists {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	var changes []string
+	for _, key := range keys {
+		if !reflect.DeepEqual(oldFields[key], newFields[key]) {
+			changes = append(changes, fmt.Sprintf("Policies[%s].%s changed", name, key))
+		}
+	}
+	return changes
+}
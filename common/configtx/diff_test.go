@@ -0,0 +1,74 @@
+package configtx
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestAppChannelConfigDiff(t *testing.T) {
+	oldConfig := &AppChannelConfig{
+		Policies: map[string]interface{}{
+			"Readers": map[string]interface{}{"Type": "Signature", "Rule": "OR('Org1MSP.member')"},
+			"Writers": map[string]interface{}{"Type": "Signature", "Rule": "OR('Org1MSP.member')"},
+		},
+		Organizations: []Organization{
+			{Name: "Org1", ID: "Org1MSP", MSPDir: "/msp/org1"},
+			{Name: "Org2", ID: "Org2MSP", MSPDir: "old/path", AnchorPeers: []AnchorPeer{{Host: "peer0.org2", Port: 7051}}},
+		},
+	}
+	newConfig := &AppChannelConfig{
+		Policies: map[string]interface{}{
+			"Readers": map[string]interface{}{"Type": "Signature", "Rule": "OR('Org1MSP.member', 'Org2MSP.member')"},
+			"Writers": map[string]interface{}{"Type": "Signature", "Rule": "OR('Org1MSP.member')"},
+		},
+		Organizations: []Organization{
+			{Name: "Org1", ID: "Org1MSP", MSPDir: "/msp/org1"},
+			{Name: "Org2", ID: "Org2MSP", MSPDir: "new/path", AnchorPeers: []AnchorPeer{{Host: "peer1.org2", Port: 7051}}},
+		},
+	}
+
+	want := []string{
+		"Organizations[1].MSPDir changed: old/path → new/path",
+		"Organizations[1].AnchorPeers changed",
+		"Policies[Readers].Rule changed",
+	}
+	if got := oldConfig.Diff(newConfig); !reflect.DeepEqual(got, want) {
+		t.Errorf("Diff() = %q, want %q", got, want)
+	}
+	if got := oldConfig.Diff(oldConfig); len(got) != 0 {
+		t.Errorf("Diff() of identical configs = %q, want none", got)
+	}
+}
+
+func TestAppChannelConfigDiffAddedAndRemoved(t *testing.T) {
+	oldConfig := &AppChannelConfig{
+		Policies:      map[string]interface{}{"Readers": "all", "Admins": "all"},
+		Organizations: []Organization{{Name: "Org1", ID: "Org1MSP"}, {Name: "Org2", ID: "Org2MSP"}},
+	}
+	newConfig := &AppChannelConfig{
+		Policies:      map[string]interface{}{"Readers": "any", "Writers": "all"},
+		Organizations: []Organization{{Name: "Org1", ID: "Org1MSP"}},
+	}
+
+	want := []string{
+		"Organizations[1] removed: Org2",
+		"Policies[Admins] removed",
+		"Policies[Readers] changed",
+		"Policies[Writers] added",
+	}
+	if got := oldConfig.Diff(newConfig); !reflect.DeepEqual(got, want) {
+		t.Errorf("Diff() = %q, want %q", got, want)
+	}
+	if got := newConfig.Diff(oldConfig); len(got) != 4 || got[0] != "Organizations[1] added: Org2" {
+		t.Errorf("reverse Diff() = %q", got)
+	}
+
+	// 단순 문자열 정책과 nil 설정도 비교할 수 있다
+	if got := (&AppChannelConfig{Policies: "all"}).Diff(&AppChannelConfig{Policies: "any"}); !reflect.DeepEqual(got, []string{"Policies changed"}) {
+		t.Errorf("Diff() of string policies = %q", got)
+	}
+	var empty *AppChannelConfig
+	if got := empty.Diff(&AppChannelConfig{Organizations: []Organization{{Name: "Org1"}}}); !reflect.DeepEqual(got, []string{"Organizations[0] added: Org1"}) {
+		t.Errorf("Diff() from nil config = %q", got)
+	}
+}
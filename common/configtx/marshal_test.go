@@ -0,0 +1,107 @@
+package configtx
+
+import (
+	"fmt"
+	"math/rand"
+	"os"
+	"reflect"
+	"testing"
+	"testing/quick"
+)
+
+// assertYAMLRoundTrip configTx를 MarshalToYAML로 직렬화한 뒤 다시 파싱한 결과가 원래 값과 같은지 확인
+func assertYAMLRoundTrip(t *testing.T, configTx *ConfigTx) bool {
+	t.Helper()
+
+	data, err := configTx.MarshalToYAML()
+	if err != nil {
+		t.Errorf("MarshalToYAML: %v", err)
+		return false
+	}
+	if got := parseTestConfigTx(t, string(data)); !reflect.DeepEqual(got, configTx) {
+		t.Errorf("round-tripped configtx = %+v, want %+v\nYAML:\n%s", got, configTx, data)
+		return false
+	}
+	return true
+}
+
+// randomConfigTx r로 임의의 조직 이름과 batch size를 가진 ConfigTx 생성
+// Profiles는 yaml.Unmarshal이 만드는 것과 같은 타입(map[string]interface{}, []interface{}, int)으로 구성한다.
+func randomConfigTx(r *rand.Rand) *ConfigTx {
+	randomName := func(prefix string) string {
+		const letters = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789-_"
+		name := []byte(prefix)
+		for i := 0; i < 1+r.Intn(12); i++ {
+			name = append(name, letters[r.Intn(len(letters))])
+		}
+		return string(name)
+	}
+	randomBytes := func() string {
+		return fmt.Sprintf("%d %s", 1+r.Intn(1024), []string{"KB", "MB", "GB"}[r.Intn(3)])
+	}
+
+	configTx := &ConfigTx{
+		Orderer: OrdererConfig{
+			BatchTimeout: fmt.Sprintf("%ds", 1+r.Intn(600)),
+			BatchSize: BatchSize{
+				MaxMessageCount:   r.Intn(10000),
+				AbsoluteMaxBytes:  randomBytes(),
+				PreferredMaxBytes: randomBytes(),
+			},
+		},
+	}
+	var profileOrgs []interface{}
+	for i := 0; i < r.Intn(5); i++ {
+		org := Organization{Name: randomName("Org"), ID: randomName("MSP"), MSPDir: "/msp/" + randomName("org")}
+		if r.Intn(2) == 0 {
+			org.AnchorPeers = []AnchorPeer{{Host: randomName("peer") + ".example.com", Port: 1 + r.Intn(65535)}}
+		}
+		configTx.Organizations = append(configTx.Organizations, org)
+		profileOrgs = append(profileOrgs, map[string]interface{}{"Name": org.Name, "ID": org.ID, "MSPDir": org.MSPDir})
+	}
+	if r.Intn(2) == 0 {
+		configTx.Channel.Policies = "all"
+		configTx.Channel.Organizations = configTx.Organizations
+	}
+	if r.Intn(2) == 0 {
+		configTx.Profiles = map[string]interface{}{
+			randomName("Profile"): map[string]interface{}{
+				"Orderer": map[string]interface{}{
+					"BatchTimeout": configTx.Orderer.BatchTimeout,
+					"BatchSize":    map[string]interface{}{"MaxMessageCount": configTx.Orderer.BatchSize.MaxMessageCount},
+				},
+			},
+		}
+		if len(profileOrgs) > 0 {
+			configTx.Profiles[randomName("AppProfile")] = map[string]interface{}{
+				"Application": map[string]interface{}{"Organizations": profileOrgs},
+			}
+		}
+	}
+	return configTx
+}
+
+func TestMarshalToYAMLRoundTripProperty(t *testing.T) {
+	roundTrip := func(seed int64) bool {
+		return assertYAMLRoundTrip(t, randomConfigTx(rand.New(rand.NewSource(seed))))
+	}
+	if err := quick.Check(roundTrip, &quick.Config{MaxCount: 300}); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestMarshalToYAMLRoundTrip(t *testing.T) {
+	data, err := os.ReadFile("../../config/configtx.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Run("sample configtx", func(t *testing.T) {
+		assertYAMLRoundTrip(t, parseTestConfigTx(t, string(data)))
+	})
+	t.Run("configtx with anchors", func(t *testing.T) {
+		assertYAMLRoundTrip(t, parseTestConfigTx(t, resolverTestConfigTx))
+	})
+	t.Run("empty configtx", func(t *testing.T) {
+		assertYAMLRoundTrip(t, &ConfigTx{})
+	})
+}
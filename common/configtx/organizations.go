@@ -0,0 +1,58 @@
+package configtx
+
+import "github.com/pkg/errors"
+
+// ErrOrgNotFound 채널 설정에 해당 이름의 조직이 없음
+var ErrOrgNotFound = errors.New("organization not found")
+
+// AddOrganization 채널 설정에 조직 추가
+// 이름과 MSP ID가 비어 있거나 기존 조직과 이름 또는 MSP ID가 겹치면 추가하지 않고 에러를 반환한다.
+func (c *AppChannelConfig) AddOrganization(org Organization) error {
+	if err := validateNewOrganization(c.Organizations, org); err != nil {
+		return err
+	}
+	c.Organizations = append(c.Organizations, org)
+	return nil
+}
+
+// ValidateOrganizations 모든 조직이 AddOrganization의 조건을 만족하는지 확인
+// 이름이나 MSP ID가 비어 있거나 앞의 조직과 겹치는 첫 번째 조직에 대한 에러를 반환한다.
+func (c *AppChannelConfig) ValidateOrganizations() error {
+	for i, org := range c.Organizations {
+		if err := validateNewOrganization(c.Organizations[:i], org); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateNewOrganization org를 existing 뒤에 추가할 수 있는지 확인
+func validateNewOrganization(existing []Organization, org Organization) error {
+	if org.Name == "" {
+		return errors.New("organization name cannot be empty")
+	}
+	if org.ID == "" {
+		return errors.Errorf("organization %s: MSP ID cannot be empty", org.Name)
+	}
+	for _, other := range existing {
+		if other.Name == org.Name {
+			return errors.Errorf("organization %s already exists", org.Name)
+		}
+		if other.ID == org.ID {
+			return errors.Errorf("organization %s: MSP ID %s is already used by organization %s", org.Name, org.ID, other.Name)
+		}
+	}
+	return nil
+}
+
+// RemoveOrganization 이름으로 채널 설정의 조직 삭제 (없으면 ErrOrgNotFound)
+func (c *AppChannelConfig) RemoveOrganization(name string) error {
+	for i, org := range c.Organizations {
+		if org.Name != name {
+			continue
+		}
+		c.Organizations = append(c.Organizations[:i:i], c.Organizations[i+1:]...)
+		return nil
+	}
+	return errors.Wrap(ErrOrgNotFound, name)
+}
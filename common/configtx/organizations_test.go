@@ -0,0 +1,93 @@
+package configtx
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/pkg/errors"
+)
+
+func TestAddOrganizationRejectsInvalidOrg(t *testing.T) {
+	tests := []struct {
+		name    string
+		org     Organization
+		wantErr string
+	}{
+		{"duplicate name", Organization{Name: "Org1", ID: "Org3MSP"}, "already exists"},
+		{"duplicate MSP ID", Organization{Name: "Org3", ID: "Org1MSP"}, "already used by organization Org1"},
+		{"empty name", Organization{ID: "Org3MSP"}, "name cannot be empty"},
+		{"empty MSP ID", Organization{Name: "Org3"}, "MSP ID cannot be empty"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := &AppChannelConfig{Organizations: []Organization{{Name: "Org1", ID: "Org1MSP"}, {Name: "Org2", ID: "Org2MSP"}}}
+			err := config.AddOrganization(tt.org)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("AddOrganization error = %v, want it to contain %q", err, tt.wantErr)
+			}
+			if len(config.Organizations) != 2 {
+				t.Errorf("rejected organization was added: %+v", config.Organizations)
+			}
+		})
+	}
+}
+
+func TestValidateOrganizations(t *testing.T) {
+	tests := []struct {
+		name    string
+		orgs    []Organization
+		wantErr string
+	}{
+		{"valid", []Organization{{Name: "Org1", ID: "Org1MSP"}, {Name: "Org2", ID: "Org2MSP"}}, ""},
+		{"no organizations", nil, ""},
+		{"duplicate name", []Organization{{Name: "Org1", ID: "Org1MSP"}, {Name: "Org1", ID: "Org2MSP"}}, "already exists"},
+		{"duplicate MSP ID", []Organization{{Name: "Org1", ID: "Org1MSP"}, {Name: "Org2", ID: "Org1MSP"}}, "already used by organization Org1"},
+		{"empty name", []Organization{{ID: "Org1MSP"}}, "name cannot be empty"},
+		{"empty MSP ID", []Organization{{Name: "Org1"}}, "MSP ID cannot be empty"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := (&AppChannelConfig{Organizations: tt.orgs}).ValidateOrganizations()
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("ValidateOrganizations: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("ValidateOrganizations error = %v, want it to contain %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestAddRemoveOrganization(t *testing.T) {
+	org1, org2, org3 := Organization{Name: "Org1", ID: "Org1MSP"}, Organization{Name: "Org2", ID: "Org2MSP"}, Organization{Name: "Org3", ID: "Org3MSP"}
+	config := &AppChannelConfig{}
+	for _, org := range []Organization{org1, org2, org3} {
+		if err := config.AddOrganization(org); err != nil {
+			t.Fatalf("AddOrganization(%s): %v", org.Name, err)
+		}
+	}
+	shared := config.Organizations
+
+	if err := config.RemoveOrganization("Org2"); err != nil {
+		t.Fatalf("RemoveOrganization: %v", err)
+	}
+	if !reflect.DeepEqual(config.Organizations, []Organization{org1, org3}) {
+		t.Errorf("organizations after remove = %+v, want Org1 and Org3", config.Organizations)
+	}
+	// 삭제 전 slice를 공유하던 복사본은 바뀌지 않는다
+	if !reflect.DeepEqual(shared, []Organization{org1, org2, org3}) {
+		t.Errorf("shared slice after remove = %+v, want Org1, Org2 and Org3", shared)
+	}
+
+	if err := config.RemoveOrganization("Org2"); !errors.Is(err, ErrOrgNotFound) {
+		t.Errorf("RemoveOrganization of a removed org error = %v, want ErrOrgNotFound", err)
+	}
+	// 삭제한 조직의 이름과 MSP ID는 다시 쓸 수 있다
+	if err := config.AddOrganization(org2); err != nil {
+		t.Errorf("AddOrganization after remove: %v", err)
+	}
+}
@@ -0,0 +1,189 @@
+package configtx
+
+import (
+	"sort"
+
+	"github.com/pkg/errors"
+)
+
+// ResolvedProfile 조직 참조가 ConfigTx.Organizations의 실제 조직으로 연결된 profile
+type ResolvedProfile struct {
+	Name                     string
+	OrdererOrganizations     []*Organization
+	ConsortiumOrganizations  []*Organization
+	ApplicationOrganizations []*Organization
+}
+
+// ProfileResolver profile에서 이름으로 참조한 조직을 ConfigTx.Organizations에서 찾아 연결
+// YAML anchor(*Org1)는 파싱 시 값으로 펼쳐지므로 조직의 Name으로 원래 조직을 찾는다.
+type ProfileResolver struct {
+	configTx      *ConfigTx
+	organizations map[string]*Organization
+}
+
+// NewProfileResolver ConfigTx의 조직을 이름별로 색인한 resolver 생성
+func NewProfileResolver(configTx *ConfigTx) (*ProfileResolver, error) {
+	organizations := make(map[string]*Organization, len(configTx.Organizations))
+	for i := range configTx.Organizations {
+		org := &configTx.Organizations[i]
+		if _, exists := organizations[org.Name]; exists {
+			return nil, errors.Errorf("duplicate organization name: %s", org.Name)
+		}
+		organizations[org.Name] = org
+	}
+
+	return &ProfileResolver{
+		configTx:      configTx,
+		organizations: organizations,
+	}, nil
+}
+
+// ResolveOrganization 이름으로 조직 조회
+func (r *ProfileResolver) ResolveOrganization(name string) (*Organization, error) {
+	org, exists := r.organizations[name]
+	if !exists {
+		return nil, errors.Errorf("organization '%s' not found", name)
+	}
+	return org, nil
+}
+
+// ResolveProfile profile의 orderer/consortium/application 조직 참조를 실제 조직으로 연결
+func (r *ProfileResolver) ResolveProfile(name string) (*ResolvedProfile, error) {
+	profileData, exists := r.configTx.Profiles[name]
+	if !exists {
+		return nil, errors.Errorf("profile '%s' not found", name)
+	}
+	profile, ok := profileData.(map[string]interface{})
+	if !ok {
+		return nil, errors.Errorf("profile '%s' is not a mapping", name)
+	}
+
+	resolved := &ResolvedProfile{Name: name}
+	var err error
+
+	if orderer, ok := profile["Orderer"].(map[string]interface{}); ok {
+		var refs []interface{}
+		if org, exists := orderer["Organization"]; exists {
+			refs = append(refs, org)
+		}
+		if orgs, exists := orderer["Organizations"]; exists {
+			refs = append(refs, orgs)
+		}
+		if resolved.OrdererOrganizations, err = r.resolveReferences(refs); err != nil {
+			return nil, errors.Wrapf(err, "profile '%s' orderer", name)
+		}
+	}
+
+	if consortiums, exists := profile["Consortiums"]; exists {
+		if resolved.ConsortiumOrganizations, err = r.resolveConsortiums(consortiums); err != nil {
+			return nil, errors.Wrapf(err, "profile '%s' consortiums", name)
+		}
+	}
+
+	if application, ok := profile["Application"].(map[string]interface{}); ok {
+		if resolved.ApplicationOrganizations, err = r.resolveReferences(application["Organizations"]); err != nil {
+			return nil, errors.Wrapf(err, "profile '%s' application", name)
+		}
+	}
+
+	return resolved, nil
+}
+
+// ResolveConsortium 모든 profile의 이름별 Consortiums mapping에서 consortium을 찾아 조직 목록 반환
+// 여러 profile에 같은 이름의 consortium이 있으면 profile 이름 순으로 처음 찾은 것을 사용한다.
+func (r *ProfileResolver) ResolveConsortium(consortiumName string) ([]*Organization, error) {
+	profileNames := make([]string, 0, len(r.configTx.Profiles))
+	for profileName := range r.configTx.Profiles {
+		profileNames = append(profileNames, profileName)
+	}
+	sort.Strings(profileNames)
+
+	for _, profileName := range profileNames {
+		profile, ok := r.configTx.Profiles[profileName].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		byName, ok := profile["Consortiums"].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if _, exists := byName[consortiumName]; !exists {
+			continue
+		}
+		orgs, err := r.resolveNamedConsortium(byName, consortiumName)
+		if err != nil {
+			return nil, errors.Wrapf(err, "profile '%s'", profileName)
+		}
+		return orgs, nil
+	}
+	return nil, errors.Errorf("consortium '%s' not found", consortiumName)
+}
+
+// resolveNamedConsortium 이름별 Consortiums mapping에서 하나의 consortium 조직 목록 연결
+func (r *ProfileResolver) resolveNamedConsortium(byName map[string]interface{}, consortiumName string) ([]*Organization, error) {
+	consortium, ok := byName[consortiumName].(map[string]interface{})
+	if !ok {
+		return nil, errors.Errorf("consortium '%s' is not a mapping", consortiumName)
+	}
+	orgs, err := r.resolveReferences(consortium["Organizations"])
+	if err != nil {
+		return nil, errors.Wrapf(err, "consortium '%s'", consortiumName)
+	}
+	return orgs, nil
+}
+
+// resolveConsortiums 조직 목록 또는 consortium 이름별 {Organizations} 형식 모두 지원
+func (r *ProfileResolver) resolveConsortiums(consortiums interface{}) ([]*Organization, error) {
+	byName, ok := consortiums.(map[string]interface{})
+	if !ok {
+		return r.resolveReferences(consortiums)
+	}
+
+	names := make([]string, 0, len(byName))
+	for consortiumName := range byName {
+		names = append(names, consortiumName)
+	}
+	sort.Strings(names)
+
+	var orgs []*Organization
+	for _, consortiumName := range names {
+		resolved, err := r.resolveNamedConsortium(byName, consortiumName)
+		if err != nil {
+			return nil, err
+		}
+		orgs = append(orgs, resolved...)
+	}
+	return orgs, nil
+}
+
+// resolveReferences 조직 이름 문자열, Name을 가진 조직 mapping, 또는 그 목록을 조직 포인터로 변환
+func (r *ProfileResolver) resolveReferences(refs interface{}) ([]*Organization, error) {
+	switch ref := refs.(type) {
+	case nil:
+		return nil, nil
+	case string:
+		org, err := r.ResolveOrganization(ref)
+		if err != nil {
+			return nil, err
+		}
+		return []*Organization{org}, nil
+	case map[string]interface{}:
+		name, ok := ref["Name"].(string)
+		if !ok {
+			return nil, errors.New("organization reference has no Name")
+		}
+		return r.resolveReferences(name)
+	case []interface{}:
+		var orgs []*Organization
+		for _, item := range ref {
+			resolved, err := r.resolveReferences(item)
+			if err != nil {
+				return nil, err
+			}
+			orgs = append(orgs, resolved...)
+		}
+		return orgs, nil
+	default:
+		return nil, errors.Errorf("unsupported organization reference type %T", refs)
+	}
+}
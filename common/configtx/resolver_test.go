@@ -0,0 +1,188 @@
+package configtx
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ddr4869/minifab/common/msp/msptest"
+	"gopkg.in/yaml.v3"
+)
+
+// parseTestConfigTx YAML 문자열을 ConvertConfigtx와 같은 방식으로 ConfigTx로 파싱 (MSP 로드 없음)
+func parseTestConfigTx(t *testing.T, content string) *ConfigTx {
+	t.Helper()
+
+	var configTx ConfigTx
+	if err := yaml.Unmarshal([]byte(content), &configTx); err != nil {
+		t.Fatalf("yaml.Unmarshal: %v", err)
+	}
+	return &configTx
+}
+
+const resolverTestConfigTx = `Organizations:
+  - &OrdererOrg
+    Name: OrdererOrg
+    ID: OrdererMSP
+    MSPDir: /msp/orderer
+  - &Org1
+    Name: Org1
+    ID: Org1MSP
+    MSPDir: /msp/org1
+  - &Org2
+    Name: Org2
+    ID: Org2MSP
+    MSPDir: /msp/org2
+Profiles:
+  SystemChannel:
+    Orderer:
+      Organizations:
+        - *OrdererOrg
+    Consortiums:
+      SampleConsortium:
+        Organizations:
+          - *Org1
+          - *Org2
+  TwoOrgsChannel:
+    Application:
+      Organizations:
+        - <<: *Org1
+        - Org2
+`
+
+// orgNames 조직 포인터 목록의 이름
+func orgNames(orgs []*Organization) string {
+	names := make([]string, len(orgs))
+	for i, org := range orgs {
+		names[i] = org.Name
+	}
+	return fmt.Sprint(names)
+}
+
+func TestResolveProfile(t *testing.T) {
+	configTx := parseTestConfigTx(t, resolverTestConfigTx)
+	resolver, err := NewProfileResolver(configTx)
+	if err != nil {
+		t.Fatalf("NewProfileResolver: %v", err)
+	}
+
+	system, err := resolver.ResolveProfile("SystemChannel")
+	if err != nil {
+		t.Fatalf("ResolveProfile(SystemChannel): %v", err)
+	}
+	if got := orgNames(system.OrdererOrganizations); got != "[OrdererOrg]" {
+		t.Errorf("orderer organizations = %s", got)
+	}
+	if got := orgNames(system.ConsortiumOrganizations); got != "[Org1 Org2]" {
+		t.Errorf("consortium organizations = %s", got)
+	}
+
+	// anchor merge와 이름 문자열 참조 모두 ConfigTx.Organizations의 조직을 가리킨다
+	application, err := resolver.ResolveProfile("TwoOrgsChannel")
+	if err != nil {
+		t.Fatalf("ResolveProfile(TwoOrgsChannel): %v", err)
+	}
+	if len(application.ApplicationOrganizations) != 2 {
+		t.Fatalf("application organizations = %s", orgNames(application.ApplicationOrganizations))
+	}
+	if application.ApplicationOrganizations[0] != &configTx.Organizations[1] || application.ApplicationOrganizations[1] != &configTx.Organizations[2] {
+		t.Error("application organizations do not point into ConfigTx.Organizations")
+	}
+
+	orgs, err := resolver.ResolveConsortium("SampleConsortium")
+	if err != nil {
+		t.Fatalf("ResolveConsortium: %v", err)
+	}
+	if got := orgNames(orgs); got != "[Org1 Org2]" {
+		t.Errorf("SampleConsortium organizations = %s", got)
+	}
+}
+
+func TestResolveProfileErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		profile string
+		wantErr string
+	}{
+		{"unknown organization name", "Application:\n      Organizations:\n        - Org3", "organization 'Org3' not found"},
+		{"unknown organization mapping", "Application:\n      Organizations:\n        - Name: Org3\n          ID: Org3MSP", "organization 'Org3' not found"},
+		{"mapping without name", "Application:\n      Organizations:\n        - ID: Org1MSP", "organization reference has no Name"},
+		{"unknown consortium organization", "Consortiums:\n      SampleConsortium:\n        Organizations:\n          - Org3", "consortium 'SampleConsortium'"},
+		{"unknown orderer organization", "Orderer:\n      Organization: Org3", "profile 'Broken' orderer"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			content := "Organizations:\n  - Name: Org1\n    ID: Org1MSP\nProfiles:\n  Broken:\n    " + tt.profile + "\n"
+			resolver, err := NewProfileResolver(parseTestConfigTx(t, content))
+			if err != nil {
+				t.Fatalf("NewProfileResolver: %v", err)
+			}
+			_, err = resolver.ResolveProfile("Broken")
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("ResolveProfile error = %v, want it to contain %q", err, tt.wantErr)
+			}
+		})
+	}
+
+	resolver, err := NewProfileResolver(parseTestConfigTx(t, resolverTestConfigTx))
+	if err != nil {
+		t.Fatalf("NewProfileResolver: %v", err)
+	}
+	if _, err := resolver.ResolveProfile("Missing"); err == nil || !strings.Contains(err.Error(), "profile 'Missing' not found") {
+		t.Errorf("ResolveProfile(Missing) error = %v", err)
+	}
+	if _, err := resolver.ResolveOrganization("Org3"); err == nil {
+		t.Error("ResolveOrganization(Org3) succeeded")
+	}
+
+	duplicate := "Organizations:\n  - Name: Org1\n    ID: Org1MSP\n  - Name: Org1\n    ID: Org1bMSP\n"
+	if _, err := NewProfileResolver(parseTestConfigTx(t, duplicate)); err == nil || !strings.Contains(err.Error(), "duplicate organization name: Org1") {
+		t.Errorf("NewProfileResolver with duplicate names error = %v", err)
+	}
+}
+
+func TestConvertConfigtxRejectsUnknownProfileOrganization(t *testing.T) {
+	dir := t.TempDir()
+	mspDir := msptest.NewOrg(t, "Org1MSP").WriteMSPDir(t, filepath.Join(dir, "org1"))
+	content := fmt.Sprintf(`Organizations:
+  - &Org1
+    Name: Org1
+    ID: Org1MSP
+    MSPDir: %s
+Profiles:
+  OneOrgChannel:
+    Application:
+      Organizations:
+        - *Org1
+  TwoOrgsChannel:
+    Application:
+      Organizations:
+        - *Org1
+        - Org2
+`, mspDir)
+	path := filepath.Join(dir, "configtx.yaml")
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err := ConvertConfigtx(path)
+	if err == nil {
+		t.Fatal("ConvertConfigtx succeeded with a profile referencing an undefined organization")
+	}
+	for _, want := range []string{"invalid profile", "profile 'TwoOrgsChannel'", "organization 'Org2' not found"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("error %q does not contain %q", err, want)
+		}
+	}
+
+	// 참조가 모두 정의된 profile만 있으면 성공
+	valid := strings.Replace(content, "        - Org2\n", "", 1)
+	if err := os.WriteFile(path, []byte(valid), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ConvertConfigtx(path); err != nil {
+		t.Fatalf("ConvertConfigtx: %v", err)
+	}
+}
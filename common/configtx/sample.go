@@ -0,0 +1,156 @@
+package configtx
+
+import (
+	"bytes"
+	"os"
+	"strconv"
+	"text/template"
+
+	"github.com/pkg/errors"
+)
+
+// SampleConfigTxOptions WriteSampleConfigTx가 생성하는 configtx.yaml의 조직/endpoint 설정
+// 비어 있는 필드는 DefaultSampleConfigTxOptions의 값을 사용한다.
+// 조직 이름만 지정하고 MSP ID를 비워 두면 MSP ID는 조직 이름 뒤에 "MSP"를 붙인 값이 된다.
+type SampleConfigTxOptions struct {
+	OrdererOrgName  string
+	OrdererMSPID    string
+	OrdererMSPDir   string
+	OrdererEndpoint string
+	PeerOrgName     string
+	PeerMSPID       string
+	PeerMSPDir      string
+	AnchorPeer      AnchorPeer
+	// Overwrite 이미 파일이 있으면 덮어씀 (false이면 에러)
+	Overwrite bool
+}
+
+// DefaultSampleConfigTxOptions 로컬 단일 orderer/peer 네트워크 기준 기본값
+func DefaultSampleConfigTxOptions() SampleConfigTxOptions {
+	return SampleConfigTxOptions{
+		OrdererOrgName:  "OrdererOrg",
+		OrdererMSPID:    "OrdererMSP",
+		OrdererMSPDir:   "./ca/OrdererOrg/ca-client/orderer0",
+		OrdererEndpoint: "127.0.0.1:7050",
+		PeerOrgName:     "Org1",
+		PeerMSPID:       "Org1MSP",
+		PeerMSPDir:      "./ca/Org1/ca-client/peer0",
+		AnchorPeer:      AnchorPeer{Host: "127.0.0.1", Port: 7051},
+	}
+}
+
+func (o SampleConfigTxOptions) withDefaults() SampleConfigTxOptions {
+	defaults := DefaultSampleConfigTxOptions()
+	if o.OrdererOrgName == "" {
+		o.OrdererOrgName = defaults.OrdererOrgName
+		if o.OrdererMSPID == "" {
+			o.OrdererMSPID = defaults.OrdererMSPID
+		}
+	}
+	if o.OrdererMSPID == "" {
+		o.OrdererMSPID = o.OrdererOrgName + "MSP"
+	}
+	if o.OrdererMSPDir == "" {
+		o.OrdererMSPDir = defaults.OrdererMSPDir
+	}
+	if o.OrdererEndpoint == "" {
+		o.OrdererEndpoint = defaults.OrdererEndpoint
+	}
+	if o.PeerOrgName == "" {
+		o.PeerOrgName = defaults.PeerOrgName
+		if o.PeerMSPID == "" {
+			o.PeerMSPID = defaults.PeerMSPID
+		}
+	}
+	if o.PeerMSPID == "" {
+		o.PeerMSPID = o.PeerOrgName + "MSP"
+	}
+	if o.PeerMSPDir == "" {
+		o.PeerMSPDir = defaults.PeerMSPDir
+	}
+	if o.AnchorPeer == (AnchorPeer{}) {
+		o.AnchorPeer = defaults.AnchorPeer
+	}
+	return o
+}
+
+var sampleConfigTxTemplate = template.Must(template.New("configtx").Funcs(template.FuncMap{
+	"quote": strconv.Quote,
+}).Parse(`Organizations:
+  - &OrdererOrg
+    Name: {{ quote .OrdererOrgName }}
+    ID: {{ quote .OrdererMSPID }}
+    MSPDir: {{ quote .OrdererMSPDir }}
+    OrdererEndpoints: [{{ quote .OrdererEndpoint }}]
+  - &PeerOrg
+    Name: {{ quote .PeerOrgName }}
+    ID: {{ quote .PeerMSPID }}
+    MSPDir: {{ quote .PeerMSPDir }}
+    AnchorPeers:
+      - Host: {{ quote .AnchorPeer.Host }}
+        Port: {{ .AnchorPeer.Port }}
+
+Orderer: &OrdererConfig
+  BatchTimeout: 2s
+  BatchSize:
+    MaxMessageCount: 10
+    AbsoluteMaxBytes: 10MB
+    PreferredMaxBytes: 2MB
+
+Channel: &ChannelConfig
+  Policies: all
+
+Profiles:
+  SystemChannel:
+    Orderer:
+      <<: *OrdererConfig
+      Organization: *OrdererOrg
+    Consortiums:
+      - *PeerOrg
+  AppChannel:
+    Application:
+      <<: *ChannelConfig
+      Organizations:
+        - *PeerOrg
+`))
+
+// WriteSampleConfigTx orderer 조직 하나, peer 조직 하나와 SystemChannel/AppChannel profile을 가진 configtx.yaml 생성
+func WriteSampleConfigTx(path string, opts SampleConfigTxOptions) error {
+	if path == "" {
+		return errors.New("configtx output path cannot be empty")
+	}
+	opts = opts.withDefaults()
+	if opts.OrdererOrgName == opts.PeerOrgName {
+		return errors.Errorf("orderer and peer organizations must have different names: %s", opts.OrdererOrgName)
+	}
+	if opts.OrdererMSPID == opts.PeerMSPID {
+		return errors.Errorf("orderer and peer organizations must have different MSP IDs: %s", opts.OrdererMSPID)
+	}
+	for _, mspID := range []string{opts.OrdererMSPID, opts.PeerMSPID} {
+		if !mspIDPattern.MatchString(mspID) {
+			return errors.Errorf("invalid MSP ID %q (must match %s)", mspID, mspIDPattern)
+		}
+	}
+	if err := opts.AnchorPeer.Validate(); err != nil {
+		return err
+	}
+
+	var buf bytes.Buffer
+	if err := sampleConfigTxTemplate.Execute(&buf, opts); err != nil {
+		return errors.Wrap(err, "failed to render sample configtx")
+	}
+
+	flags := os.O_WRONLY | os.O_CREATE | os.O_TRUNC
+	if !opts.Overwrite {
+		flags |= os.O_EXCL
+	}
+	file, err := os.OpenFile(path, flags, 0644)
+	if err != nil {
+		return errors.Wrapf(err, "failed to create configtx file: %s", path)
+	}
+	if _, err := file.Write(buf.Bytes()); err != nil {
+		file.Close()
+		return errors.Wrapf(err, "failed to write configtx file: %s", path)
+	}
+	return file.Close()
+}
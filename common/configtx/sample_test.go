@@ -0,0 +1,86 @@
+package configtx
+
+import (
+	"bytes"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ddr4869/minifab/common/msp/msptest"
+)
+
+func TestWriteSampleConfigTx(t *testing.T) {
+	dir := t.TempDir()
+	ordererOrg := msptest.NewOrg(t, "OrdererMSP")
+	peerOrg := msptest.NewOrg(t, "Org1MSP")
+	opts := DefaultSampleConfigTxOptions()
+	opts.OrdererMSPDir = ordererOrg.WriteMSPDir(t, filepath.Join(dir, "orderer"))
+	opts.PeerMSPDir = peerOrg.WriteMSPDir(t, filepath.Join(dir, "org1"))
+	opts.OrdererEndpoint = "127.0.0.1:8050"
+	path := filepath.Join(dir, "configtx.yaml")
+	if err := WriteSampleConfigTx(path, opts); err != nil {
+		t.Fatalf("WriteSampleConfigTx: %v", err)
+	}
+
+	configTx, err := ConvertConfigtx(path)
+	if err != nil {
+		t.Fatalf("ConvertConfigtx: %v", err)
+	}
+	info, err := configTx.GetSystemChannelInfo("SystemChannel")
+	if err != nil {
+		t.Fatalf("GetSystemChannelInfo: %v", err)
+	}
+	orderer := info.Orderer.Organization
+	if orderer.ID != "OrdererMSP" || !bytes.Equal(orderer.MSPCaCert, ordererOrg.CACert.Raw) {
+		t.Errorf("orderer organization = %s, want OrdererMSP with its CA cert", orderer.ID)
+	}
+	if len(orderer.OrdererEndpoints) != 1 || orderer.OrdererEndpoints[0] != "127.0.0.1:8050" {
+		t.Errorf("OrdererEndpoints = %v, want [127.0.0.1:8050]", orderer.OrdererEndpoints)
+	}
+	if info.Orderer.BatchTimeout != "2s" || info.Orderer.BatchSize.MaxMessageCount != 10 {
+		t.Errorf("orderer config = %+v, want 2s batch timeout and 10 messages", info.Orderer)
+	}
+	if len(info.Consortiums) != 1 || info.Consortiums[0].ID != "Org1MSP" || !bytes.Equal(info.Consortiums[0].MSPCaCert, peerOrg.CACert.Raw) {
+		t.Errorf("consortium = %+v, want Org1MSP with its CA cert", info.Consortiums)
+	}
+
+	app, err := configTx.GetAppChannelProfile("AppChannel")
+	if err != nil {
+		t.Fatalf("GetAppChannelProfile: %v", err)
+	}
+	if orgs := app.Application.Organizations; len(orgs) != 1 || orgs[0].ID != "Org1MSP" {
+		t.Errorf("AppChannel organizations = %+v, want Org1MSP", orgs)
+	}
+
+	// 기존 파일은 Overwrite 없이 덮어쓰지 않는다
+	if err := WriteSampleConfigTx(path, opts); err == nil {
+		t.Error("WriteSampleConfigTx overwrote an existing file without Overwrite")
+	}
+	opts.Overwrite = true
+	if err := WriteSampleConfigTx(path, opts); err != nil {
+		t.Errorf("WriteSampleConfigTx with Overwrite: %v", err)
+	}
+}
+
+func TestWriteSampleConfigTxRejectsInvalidOptions(t *testing.T) {
+	tests := []struct {
+		name    string
+		modify  func(*SampleConfigTxOptions)
+		wantErr string
+	}{
+		{"same org name", func(o *SampleConfigTxOptions) { o.PeerOrgName = o.OrdererOrgName }, "different names"},
+		{"same MSP ID", func(o *SampleConfigTxOptions) { o.PeerMSPID = o.OrdererMSPID }, "different MSP IDs"},
+		{"invalid MSP ID", func(o *SampleConfigTxOptions) { o.PeerMSPID = "Org 1" }, "invalid MSP ID"},
+		{"invalid anchor peer port", func(o *SampleConfigTxOptions) { o.AnchorPeer.Port = 70000 }, "invalid port"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := DefaultSampleConfigTxOptions()
+			tt.modify(&opts)
+			err := WriteSampleConfigTx(filepath.Join(t.TempDir(), "configtx.yaml"), opts)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("WriteSampleConfigTx error = %v, want it to contain %q", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,37 @@
+package crypto
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+
+	"github.com/pkg/errors"
+)
+
+// GenerateECDSAKey 지정한 curve로 ECDSA 개인키 생성
+func GenerateECDSAKey(curve elliptic.Curve) (*ecdsa.PrivateKey, error) {
+	if curve == nil {
+		return nil, errors.New("curve cannot be nil")
+	}
+	privateKey, err := ecdsa.GenerateKey(curve, rand.Reader)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to generate ECDSA key")
+	}
+	return privateKey, nil
+}
+
+// DefaultECDSAKey Fabric 기본 curve(P-256)로 ECDSA 개인키 생성
+func DefaultECDSAKey() (*ecdsa.PrivateKey, error) {
+	return GenerateECDSAKey(elliptic.P256())
+}
+
+// GenerateEd25519Key Ed25519 개인키 생성
+// Ed25519 키로 만든 Signer도 다른 키와 같이 메시지의 SHA256 해시에 서명한다.
+func GenerateEd25519Key() (ed25519.PrivateKey, error) {
+	_, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to generate Ed25519 key")
+	}
+	return privateKey, nil
+}
@@ -0,0 +1,72 @@
+package crypto
+
+import (
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"testing"
+)
+
+func TestGenerateECDSAKey(t *testing.T) {
+	for _, curve := range []elliptic.Curve{elliptic.P256(), elliptic.P384()} {
+		key, err := GenerateECDSAKey(curve)
+		if err != nil {
+			t.Fatalf("GenerateECDSAKey(%s): %v", curve.Params().Name, err)
+		}
+		if key.Curve != curve || !curve.IsOnCurve(key.X, key.Y) {
+			t.Fatalf("GenerateECDSAKey(%s) returned a key on the wrong curve", curve.Params().Name)
+		}
+	}
+	if _, err := GenerateECDSAKey(nil); err == nil {
+		t.Fatal("GenerateECDSAKey accepted a nil curve")
+	}
+
+	key, err := DefaultECDSAKey()
+	if err != nil {
+		t.Fatalf("DefaultECDSAKey: %v", err)
+	}
+	if key.Curve != elliptic.P256() {
+		t.Fatalf("DefaultECDSAKey curve = %s, want P-256", key.Curve.Params().Name)
+	}
+}
+
+func TestGenerateEd25519Key(t *testing.T) {
+	key, err := GenerateEd25519Key()
+	if err != nil {
+		t.Fatalf("GenerateEd25519Key: %v", err)
+	}
+	if len(key) != ed25519.PrivateKeySize {
+		t.Fatalf("Ed25519 private key is %d bytes, want %d", len(key), ed25519.PrivateKeySize)
+	}
+	other, err := GenerateEd25519Key()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if key.Equal(other) {
+		t.Fatal("GenerateEd25519Key returned the same key twice")
+	}
+}
+
+// BenchmarkKeyGeneration P-256, P-384, Ed25519 키 생성 비용 비교
+func BenchmarkKeyGeneration(b *testing.B) {
+	b.Run("P-256", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			if _, err := GenerateECDSAKey(elliptic.P256()); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	b.Run("P-384", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			if _, err := GenerateECDSAKey(elliptic.P384()); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	b.Run("Ed25519", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			if _, err := GenerateEd25519Key(); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
@@ -2,6 +2,7 @@ package logger
 
 import (
 	"fmt"
+	"net/http"
 	"sync"
 
 	"github.com/pkg/errors"
@@ -16,12 +17,15 @@ var (
 	loggerMutex sync.RWMutex
 	// Track if logger is initialized
 	initialized bool
+	// Runtime에 변경 가능한 로그 레벨 (SetLevel, LevelHandler에서 사용)
+	atomicLevel = zap.NewAtomicLevel()
 )
 
 // LogLevel represents the logging level
 type LogLevel string
 
 const (
+	TraceLevel LogLevel = "trace"
 	DebugLevel LogLevel = "debug"
 	InfoLevel  LogLevel = "info"
 	WarnLevel  LogLevel = "warn"
@@ -30,6 +34,28 @@ const (
 	FatalLevel LogLevel = "fatal"
 )
 
+// TraceZapLevel zap에는 trace 레벨이 없으므로 Debug(-1)보다 한 단계 낮은 레벨을 trace로 사용
+const TraceZapLevel = zapcore.Level(-2)
+
+// parseLevel LogLevel을 zap 레벨로 변환 ("trace"는 TraceZapLevel)
+func parseLevel(level LogLevel) (zapcore.Level, error) {
+	if level == TraceLevel {
+		return TraceZapLevel, nil
+	}
+	return zapcore.ParseLevel(string(level))
+}
+
+// levelEncoder TraceZapLevel을 "TRACE"로 출력하고 나머지 레벨은 base에 위임
+func levelEncoder(base zapcore.LevelEncoder) zapcore.LevelEncoder {
+	return func(level zapcore.Level, enc zapcore.PrimitiveArrayEncoder) {
+		if level == TraceZapLevel {
+			enc.AppendString("TRACE")
+			return
+		}
+		base(level, enc)
+	}
+}
+
 // Config holds the logger configuration
 type Config struct {
 	Level       LogLevel `json:"level"`
@@ -74,11 +100,12 @@ func Initialize(config *Config) error {
 	}
 
 	// Set log level
-	level, err := zapcore.ParseLevel(string(config.Level))
+	level, err := parseLevel(config.Level)
 	if err != nil {
 		return err
 	}
-	zapConfig.Level = zap.NewAtomicLevelAt(level)
+	atomicLevel.SetLevel(level)
+	zapConfig.Level = atomicLevel
 
 	// Set encoding
 	zapConfig.Encoding = config.Encoding
@@ -88,6 +115,7 @@ func Initialize(config *Config) error {
 	zapConfig.EncoderConfig.EncodeTime = zapcore.ISO8601TimeEncoder
 	zapConfig.EncoderConfig.CallerKey = "caller"
 	zapConfig.EncoderConfig.EncodeCaller = zapcore.ShortCallerEncoder
+	zapConfig.EncoderConfig.EncodeLevel = levelEncoder(zapConfig.EncoderConfig.EncodeLevel)
 
 	// Build logger
 	logger, err := zapConfig.Build(zap.AddCallerSkip(1))
@@ -151,11 +179,12 @@ func initialize(config *Config) error {
 	}
 
 	// Set log level
-	level, err := zapcore.ParseLevel(string(config.Level))
+	level, err := parseLevel(config.Level)
 	if err != nil {
 		return err
 	}
-	zapConfig.Level = zap.NewAtomicLevelAt(level)
+	atomicLevel.SetLevel(level)
+	zapConfig.Level = atomicLevel
 
 	// Set encoding
 	zapConfig.Encoding = config.Encoding
@@ -165,6 +194,7 @@ func initialize(config *Config) error {
 	zapConfig.EncoderConfig.EncodeTime = zapcore.ISO8601TimeEncoder
 	zapConfig.EncoderConfig.CallerKey = "caller"
 	zapConfig.EncoderConfig.EncodeCaller = zapcore.ShortCallerEncoder
+	zapConfig.EncoderConfig.EncodeLevel = levelEncoder(zapConfig.EncoderConfig.EncodeLevel)
 
 	// Build logger
 	logger, err := zapConfig.Build(zap.AddCallerSkip(1))
@@ -177,6 +207,47 @@ func initialize(config *Config) error {
 	return nil
 }
 
+// SetLevel 프로세스 재시작 없이 전역 logger의 로그 레벨 변경
+func SetLevel(level LogLevel) error {
+	zapLevel, err := parseLevel(level)
+	if err != nil {
+		return errors.Wrapf(err, "invalid log level: %s", level)
+	}
+	// 초기화 전이라면 기본 설정 초기화가 변경한 레벨을 덮어쓰지 않도록 먼저 초기화
+	GetLogger()
+	atomicLevel.SetLevel(zapLevel)
+	return nil
+}
+
+// GetLevel 현재 전역 logger의 로그 레벨 반환
+func GetLevel() LogLevel {
+	if atomicLevel.Level() == TraceZapLevel {
+		return TraceLevel
+	}
+	return LogLevel(atomicLevel.Level().String())
+}
+
+// TraceEnabled trace 로그가 출력되는 레벨인지 확인 (비용이 큰 trace 메시지 생성 전 확인용)
+func TraceEnabled() bool {
+	return atomicLevel.Enabled(TraceZapLevel)
+}
+
+// LevelHandler 로그 레벨을 조회(GET)/변경(PUT)하는 HTTP 핸들러
+// 요청/응답 형식은 zap.AtomicLevel.ServeHTTP를 따른다 (예: {"level":"debug"})
+func LevelHandler() http.Handler {
+	return atomicLevel
+}
+
+// Trace logs a trace message
+func Trace(args ...any) {
+	GetLogger().Log(TraceZapLevel, args...)
+}
+
+// Tracef logs a formatted trace message
+func Tracef(template string, args ...any) {
+	GetLogger().Logf(TraceZapLevel, template, args...)
+}
+
 // Debug logs a debug message
 func Debug(args ...any) {
 	GetLogger().Debug(args...)
@@ -0,0 +1,133 @@
+package logger
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// captureLogs 전역 logger를 atomicLevel을 따르는 buffer 출력 logger로 교체 (테스트 종료 시 복원)
+func captureLogs(t *testing.T, level LogLevel) *bytes.Buffer {
+	t.Helper()
+
+	loggerMutex.Lock()
+	previous := Logger
+	var buf bytes.Buffer
+	encoder := zapcore.NewConsoleEncoder(zapcore.EncoderConfig{MessageKey: "msg", LevelKey: "level", EncodeLevel: levelEncoder(zapcore.CapitalLevelEncoder)})
+	Logger = zap.New(zapcore.NewCore(encoder, zapcore.AddSync(&buf), atomicLevel)).Sugar()
+	loggerMutex.Unlock()
+
+	previousLevel := atomicLevel.Level()
+	if err := SetLevel(level); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		loggerMutex.Lock()
+		Logger = previous
+		loggerMutex.Unlock()
+		atomicLevel.SetLevel(previousLevel)
+	})
+	return &buf
+}
+
+func TestSetLevelEnablesDebugOutput(t *testing.T) {
+	buf := captureLogs(t, InfoLevel)
+
+	Debug("hidden debug message")
+	if strings.Contains(buf.String(), "hidden debug message") {
+		t.Fatalf("debug message logged at info level: %q", buf.String())
+	}
+
+	if err := SetLevel(DebugLevel); err != nil {
+		t.Fatalf("SetLevel: %v", err)
+	}
+	if GetLevel() != DebugLevel {
+		t.Errorf("GetLevel() = %s, want %s", GetLevel(), DebugLevel)
+	}
+	Debug("visible debug message")
+	if !strings.Contains(buf.String(), "visible debug message") {
+		t.Errorf("debug message missing after SetLevel(debug): %q", buf.String())
+	}
+}
+
+func TestSetLevelRejectsUnknownLevel(t *testing.T) {
+	captureLogs(t, WarnLevel)
+
+	if err := SetLevel("verbose"); err == nil {
+		t.Fatal("SetLevel accepted an unknown level")
+	}
+	if GetLevel() != WarnLevel {
+		t.Errorf("GetLevel() = %s after a rejected SetLevel, want %s", GetLevel(), WarnLevel)
+	}
+}
+
+func TestLevelHandlerPut(t *testing.T) {
+	buf := captureLogs(t, InfoLevel)
+
+	req := httptest.NewRequest(http.MethodPut, "/admin/loglevel", strings.NewReader(`{"level":"error"}`))
+	rec := httptest.NewRecorder()
+	LevelHandler().ServeHTTP(rec, req)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("PUT status = %d, body %q", rec.Code, rec.Body.String())
+	}
+	if GetLevel() != ErrorLevel {
+		t.Errorf("GetLevel() = %s, want %s", GetLevel(), ErrorLevel)
+	}
+
+	Warn("suppressed warning")
+	if strings.Contains(buf.String(), "suppressed warning") {
+		t.Errorf("warning logged at error level: %q", buf.String())
+	}
+
+	rec = httptest.NewRecorder()
+	LevelHandler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/admin/loglevel", nil))
+	if !strings.Contains(rec.Body.String(), `"level":"error"`) {
+		t.Errorf("GET body = %q, want the current level", rec.Body.String())
+	}
+}
+
+func TestTraceLevel(t *testing.T) {
+	buf := captureLogs(t, TraceLevel)
+
+	if GetLevel() != TraceLevel {
+		t.Errorf("GetLevel() = %s, want %s", GetLevel(), TraceLevel)
+	}
+	if !TraceEnabled() {
+		t.Error("TraceEnabled() = false at trace level")
+	}
+	Tracef("visible trace message %d", 1)
+	if !strings.Contains(buf.String(), "TRACE\tvisible trace message 1") {
+		t.Errorf("trace message missing at trace level: %q", buf.String())
+	}
+
+	if err := SetLevel(DebugLevel); err != nil {
+		t.Fatalf("SetLevel: %v", err)
+	}
+	if TraceEnabled() {
+		t.Error("TraceEnabled() = true at debug level")
+	}
+	Trace("hidden trace message")
+	Debug("visible debug message")
+	if strings.Contains(buf.String(), "hidden trace message") {
+		t.Errorf("trace message logged at debug level: %q", buf.String())
+	}
+	if !strings.Contains(buf.String(), "visible debug message") {
+		t.Errorf("debug message missing at debug level: %q", buf.String())
+	}
+}
+
+func TestInitializeTraceLevel(t *testing.T) {
+	captureLogs(t, InfoLevel)
+
+	if err := Initialize(&Config{Level: TraceLevel, Encoding: "console"}); err != nil {
+		t.Fatalf("Initialize(trace): %v", err)
+	}
+	if GetLevel() != TraceLevel || !TraceEnabled() {
+		t.Errorf("GetLevel() = %s after Initialize(trace), want %s", GetLevel(), TraceLevel)
+	}
+}
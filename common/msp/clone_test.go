@@ -0,0 +1,65 @@
+package msp_test
+
+import (
+	"crypto/x509"
+	"sync"
+	"testing"
+
+	"github.com/ddr4869/minifab/common/msp"
+	"github.com/ddr4869/minifab/common/msp/msptest"
+)
+
+// go test -race로 실행하면 복제본 읽기와 원본 재설정 사이의 data race를 확인할 수 있다
+func TestCloneConcurrentReadAndReload(t *testing.T) {
+	org := msptest.NewOrg(t, "Org1MSP")
+	newOrg := msptest.NewOrg(t, "Org1MSP")
+	tlsCert, _ := org.IssueTLS(t, "tls", "localhost")
+	newTLSCert, _ := newOrg.IssueTLS(t, "tls", "localhost")
+
+	original := msp.NewFabricMSP()
+	signingIdentity := org.SigningIdentity()
+	if err := original.Setup(&msp.MSPConfig{MSPID: "Org1MSP", SigningIdentity: &signingIdentity, RootCerts: org.CACert, TLSRootCerts: []*x509.Certificate{tlsCert}}); err != nil {
+		t.Fatalf("Setup: %v", err)
+	}
+
+	clones := make([]*msp.FabricMSP, 10)
+	for i := range clones {
+		clones[i] = original.Clone().(*msp.FabricMSP)
+	}
+
+	var wg sync.WaitGroup
+	for _, clone := range clones {
+		wg.Add(1)
+		go func(clone *msp.FabricMSP) {
+			defer wg.Done()
+			for i := 0; i < 100; i++ {
+				if len(clone.TLSRootCerts) != 1 || clone.TLSRootCerts[0] != tlsCert {
+					t.Error("clone TLS root certs changed during reload")
+					return
+				}
+				if clone.RootCerts != org.CACert || clone.MSPID != "Org1MSP" {
+					t.Error("clone root cert changed during reload")
+					return
+				}
+			}
+		}(clone)
+	}
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		newSigningIdentity := newOrg.SigningIdentity()
+		for i := 0; i < 100; i++ {
+			original.TLSRootCerts[0] = newTLSCert
+			if err := original.Setup(&msp.MSPConfig{MSPID: "Org1MSP", SigningIdentity: &newSigningIdentity, RootCerts: newOrg.CACert, TLSRootCerts: []*x509.Certificate{newTLSCert}}); err != nil {
+				t.Errorf("Setup: %v", err)
+				return
+			}
+		}
+	}()
+	wg.Wait()
+
+	if clones[0].SigningIdentity != signingIdentity {
+		t.Error("clone does not share the original signing identity")
+	}
+}
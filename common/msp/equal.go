@@ -0,0 +1,86 @@
+package msp
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/binary"
+	"sort"
+)
+
+// Equals MSPID, root 인증서, TLS root 인증서 DER이 모두 같으면 true
+// 인증서 순서는 비교에 영향을 주지 않는다. (NodeOUs 설정은 아직 지원하지 않아 비교하지 않음)
+func (msp *FabricMSP) Equals(other MSP) bool {
+	if other == nil {
+		return false
+	}
+	o, ok := other.(*FabricMSP)
+	if !ok {
+		hash, err := msp.HashConfig()
+		if err != nil {
+			return false
+		}
+		otherHash, err := other.HashConfig()
+		return err == nil && bytes.Equal(hash, otherHash)
+	}
+
+	return msp.MSPID == o.MSPID &&
+		equalDERs(sortedDERs(msp.rootCertList()), sortedDERs(o.rootCertList())) &&
+		equalDERs(sortedDERs(msp.TLSRootCerts), sortedDERs(o.TLSRootCerts))
+}
+
+// HashConfig SHA256(MSPID || 정렬된 root 인증서 DER || 정렬된 TLS root 인증서 DER)
+// 항목 경계가 모호하지 않도록 각 항목 앞에 4바이트 길이를 붙인다.
+func (msp *FabricMSP) HashConfig() ([]byte, error) {
+	hash := sha256.New()
+	writeField := func(data []byte) {
+		var length [4]byte
+		binary.BigEndian.PutUint32(length[:], uint32(len(data)))
+		hash.Write(length[:])
+		hash.Write(data)
+	}
+
+	writeField([]byte(msp.MSPID))
+	rootDERs := sortedDERs(msp.rootCertList())
+	tlsDERs := sortedDERs(msp.TLSRootCerts)
+	for _, group := range [][][]byte{rootDERs, tlsDERs} {
+		// 인증서 목록 사이 경계 표시를 위해 개수를 먼저 기록
+		var count [4]byte
+		binary.BigEndian.PutUint32(count[:], uint32(len(group)))
+		hash.Write(count[:])
+		for _, der := range group {
+			writeField(der)
+		}
+	}
+	return hash.Sum(nil), nil
+}
+
+func (msp *FabricMSP) rootCertList() []*x509.Certificate {
+	if msp.RootCerts == nil {
+		return nil
+	}
+	return []*x509.Certificate{msp.RootCerts}
+}
+
+func sortedDERs(certs []*x509.Certificate) [][]byte {
+	ders := make([][]byte, 0, len(certs))
+	for _, cert := range certs {
+		if cert != nil {
+			ders = append(ders, cert.Raw)
+		}
+	}
+	sort.Slice(ders, func(i, j int) bool { return bytes.Compare(ders[i], ders[j]) < 0 })
+	return ders
+}
+
+func equalDERs(a, b [][]byte) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !bytes.Equal(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
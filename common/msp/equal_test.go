@@ -0,0 +1,57 @@
+package msp_test
+
+import (
+	"bytes"
+	"crypto/x509"
+	"testing"
+
+	"github.com/ddr4869/minifab/common/msp"
+	"github.com/ddr4869/minifab/common/msp/msptest"
+)
+
+func TestMSPEquals(t *testing.T) {
+	org := msptest.NewOrg(t, "Org1MSP")
+	otherOrg := msptest.NewOrg(t, "Org1MSP")
+	tlsA, _ := org.IssueTLS(t, "tls-a", "localhost")
+	tlsB, _ := org.IssueTLS(t, "tls-b", "localhost")
+
+	base := &msp.FabricMSP{MSPID: "Org1MSP", RootCerts: org.CACert, TLSRootCerts: []*x509.Certificate{tlsA, tlsB}}
+	tests := []struct {
+		name  string
+		other *msp.FabricMSP
+		equal bool
+	}{
+		{"same config", &msp.FabricMSP{MSPID: "Org1MSP", RootCerts: org.CACert, TLSRootCerts: []*x509.Certificate{tlsA, tlsB}}, true},
+		{"TLS certs in different order", &msp.FabricMSP{MSPID: "Org1MSP", RootCerts: org.CACert, TLSRootCerts: []*x509.Certificate{tlsB, tlsA}}, true},
+		{"different MSP ID", &msp.FabricMSP{MSPID: "Org2MSP", RootCerts: org.CACert, TLSRootCerts: []*x509.Certificate{tlsA, tlsB}}, false},
+		{"different root cert", &msp.FabricMSP{MSPID: "Org1MSP", RootCerts: otherOrg.CACert, TLSRootCerts: []*x509.Certificate{tlsA, tlsB}}, false},
+		{"missing root cert", &msp.FabricMSP{MSPID: "Org1MSP", TLSRootCerts: []*x509.Certificate{tlsA, tlsB}}, false},
+		{"different TLS certs", &msp.FabricMSP{MSPID: "Org1MSP", RootCerts: org.CACert, TLSRootCerts: []*x509.Certificate{tlsA}}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := base.Equals(tt.other); got != tt.equal {
+				t.Errorf("Equals = %v, want %v", got, tt.equal)
+			}
+			if got := tt.other.Equals(base); got != tt.equal {
+				t.Errorf("reverse Equals = %v, want %v", got, tt.equal)
+			}
+
+			hash, err := base.HashConfig()
+			if err != nil {
+				t.Fatal(err)
+			}
+			otherHash, err := tt.other.HashConfig()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := bytes.Equal(hash, otherHash); got != tt.equal {
+				t.Errorf("HashConfig equal = %v, want %v", got, tt.equal)
+			}
+		})
+	}
+
+	if base.Equals(nil) {
+		t.Error("Equals(nil) = true, want false")
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/ddr4869/minifab/common/cert"
 	"github.com/pkg/errors"
 )
 
@@ -36,6 +37,16 @@ func NewIdentity(cert *x509.Certificate, pk crypto.PublicKey, mspID string) *ide
 	}
 }
 
+// NewIdentityFromCertPEM PEM 인증서만으로 서명 검증용 Identity 생성
+// MSP 없이 만들어지므로 발급 CA를 확인하지 않으며, MSP ID가 비어 있어 Validate는 항상 실패한다.
+func NewIdentityFromCertPEM(certPEM []byte) (Identity, error) {
+	x509Cert, err := cert.ParseCertificatePEM(certPEM)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse identity certificate")
+	}
+	return NewIdentity(x509Cert, x509Cert.PublicKey, ""), nil
+}
+
 func (id *identity) GetIdentifier() *IdentityIdentifier {
 	return id.id
 }
@@ -52,6 +63,9 @@ func (id *identity) Validate() error {
 	if id.cert == nil {
 		return errors.New("certificate cannot be nil")
 	}
+	if id.id.Mspid == "" {
+		return errors.New("identity is not backed by an MSP")
+	}
 
 	// 인증서 유효성 검사
 	if id.cert.NotAfter.Before(time.Now()) {
@@ -82,8 +96,13 @@ func (id *identity) Verify(msg []byte, sig []byte) error {
 		return errors.New("public key cannot be nil")
 	}
 
-	// 실제 구현에서는 서명 알고리즘에 따른 검증 수행
-	// 여기서는 간단한 구현만 제공
+	ok, err := cert.VerifySignature(id.pk, msg, sig)
+	if err != nil {
+		return errors.Wrap(err, "failed to verify signature")
+	}
+	if !ok {
+		return errors.New("signature verification failed")
+	}
 	return nil
 }
 
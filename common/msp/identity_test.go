@@ -0,0 +1,54 @@
+package msp_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/sha256"
+	"testing"
+
+	"github.com/ddr4869/minifab/common/msp"
+	"github.com/ddr4869/minifab/common/msp/msptest"
+)
+
+func TestNewIdentityFromCertPEM(t *testing.T) {
+	org := msptest.NewOrg(t, "Org1MSP")
+	identity, err := msp.NewIdentityFromCertPEM(org.SignCertPEM())
+	if err != nil {
+		t.Fatalf("NewIdentityFromCertPEM: %v", err)
+	}
+
+	// MSP와 무관하게 인증서 개인키로 직접 서명
+	message := []byte("channel creation envelope")
+	digest := sha256.Sum256(message)
+	signature, err := ecdsa.SignASN1(rand.Reader, org.Key, digest[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := identity.Verify(message, signature); err != nil {
+		t.Errorf("Verify of an external signature: %v", err)
+	}
+	if err := identity.Verify([]byte("tampered message"), signature); err == nil {
+		t.Error("Verify accepted a signature over a different message")
+	}
+
+	if err := identity.Validate(); err == nil {
+		t.Error("Validate succeeded for an identity without an MSP")
+	}
+	if err := msp.NewIdentity(org.SignCert, org.SignCert.PublicKey, org.MSPID).Validate(); err != nil {
+		t.Errorf("Validate of an MSP-backed identity: %v", err)
+	}
+}
+
+func TestNewIdentityFromCertPEMRejectsInvalidPEM(t *testing.T) {
+	for name, certPEM := range map[string][]byte{
+		"empty":   nil,
+		"not PEM": []byte("not a certificate"),
+		"key PEM": msptest.NewOrg(t, "Org1MSP").KeyPEM(t),
+	} {
+		t.Run(name, func(t *testing.T) {
+			if _, err := msp.NewIdentityFromCertPEM(certPEM); err == nil {
+				t.Fatal("NewIdentityFromCertPEM succeeded, want error")
+			}
+		})
+	}
+}
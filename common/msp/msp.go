@@ -7,10 +7,21 @@ import (
 
 type MSP interface {
 	Setup(config *MSPConfig) error
+	GetMSPID() string
 	GetSigningIdentity() SigningIdentity
 	GetRootCertificates() *x509.Certificate
+	GetRootCertPool() (*x509.CertPool, error)
+	GetTLSRootCertPool() (*x509.CertPool, error)
+	// GetOrganizationalUnits signing identity 인증서의 OU 값 목록
+	GetOrganizationalUnits() []string
+	// Equals MSPID, root 인증서, TLS root 인증서가 모두 같은지 비교
+	Equals(other MSP) bool
+	// HashConfig MSPID와 정렬된 root/TLS root 인증서 DER의 SHA256 (설정 변경 여부의 빠른 판단용)
+	HashConfig() ([]byte, error)
+	// Clone 다른 goroutine에 넘겨도 안전한 복제본 (인증서와 signing identity는 공유)
+	Clone() MSP
 	// ValidateIdentity(identity Identity) error
-	// DeserializeIdentity(serializedIdentity []byte) (Identity, error)
+	DeserializeIdentity(serializedIdentity []byte) (Identity, error)
 	// IsWellFormed(identity *SerializedIdentity) error
 }
 
@@ -21,7 +32,7 @@ type Identity interface {
 	Validate() error
 	// GetMSPIdentifier() string
 	// GetOrganizationalUnits() []*OUIdentifier
-	// Verify(msg []byte, sig []byte) error
+	Verify(msg []byte, sig []byte) error
 	// Serialize() ([]byte, error)
 	// SatisfiesPrincipal(principal *MSPPrincipal) error
 }
@@ -29,6 +40,12 @@ type Identity interface {
 type SigningIdentity interface {
 	Identity
 	crypto.Signer
+	// GetPublicKeyDER 인증서 공개키의 DER 인코딩 SubjectPublicKeyInfo
+	GetPublicKeyDER() ([]byte, error)
+	// GetPublicKeyPEM GetPublicKeyDER 결과를 "PUBLIC KEY" PEM 블록으로 인코딩
+	GetPublicKeyPEM() ([]byte, error)
+	// GetSigningCertPEM 서명 인증서를 "CERTIFICATE" PEM 블록으로 인코딩
+	GetSigningCertPEM() ([]byte, error)
 }
 
 // 키 인터페이스
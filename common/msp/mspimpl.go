@@ -1,11 +1,7 @@
 package msp
 
 import (
-	"crypto/rsa"
 	"crypto/x509"
-	"crypto/x509/pkix"
-	"math/big"
-	"time"
 
 	"github.com/pkg/errors"
 )
@@ -15,6 +11,7 @@ type MSPConfig struct {
 	MSPID           string
 	SigningIdentity *SigningIdentity
 	RootCerts       *x509.Certificate
+	TLSRootCerts    []*x509.Certificate
 	//Admins                        []*x509.Certificate
 	// RevocationList                []*x509.Certificate
 	// OrganizationalUnitIdentifiers []*FabricOUIdentifier
@@ -27,6 +24,7 @@ type FabricMSP struct {
 	MSPID           string
 	SigningIdentity SigningIdentity
 	RootCerts       *x509.Certificate
+	TLSRootCerts    []*x509.Certificate
 	// Admins          []*identity
 	// Bccsp           BCCSP
 	//CryptoConfig    *FabricCryptoConfig
@@ -63,10 +61,62 @@ func (msp *FabricMSP) GetRootCertificates() *x509.Certificate {
 	return msp.RootCerts
 }
 
+// GetRootCertPool MSP root CA 인증서로 구성된 인증서 풀 반환
+func (msp *FabricMSP) GetRootCertPool() (*x509.CertPool, error) {
+	if msp.RootCerts == nil {
+		return nil, errors.Errorf("MSP %s has no root certificate", msp.MSPID)
+	}
+	return newCertPool(msp.RootCerts), nil
+}
+
+// GetTLSRootCertPool MSP TLS root CA 인증서(tlscacerts)로 구성된 인증서 풀 반환
+func (msp *FabricMSP) GetTLSRootCertPool() (*x509.CertPool, error) {
+	if len(msp.TLSRootCerts) == 0 {
+		return nil, errors.Errorf("MSP %s has no TLS root certificate", msp.MSPID)
+	}
+	return newCertPool(msp.TLSRootCerts...), nil
+}
+
+// GetOrganizationalUnits signing identity 인증서 Subject의 OU 값 목록 반환 (인증서가 없으면 빈 목록)
+func (msp *FabricMSP) GetOrganizationalUnits() []string {
+	if msp.SigningIdentity == nil {
+		return []string{}
+	}
+	cert := msp.SigningIdentity.GetCertificate()
+	if cert == nil {
+		return []string{}
+	}
+	return append([]string{}, cert.Subject.OrganizationalUnit...)
+}
+
+// Clone 슬라이스를 복사한 독립적인 FabricMSP 반환
+// 인증서와 SigningIdentity는 읽기 전용으로 취급되어 원본과 공유한다.
+// 원본의 TLSRootCerts를 교체하거나 수정해도 복제본에는 영향을 주지 않는다.
+func (msp *FabricMSP) Clone() MSP {
+	clone := *msp
+	if msp.TLSRootCerts != nil {
+		clone.TLSRootCerts = append([]*x509.Certificate(nil), msp.TLSRootCerts...)
+	}
+	return &clone
+}
+
+func newCertPool(certs ...*x509.Certificate) *x509.CertPool {
+	pool := x509.NewCertPool()
+	for _, cert := range certs {
+		pool.AddCert(cert)
+	}
+	return pool
+}
+
 func NewFabricMSP() *FabricMSP {
 	return &FabricMSP{}
 }
 
+// GetMSPID MSP ID 반환
+func (msp *FabricMSP) GetMSPID() string {
+	return msp.MSPID
+}
+
 // Setup MSP 설정
 func (msp *FabricMSP) Setup(config *MSPConfig) error {
 	if config == nil {
@@ -76,33 +126,50 @@ func (msp *FabricMSP) Setup(config *MSPConfig) error {
 	msp.MSPID = config.MSPID
 	msp.SigningIdentity = *config.SigningIdentity
 	msp.RootCerts = config.RootCerts
+	msp.TLSRootCerts = config.TLSRootCerts
 	// msp.CryptoConfig = config.CryptoConfig
 	// msp.NodeOUs = config.NodeOUs
 	return nil
 }
 
-// DeserializeIdentity 직렬화된 Identity를 역직렬화
+// DeserializeIdentity DER 인코딩된 x509 인증서를 이 MSP의 Identity로 역직렬화
+// 인증서는 MSP의 root CA로 서명되어 있어야 한다.
 func (msp *FabricMSP) DeserializeIdentity(serializedIdentity []byte) (Identity, error) {
-	return &identity{
-		id: &IdentityIdentifier{
-			Mspid: msp.MSPID,
-			Id:    "user",
-		},
-		// mock x509.Certificate
-		cert: &x509.Certificate{
-			Subject: pkix.Name{
-				CommonName: "test",
-			},
-			NotBefore:          time.Now(),
-			NotAfter:           time.Now().Add(time.Hour * 24 * 365),
-			PublicKeyAlgorithm: x509.RSA,
-			PublicKey:          nil,
-		},
-		pk: &rsa.PublicKey{
-			N: big.NewInt(1),
-			E: 1,
-		}, // mock crypto.PublicKey
-	}, nil
+	if len(serializedIdentity) == 0 {
+		return nil, errors.New("serialized identity cannot be empty")
+	}
+
+	x509Cert, err := x509.ParseCertificate(serializedIdentity)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse certificate")
+	}
+	if msp.RootCerts == nil {
+		return nil, errors.Errorf("MSP %s has no root certificate", msp.MSPID)
+	}
+	if err := x509Cert.CheckSignatureFrom(msp.RootCerts); err != nil {
+		return nil, errors.Wrapf(err, "certificate is not issued by MSP %s", msp.MSPID)
+	}
+
+	return NewIdentity(x509Cert, x509Cert.PublicKey, msp.MSPID), nil
+}
+
+// CrossOrganizationVerify 다른 조직(signerMSPID)의 서명을 해당 조직의 MSP로 검증
+// knownMSPs에서 signerMSPID의 MSP를 찾아 identityBytes를 역직렬화한 뒤 data에 대한 sig를 검증한다.
+func CrossOrganizationVerify(signerMSPID string, identityBytes, data, sig []byte, knownMSPs map[string]MSP) error {
+	signerMSP, exists := knownMSPs[signerMSPID]
+	if !exists {
+		return errors.Errorf("unknown MSP: %s", signerMSPID)
+	}
+
+	identity, err := signerMSP.DeserializeIdentity(identityBytes)
+	if err != nil {
+		return errors.Wrapf(err, "failed to deserialize identity for MSP %s", signerMSPID)
+	}
+
+	if err := identity.Verify(data, sig); err != nil {
+		return errors.Wrapf(err, "failed to verify signature from MSP %s", signerMSPID)
+	}
+	return nil
 }
 
 // IsWellFormed Identity가 올바른 형식인지 확인
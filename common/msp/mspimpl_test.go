@@ -0,0 +1,84 @@
+package msp_test
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"sort"
+	"testing"
+
+	"github.com/ddr4869/minifab/common/msp"
+	"github.com/ddr4869/minifab/common/msp/msptest"
+)
+
+func TestCrossOrganizationVerify(t *testing.T) {
+	orgA := msptest.NewOrg(t, "OrgAMSP")
+	orgB := msptest.NewOrg(t, "OrgBMSP")
+	knownMSPs := map[string]msp.MSP{"OrgAMSP": orgA.MSP, "OrgBMSP": orgB.MSP}
+
+	data := []byte("endorsement from org B")
+	digest := sha256.Sum256(data)
+	sig, err := orgB.SigningIdentity().Sign(rand.Reader, digest[:], nil)
+	if err != nil {
+		t.Fatalf("Sign: %v", err)
+	}
+
+	if err := msp.CrossOrganizationVerify("OrgBMSP", orgB.SignCert.Raw, data, sig, knownMSPs); err != nil {
+		t.Fatalf("CrossOrganizationVerify: %v", err)
+	}
+
+	tests := []struct {
+		name          string
+		signerMSPID   string
+		identityBytes []byte
+		data          []byte
+	}{
+		{"identity claimed by another org", "OrgAMSP", orgB.SignCert.Raw, data},
+		{"unknown MSP", "OrgCMSP", orgB.SignCert.Raw, data},
+		{"malformed identity", "OrgBMSP", []byte("not a certificate"), data},
+		{"tampered data", "OrgBMSP", orgB.SignCert.Raw, []byte("tampered")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := msp.CrossOrganizationVerify(tt.signerMSPID, tt.identityBytes, tt.data, sig, knownMSPs); err == nil {
+				t.Error("CrossOrganizationVerify succeeded")
+			}
+		})
+	}
+}
+
+func TestGetOrganizationalUnits(t *testing.T) {
+	org := msptest.NewOrg(t, "Org1MSP")
+	if ous := org.MSP.GetOrganizationalUnits(); len(ous) != 0 {
+		t.Errorf("OUs of a cert without OU = %v, want none", ous)
+	}
+
+	cert, key := org.Issue(t, "peer0", "peer", "org1")
+	signer, err := msp.NewSigner(msp.NewIdentity(cert, cert.PublicKey, org.MSPID), key)
+	if err != nil {
+		t.Fatalf("NewSigner: %v", err)
+	}
+	var signingIdentity msp.SigningIdentity = signer
+	peerMSP := msp.NewFabricMSP()
+	if err := peerMSP.Setup(&msp.MSPConfig{MSPID: org.MSPID, SigningIdentity: &signingIdentity, RootCerts: org.CACert}); err != nil {
+		t.Fatalf("Setup: %v", err)
+	}
+
+	// 같은 RDN의 OU 값은 DER 인코딩 시 정렬되므로 순서와 무관하게 비교
+	ous := peerMSP.GetOrganizationalUnits()
+	sort.Strings(ous)
+	if len(ous) != 2 || ous[0] != "org1" || ous[1] != "peer" {
+		t.Errorf("GetOrganizationalUnits() = %v, want [org1 peer]", ous)
+	}
+
+	// 반환된 slice를 수정해도 인증서에는 영향이 없다
+	ous[0] = "changed"
+	for _, ou := range peerMSP.GetOrganizationalUnits() {
+		if ou == "changed" {
+			t.Error("GetOrganizationalUnits returned the certificate's slice")
+		}
+	}
+
+	if ous := msp.NewFabricMSP().GetOrganizationalUnits(); ous == nil || len(ous) != 0 {
+		t.Errorf("OUs without a signing identity = %#v, want empty slice", ous)
+	}
+}
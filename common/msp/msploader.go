@@ -1,6 +1,8 @@
 package msp
 
 import (
+	"crypto"
+	"crypto/x509"
 	"os"
 	"path/filepath"
 
@@ -49,6 +51,57 @@ func LoadMSP(mspID, mspPath string) (MSP, error) {
 	return msp, nil
 }
 
+// NewMSPFromPEMBytes PEM 바이트로 전달된 CA 인증서, 서명 인증서, 개인키로 MSP 생성 (디스크 I/O 없음)
+// 서명 인증서는 CA 인증서로 서명되어 있어야 한다.
+func NewMSPFromPEMBytes(mspID string, caCertPEM, signcertPEM, keyPEM []byte) (MSP, error) {
+	caCert, err := cert.ParseCertificatePEM(caCertPEM)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse CA cert")
+	}
+	signCert, err := cert.ParseCertificatePEM(signcertPEM)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse sign cert")
+	}
+	if err := signCert.CheckSignatureFrom(caCert); err != nil {
+		return nil, errors.Wrap(err, "sign cert is not issued by CA cert")
+	}
+
+	privateKey, err := cert.LoadPrivateKeyFromFile(keyPEM)
+	if err != nil {
+		return nil, errors.New("failed to parse private key")
+	}
+	if !publicKeyMatches(privateKey, signCert.PublicKey) {
+		return nil, errors.New("private key does not match sign cert")
+	}
+
+	signer, err := NewSigner(NewIdentity(signCert, signCert.PublicKey, mspID), privateKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create signer")
+	}
+
+	var signingIdentity SigningIdentity = signer
+	msp := NewFabricMSP()
+	mspConfig := &MSPConfig{
+		MSPID:           mspID,
+		SigningIdentity: &signingIdentity,
+		RootCerts:       caCert,
+	}
+	if err := msp.Setup(mspConfig); err != nil {
+		return nil, errors.Wrap(err, "failed to setup MSP")
+	}
+
+	return msp, nil
+}
+
+func publicKeyMatches(privateKey crypto.PrivateKey, publicKey crypto.PublicKey) bool {
+	signer, ok := privateKey.(crypto.Signer)
+	if !ok {
+		return false
+	}
+	pub, ok := signer.Public().(interface{ Equal(crypto.PublicKey) bool })
+	return ok && pub.Equal(publicKey)
+}
+
 // NewMSPWithIdentity Identity를 사용하여 MSP 생성
 func NewMSPWithIdentity(mspID, mspPath string, identity SigningIdentity) (MSP, error) {
 	caCerts, err := cert.LoadCaCertFromDir(mspPath)
@@ -56,11 +109,22 @@ func NewMSPWithIdentity(mspID, mspPath string, identity SigningIdentity) (MSP, e
 		return nil, errors.Wrap(err, "failed to load CA certs")
 	}
 
+	// TLS CA 인증서는 선택 사항 (tlscacerts 폴더가 있을 때만 로드)
+	var tlsCaCerts []*x509.Certificate
+	if stat, err := os.Stat(filepath.Join(mspPath, "tlscacerts")); err == nil && stat.IsDir() {
+		tlsCaCert, err := cert.LoadCertFromDir(mspPath, "tlscacerts")
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to load TLS CA certs")
+		}
+		tlsCaCerts = append(tlsCaCerts, tlsCaCert)
+	}
+
 	msp := NewFabricMSP()
 	mspConfig := &MSPConfig{
 		MSPID:           mspID,
 		SigningIdentity: &identity,
 		RootCerts:       caCerts,
+		TLSRootCerts:    tlsCaCerts,
 	}
 
 	if err := msp.Setup(mspConfig); err != nil {
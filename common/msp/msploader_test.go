@@ -0,0 +1,65 @@
+package msp_test
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"testing"
+
+	"github.com/ddr4869/minifab/common/msp"
+	"github.com/ddr4869/minifab/common/msp/msptest"
+)
+
+func TestNewMSPFromPEMBytes(t *testing.T) {
+	org := msptest.NewOrg(t, "Org1MSP")
+
+	m, err := msp.NewMSPFromPEMBytes("Org1MSP", org.CACertPEM(), org.SignCertPEM(), org.KeyPEM(t))
+	if err != nil {
+		t.Fatalf("NewMSPFromPEMBytes: %v", err)
+	}
+	if m.GetMSPID() != "Org1MSP" {
+		t.Errorf("GetMSPID() = %s, want Org1MSP", m.GetMSPID())
+	}
+
+	message := []byte("in-memory MSP")
+	digest := sha256.Sum256(message)
+	signer := m.GetSigningIdentity()
+	sig, err := signer.Sign(rand.Reader, digest[:], nil)
+	if err != nil {
+		t.Fatalf("Sign: %v", err)
+	}
+	if err := signer.Verify(message, sig); err != nil {
+		t.Errorf("signing identity Verify: %v", err)
+	}
+
+	// 같은 CA의 MSP로 역직렬화한 identity도 서명을 검증할 수 있어야 한다
+	identity, err := m.DeserializeIdentity(org.SignCert.Raw)
+	if err != nil {
+		t.Fatalf("DeserializeIdentity: %v", err)
+	}
+	if err := identity.Verify(message, sig); err != nil {
+		t.Errorf("deserialized identity Verify: %v", err)
+	}
+}
+
+func TestNewMSPFromPEMBytesErrors(t *testing.T) {
+	org := msptest.NewOrg(t, "Org1MSP")
+	other := msptest.NewOrg(t, "Org2MSP")
+
+	tests := []struct {
+		name                           string
+		caCertPEM, signcertPEM, keyPEM []byte
+	}{
+		{"invalid CA cert", []byte("not a pem"), org.SignCertPEM(), org.KeyPEM(t)},
+		{"invalid sign cert", org.CACertPEM(), []byte("not a pem"), org.KeyPEM(t)},
+		{"invalid key", org.CACertPEM(), org.SignCertPEM(), []byte("not a pem")},
+		{"sign cert from another CA", org.CACertPEM(), other.SignCertPEM(), other.KeyPEM(t)},
+		{"key does not match sign cert", org.CACertPEM(), org.SignCertPEM(), other.KeyPEM(t)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := msp.NewMSPFromPEMBytes("Org1MSP", tt.caCertPEM, tt.signcertPEM, tt.keyPEM); err == nil {
+				t.Error("NewMSPFromPEMBytes succeeded")
+			}
+		})
+	}
+}
@@ -0,0 +1,87 @@
+package msp
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// MSPManager 여러 조직의 MSP를 MSP ID로 조회하기 위한 모음 (정책 평가, consortium 검증용)
+type MSPManager interface {
+	GetMSP(mspID string) (MSP, error)
+	// AddMSP MSP 등록 (같은 MSP ID가 이미 있으면 에러)
+	AddMSP(msp MSP) error
+	RemoveMSP(mspID string) error
+	// GetMSPIDs 등록된 MSP ID를 오름차순으로 정렬해 반환
+	GetMSPIDs() []string
+}
+
+type mspManager struct {
+	mutex sync.RWMutex
+	msps  map[string]MSP
+}
+
+// NewMSPManager MSP ID별 MSP로 MSPManager 생성 (msps는 복사되므로 이후 변경해도 영향 없음)
+func NewMSPManager(msps map[string]MSP) MSPManager {
+	manager := &mspManager{
+		msps: make(map[string]MSP, len(msps)),
+	}
+	for mspID, msp := range msps {
+		manager.msps[mspID] = msp
+	}
+	return manager
+}
+
+func (m *mspManager) GetMSP(mspID string) (MSP, error) {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	msp, exists := m.msps[mspID]
+	if !exists {
+		return nil, errors.Errorf("MSP %s not found", mspID)
+	}
+	return msp, nil
+}
+
+func (m *mspManager) AddMSP(msp MSP) error {
+	if msp == nil {
+		return errors.New("MSP cannot be nil")
+	}
+	mspID := msp.GetMSPID()
+	if mspID == "" {
+		return errors.New("MSP ID cannot be empty")
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if _, exists := m.msps[mspID]; exists {
+		return errors.Errorf("MSP %s already exists", mspID)
+	}
+	m.msps[mspID] = msp
+	return nil
+}
+
+func (m *mspManager) RemoveMSP(mspID string) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if _, exists := m.msps[mspID]; !exists {
+		return errors.Errorf("MSP %s not found", mspID)
+	}
+	delete(m.msps, mspID)
+	return nil
+}
+
+func (m *mspManager) GetMSPIDs() []string {
+	m.mutex.RLock()
+	mspIDs := make([]string, 0, len(m.msps))
+	for mspID := range m.msps {
+		mspIDs = append(mspIDs, mspID)
+	}
+	m.mutex.RUnlock()
+
+	sort.Strings(mspIDs)
+	return mspIDs
+}
@@ -0,0 +1,70 @@
+package msp_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/ddr4869/minifab/common/msp"
+	"github.com/ddr4869/minifab/common/msp/msptest"
+)
+
+func TestMSPManagerGetMSP(t *testing.T) {
+	msps := make(map[string]msp.MSP)
+	for _, mspID := range []string{"Org3MSP", "Org1MSP", "Org2MSP"} {
+		msps[mspID] = msptest.NewOrg(t, mspID).MSP
+	}
+	manager := msp.NewMSPManager(msps)
+
+	for mspID, want := range msps {
+		got, err := manager.GetMSP(mspID)
+		if err != nil {
+			t.Fatalf("GetMSP(%s): %v", mspID, err)
+		}
+		if got != want {
+			t.Errorf("GetMSP(%s) returned the MSP of %s", mspID, got.GetMSPID())
+		}
+	}
+	if _, err := manager.GetMSP("UnknownMSP"); err == nil {
+		t.Error("GetMSP of an unknown MSP ID succeeded, want error")
+	}
+	if got := manager.GetMSPIDs(); !reflect.DeepEqual(got, []string{"Org1MSP", "Org2MSP", "Org3MSP"}) {
+		t.Errorf("GetMSPIDs() = %v, want sorted IDs", got)
+	}
+
+	// 생성 후 원본 map을 바꿔도 manager에는 영향이 없다
+	delete(msps, "Org1MSP")
+	if _, err := manager.GetMSP("Org1MSP"); err != nil {
+		t.Errorf("GetMSP after modifying the source map: %v", err)
+	}
+}
+
+func TestMSPManagerAddRemove(t *testing.T) {
+	manager := msp.NewMSPManager(nil)
+	org := msptest.NewOrg(t, "Org1MSP")
+
+	if err := manager.AddMSP(org.MSP); err != nil {
+		t.Fatalf("AddMSP: %v", err)
+	}
+	if err := manager.AddMSP(msptest.NewOrg(t, "Org1MSP").MSP); err == nil {
+		t.Error("AddMSP accepted a duplicate MSP ID")
+	}
+	if err := manager.AddMSP(nil); err == nil {
+		t.Error("AddMSP accepted a nil MSP")
+	}
+	if err := manager.AddMSP(msp.NewFabricMSP()); err == nil {
+		t.Error("AddMSP accepted an MSP without an ID")
+	}
+	if got, err := manager.GetMSP("Org1MSP"); err != nil || got != msp.MSP(org.MSP) {
+		t.Errorf("GetMSP after AddMSP = %v, %v, want the added MSP", got, err)
+	}
+
+	if err := manager.RemoveMSP("Org1MSP"); err != nil {
+		t.Fatalf("RemoveMSP: %v", err)
+	}
+	if err := manager.RemoveMSP("Org1MSP"); err == nil {
+		t.Error("RemoveMSP of a missing MSP ID succeeded, want error")
+	}
+	if got := manager.GetMSPIDs(); len(got) != 0 {
+		t.Errorf("GetMSPIDs() after RemoveMSP = %v, want none", got)
+	}
+}
@@ -0,0 +1,223 @@
+// Package msptest 테스트에서 사용할 조직 CA, 서명 identity와 MSP 디렉터리를 생성한다.
+// 키와 인증서는 매번 새로 만들어지므로 테스트끼리 상태를 공유하지 않는다.
+package msptest
+
+import (
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ddr4869/minifab/common/crypto"
+	"github.com/ddr4869/minifab/common/msp"
+)
+
+var serial atomic.Int64
+
+// Org 자체 서명 CA와 그 CA가 발급한 서명 identity 하나를 가진 테스트 조직
+type Org struct {
+	MSPID    string
+	CACert   *x509.Certificate
+	caKey    *ecdsa.PrivateKey
+	SignCert *x509.Certificate
+	Key      *ecdsa.PrivateKey
+	// MSP SignCert/Key를 서명 identity로, CACert를 root로 가진 MSP
+	MSP *msp.FabricMSP
+}
+
+// NewOrg mspID 조직의 CA와 "<mspID>-member" 서명 identity 생성
+func NewOrg(t testing.TB, mspID string) *Org {
+	t.Helper()
+
+	caKey := newKey(t)
+	caTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(serial.Add(1)),
+		Subject:               pkix.Name{CommonName: mspID + "-ca", Organization: []string{mspID}},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(24 * time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature,
+	}
+	caCert := createCert(t, caTemplate, caTemplate, &caKey.PublicKey, caKey)
+
+	org := &Org{MSPID: mspID, CACert: caCert, caKey: caKey}
+	org.SignCert, org.Key = org.Issue(t, mspID+"-member")
+
+	signer, err := msp.NewSigner(msp.NewIdentity(org.SignCert, org.SignCert.PublicKey, mspID), org.Key)
+	if err != nil {
+		t.Fatalf("failed to create signer: %v", err)
+	}
+	var signingIdentity msp.SigningIdentity = signer
+	org.MSP = msp.NewFabricMSP()
+	if err := org.MSP.Setup(&msp.MSPConfig{MSPID: mspID, SigningIdentity: &signingIdentity, RootCerts: caCert}); err != nil {
+		t.Fatalf("failed to setup MSP: %v", err)
+	}
+	return org
+}
+
+// Issue 조직 CA로 commonName 인증서와 키를 발급 (ous는 인증서의 OrganizationalUnit)
+func (o *Org) Issue(t testing.TB, commonName string, ous ...string) (*x509.Certificate, *ecdsa.PrivateKey) {
+	t.Helper()
+
+	key := newKey(t)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(serial.Add(1)),
+		Subject: pkix.Name{
+			CommonName:         commonName,
+			Organization:       []string{o.MSPID},
+			OrganizationalUnit: ous,
+		},
+		NotBefore: time.Now().Add(-time.Hour),
+		NotAfter:  time.Now().Add(24 * time.Hour),
+		KeyUsage:  x509.KeyUsageDigitalSignature,
+	}
+	return createCert(t, template, o.CACert, &key.PublicKey, o.caKey), key
+}
+
+// NewIntermediate 조직 CA가 서명한 중간 CA를 CA로 가진 같은 MSP ID의 조직 생성
+// 반환된 조직의 SignCert와 Issue로 발급한 인증서는 중간 CA가 서명하며, MSP는 설정하지 않는다.
+func (o *Org) NewIntermediate(t testing.TB, commonName string) *Org {
+	t.Helper()
+
+	caKey := newKey(t)
+	caTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(serial.Add(1)),
+		Subject:               pkix.Name{CommonName: commonName, Organization: []string{o.MSPID}},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(24 * time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature,
+	}
+	caCert := createCert(t, caTemplate, o.CACert, &caKey.PublicKey, o.caKey)
+
+	intermediate := &Org{MSPID: o.MSPID, CACert: caCert, caKey: caKey}
+	intermediate.SignCert, intermediate.Key = intermediate.Issue(t, o.MSPID+"-member")
+	return intermediate
+}
+
+// IssueTLS 조직 CA로 hosts(IP 또는 DNS 이름)를 SAN으로 가진 TLS 서버/클라이언트 겸용 인증서와 키를 발급
+func (o *Org) IssueTLS(t testing.TB, commonName string, hosts ...string) (*x509.Certificate, *ecdsa.PrivateKey) {
+	t.Helper()
+
+	key := newKey(t)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(serial.Add(1)),
+		Subject:      pkix.Name{CommonName: commonName, Organization: []string{o.MSPID}},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(24 * time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+	for _, host := range hosts {
+		if ip := net.ParseIP(host); ip != nil {
+			template.IPAddresses = append(template.IPAddresses, ip)
+		} else {
+			template.DNSNames = append(template.DNSNames, host)
+		}
+	}
+	return createCert(t, template, o.CACert, &key.PublicKey, o.caKey), key
+}
+
+// NewSigningIdentity 조직 CA가 발급한 새 인증서로 서명 identity 생성
+func (o *Org) NewSigningIdentity(t testing.TB, commonName string) msp.SigningIdentity {
+	t.Helper()
+
+	cert, key := o.Issue(t, commonName)
+	signer, err := msp.NewSigner(msp.NewIdentity(cert, cert.PublicKey, o.MSPID), key)
+	if err != nil {
+		t.Fatalf("failed to create signer: %v", err)
+	}
+	return signer
+}
+
+// SigningIdentity 조직 MSP의 기본 서명 identity
+func (o *Org) SigningIdentity() msp.SigningIdentity {
+	return o.MSP.GetSigningIdentity()
+}
+
+// CACertPEM PEM 인코딩된 CA 인증서
+func (o *Org) CACertPEM() []byte {
+	return CertPEM(o.CACert)
+}
+
+// SignCertPEM PEM 인코딩된 서명 인증서
+func (o *Org) SignCertPEM() []byte {
+	return CertPEM(o.SignCert)
+}
+
+// KeyPEM PKCS#8 PEM 인코딩된 서명 키
+func (o *Org) KeyPEM(t testing.TB) []byte {
+	t.Helper()
+	return KeyPEM(t, o.Key)
+}
+
+// WriteMSPDir dir에 cacerts, signcerts, keystore 폴더를 가진 MSP 디렉터리를 쓰고 dir 반환
+func (o *Org) WriteMSPDir(t testing.TB, dir string) string {
+	t.Helper()
+
+	files := map[string][]byte{
+		filepath.Join("cacerts", "ca-cert.pem"): o.CACertPEM(),
+		filepath.Join("signcerts", "cert.pem"):  o.SignCertPEM(),
+		filepath.Join("keystore", "priv_sk"):    o.KeyPEM(t),
+	}
+	for name, data := range files {
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatalf("failed to create %s: %v", filepath.Dir(path), err)
+		}
+		if err := os.WriteFile(path, data, 0600); err != nil {
+			t.Fatalf("failed to write %s: %v", path, err)
+		}
+	}
+	return dir
+}
+
+// CertPEM 인증서를 PEM으로 인코딩
+func CertPEM(cert *x509.Certificate) []byte {
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Raw})
+}
+
+// KeyPEM 개인키를 PKCS#8 PEM으로 인코딩
+func KeyPEM(t testing.TB, key *ecdsa.PrivateKey) []byte {
+	t.Helper()
+
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatalf("failed to marshal private key: %v", err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})
+}
+
+func newKey(t testing.TB) *ecdsa.PrivateKey {
+	t.Helper()
+
+	key, err := crypto.DefaultECDSAKey()
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+	return key
+}
+
+func createCert(t testing.TB, template, parent *x509.Certificate, pub *ecdsa.PublicKey, signerKey *ecdsa.PrivateKey) *x509.Certificate {
+	t.Helper()
+
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, pub, signerKey)
+	if err != nil {
+		t.Fatalf("failed to create certificate: %v", err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatalf("failed to parse certificate: %v", err)
+	}
+	return cert
+}
@@ -0,0 +1,180 @@
+package msp
+
+import (
+	"bytes"
+	"crypto/x509"
+
+	"github.com/pkg/errors"
+)
+
+// PolicyType 정책 종류
+type PolicyType string
+
+const (
+	// PolicyTypeSignature 지정된 identity들 중 N개 이상의 서명을 요구하는 정책
+	PolicyTypeSignature PolicyType = "Signature"
+	// PolicyTypeImplicitMeta 하위 정책들 중 Rule만큼이 만족되기를 요구하는 정책
+	PolicyTypeImplicitMeta PolicyType = "ImplicitMeta"
+)
+
+// SignatureRule m개 중 몇 개가 만족되어야 하는지 정하는 규칙
+type SignatureRule string
+
+const (
+	RuleAny      SignatureRule = "ANY"
+	RuleMajority SignatureRule = "MAJORITY"
+	RuleAll      SignatureRule = "ALL"
+)
+
+// Required m개 중 만족되어야 하는 수 (ANY: 1, MAJORITY: 과반, ALL: m)
+func (r SignatureRule) Required(m int) (int, error) {
+	switch r {
+	case RuleAny:
+		return 1, nil
+	case RuleMajority:
+		return m/2 + 1, nil
+	case RuleAll:
+		return m, nil
+	default:
+		return 0, errors.Errorf("unknown signature rule %q", r)
+	}
+}
+
+// NOutOfSpec Identities(DER 인코딩 x509 인증서) 중 N개 이상의 서명을 요구
+type NOutOfSpec struct {
+	N          int
+	Identities [][]byte
+}
+
+// Policy Signature 정책은 NOutOf를, ImplicitMeta 정책은 Rule과 SubPolicies를 사용한다.
+type Policy struct {
+	Type        PolicyType
+	NOutOf      *NOutOfSpec
+	Rule        SignatureRule
+	SubPolicies []*Policy
+}
+
+// NewSignaturePolicy identities 중 rule만큼의 서명을 요구하는 Signature 정책 생성
+// 중복된 identity는 한 번만 포함되므로 rule은 서로 다른 identity 수를 기준으로 계산된다.
+func NewSignaturePolicy(rule SignatureRule, identities ...[]byte) (*Policy, error) {
+	identities = uniqueIdentities(identities)
+	n, err := rule.Required(len(identities))
+	if err != nil {
+		return nil, err
+	}
+	return &Policy{
+		Type:   PolicyTypeSignature,
+		NOutOf: &NOutOfSpec{N: n, Identities: identities},
+	}, nil
+}
+
+// NewImplicitMetaPolicy subPolicies 중 rule만큼이 만족되기를 요구하는 ImplicitMeta 정책 생성
+func NewImplicitMetaPolicy(rule SignatureRule, subPolicies ...*Policy) *Policy {
+	return &Policy{
+		Type:        PolicyTypeImplicitMeta,
+		Rule:        rule,
+		SubPolicies: subPolicies,
+	}
+}
+
+// PolicySignature Identity(DER 인코딩 x509 인증서)가 평가 대상 메시지에 대해 만든 서명
+// 서명 대상 메시지는 Evaluate 호출자가 넘기므로 다른 메시지에 대한 서명은 재사용될 수 없다.
+type PolicySignature struct {
+	Identity  []byte
+	Signature []byte
+}
+
+// PolicyEvaluator 서명 목록이 정책을 만족하는지 평가 (상태가 없으므로 zero value를 그대로 사용)
+type PolicyEvaluator struct{}
+
+// Evaluate signedData에 대한 signatures가 policy를 만족하면 nil, 아니면 부족한 서명 수를 담은 에러 반환
+// 같은 identity의 서명은 여러 개여도 한 번만 센다.
+func (e PolicyEvaluator) Evaluate(policy *Policy, signedData []byte, signatures []*PolicySignature) error {
+	if policy == nil {
+		return errors.New("policy cannot be nil")
+	}
+	if len(signedData) == 0 {
+		return errors.New("signed data cannot be empty")
+	}
+
+	switch policy.Type {
+	case PolicyTypeSignature:
+		return e.evaluateSignature(policy.NOutOf, signedData, signatures)
+	case PolicyTypeImplicitMeta:
+		return e.evaluateImplicitMeta(policy, signedData, signatures)
+	default:
+		return errors.Errorf("unsupported policy type %q", policy.Type)
+	}
+}
+
+func (e PolicyEvaluator) evaluateSignature(spec *NOutOfSpec, signedData []byte, signatures []*PolicySignature) error {
+	if spec == nil {
+		return errors.New("signature policy has no n-out-of spec")
+	}
+	// 같은 principal이 여러 번 나열되어도 한 서명이 여러 번 세어지지 않도록 중복 제거
+	principals := uniqueIdentities(spec.Identities)
+	if spec.N <= 0 || spec.N > len(principals) {
+		return errors.Errorf("invalid signature policy: %d out of %d identities", spec.N, len(principals))
+	}
+
+	satisfied := 0
+	for _, principal := range principals {
+		for _, signature := range signatures {
+			if signature == nil || !bytes.Equal(signature.Identity, principal) {
+				continue
+			}
+			if verifyPolicySignature(signature, signedData) == nil {
+				satisfied++
+				break
+			}
+		}
+	}
+	if satisfied < spec.N {
+		return errors.Errorf("signature policy not satisfied: %d of %d required signatures", satisfied, spec.N)
+	}
+	return nil
+}
+
+func (e PolicyEvaluator) evaluateImplicitMeta(policy *Policy, signedData []byte, signatures []*PolicySignature) error {
+	if len(policy.SubPolicies) == 0 {
+		return errors.New("implicit meta policy has no sub-policies")
+	}
+	required, err := policy.Rule.Required(len(policy.SubPolicies))
+	if err != nil {
+		return err
+	}
+
+	satisfied := 0
+	for _, subPolicy := range policy.SubPolicies {
+		if e.Evaluate(subPolicy, signedData, signatures) == nil {
+			satisfied++
+		}
+	}
+	if satisfied < required {
+		return errors.Errorf("%s policy not satisfied: %d of %d required sub-policies", policy.Rule, satisfied, required)
+	}
+	return nil
+}
+
+// verifyPolicySignature 서명자 인증서의 공개키로 signedData에 대한 서명 검증
+func verifyPolicySignature(signature *PolicySignature, signedData []byte) error {
+	cert, err := x509.ParseCertificate(signature.Identity)
+	if err != nil {
+		return errors.Wrap(err, "failed to parse signer certificate")
+	}
+	return NewIdentity(cert, cert.PublicKey, "").Verify(signedData, signature.Signature)
+}
+
+// uniqueIdentities 순서를 유지하며 중복된 identity 제거
+func uniqueIdentities(identities [][]byte) [][]byte {
+	seen := make(map[string]struct{}, len(identities))
+	unique := make([][]byte, 0, len(identities))
+	for _, identity := range identities {
+		if _, ok := seen[string(identity)]; ok {
+			continue
+		}
+		seen[string(identity)] = struct{}{}
+		unique = append(unique, identity)
+	}
+	return unique
+}
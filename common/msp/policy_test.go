@@ -0,0 +1,144 @@
+package msp_test
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"fmt"
+	"testing"
+
+	"github.com/ddr4869/minifab/common/msp"
+	"github.com/ddr4869/minifab/common/msp/msptest"
+)
+
+// newPolicyMembers 같은 조직에서 발급한 서명 identity count개
+func newPolicyMembers(t *testing.T, count int) []msp.SigningIdentity {
+	t.Helper()
+
+	org := msptest.NewOrg(t, "Org1MSP")
+	members := make([]msp.SigningIdentity, count)
+	for i := range members {
+		members[i] = org.NewSigningIdentity(t, fmt.Sprintf("member%d", i))
+	}
+	return members
+}
+
+func policySignature(t *testing.T, signer msp.SigningIdentity, message []byte) *msp.PolicySignature {
+	t.Helper()
+
+	digest := sha256.Sum256(message)
+	signature, err := signer.Sign(rand.Reader, digest[:], nil)
+	if err != nil {
+		t.Fatalf("Sign: %v", err)
+	}
+	return &msp.PolicySignature{Identity: signer.GetCertificate().Raw, Signature: signature}
+}
+
+func memberIdentities(members []msp.SigningIdentity) [][]byte {
+	identities := make([][]byte, len(members))
+	for i, member := range members {
+		identities[i] = member.GetCertificate().Raw
+	}
+	return identities
+}
+
+func TestPolicyEvaluatorSignatureRules(t *testing.T) {
+	members := newPolicyMembers(t, 3)
+	message := []byte("channel update")
+
+	tests := []struct {
+		rule    msp.SignatureRule
+		signers []int
+		ok      bool
+	}{
+		{msp.RuleAny, nil, false},
+		{msp.RuleAny, []int{0}, true},
+		{msp.RuleAny, []int{2}, true},
+		{msp.RuleMajority, []int{1}, false},
+		{msp.RuleMajority, []int{0, 2}, true},
+		{msp.RuleMajority, []int{0, 1, 2}, true},
+		{msp.RuleAll, []int{0, 1}, false},
+		{msp.RuleAll, []int{0, 1, 2}, true},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%s/%v", tt.rule, tt.signers), func(t *testing.T) {
+			policy, err := msp.NewSignaturePolicy(tt.rule, memberIdentities(members)...)
+			if err != nil {
+				t.Fatalf("NewSignaturePolicy: %v", err)
+			}
+			var signatures []*msp.PolicySignature
+			for _, i := range tt.signers {
+				signatures = append(signatures, policySignature(t, members[i], message))
+			}
+
+			err = msp.PolicyEvaluator{}.Evaluate(policy, message, signatures)
+			if tt.ok && err != nil {
+				t.Fatalf("Evaluate rejected satisfying signatures: %v", err)
+			}
+			if !tt.ok && err == nil {
+				t.Fatal("Evaluate accepted insufficient signatures")
+			}
+		})
+	}
+}
+
+func TestPolicyEvaluatorRejectsSignaturesOverOtherMessage(t *testing.T) {
+	members := newPolicyMembers(t, 3)
+	policy, err := msp.NewSignaturePolicy(msp.RuleAny, memberIdentities(members)...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	replayed := []*msp.PolicySignature{policySignature(t, members[0], []byte("old update"))}
+	if err := (msp.PolicyEvaluator{}).Evaluate(policy, []byte("new update"), replayed); err == nil {
+		t.Fatal("signature over a different message satisfied the policy")
+	}
+}
+
+func TestPolicyEvaluatorCountsDuplicatePrincipalOnce(t *testing.T) {
+	members := newPolicyMembers(t, 2)
+	message := []byte("channel update")
+	duplicated := members[0].GetCertificate().Raw
+
+	// identity를 중복 나열해도 서명 하나로 2-of-3를 만족할 수 없어야 한다
+	policy := &msp.Policy{
+		Type:   msp.PolicyTypeSignature,
+		NOutOf: &msp.NOutOfSpec{N: 2, Identities: [][]byte{duplicated, duplicated, members[1].GetCertificate().Raw}},
+	}
+	signatures := []*msp.PolicySignature{policySignature(t, members[0], message)}
+	if err := (msp.PolicyEvaluator{}).Evaluate(policy, message, signatures); err == nil {
+		t.Fatal("one signature satisfied a 2-of-2 policy through a duplicated principal")
+	}
+
+	// NewSignaturePolicy는 서로 다른 identity 수를 기준으로 ALL을 계산한다
+	all, err := msp.NewSignaturePolicy(msp.RuleAll, duplicated, duplicated)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := (msp.PolicyEvaluator{}).Evaluate(all, message, signatures); err != nil {
+		t.Fatalf("ALL over a single distinct identity: %v", err)
+	}
+}
+
+func TestPolicyEvaluatorImplicitMeta(t *testing.T) {
+	members := newPolicyMembers(t, 3)
+	message := []byte("channel update")
+
+	var subPolicies []*msp.Policy
+	for _, member := range members {
+		policy, err := msp.NewSignaturePolicy(msp.RuleAny, member.GetCertificate().Raw)
+		if err != nil {
+			t.Fatal(err)
+		}
+		subPolicies = append(subPolicies, policy)
+	}
+	policy := msp.NewImplicitMetaPolicy(msp.RuleMajority, subPolicies...)
+
+	one := []*msp.PolicySignature{policySignature(t, members[0], message)}
+	if err := (msp.PolicyEvaluator{}).Evaluate(policy, message, one); err == nil {
+		t.Fatal("MAJORITY satisfied by 1 of 3 sub-policies")
+	}
+	two := append(one, policySignature(t, members[1], message))
+	if err := (msp.PolicyEvaluator{}).Evaluate(policy, message, two); err != nil {
+		t.Fatalf("MAJORITY with 2 of 3 sub-policies: %v", err)
+	}
+}
@@ -2,7 +2,9 @@ package msp
 
 import (
 	"crypto"
+	"crypto/ed25519"
 	"crypto/x509"
+	"encoding/pem"
 	"io"
 
 	"github.com/pkg/errors"
@@ -37,6 +39,10 @@ func (s *Signer) Sign(rand io.Reader, digest []byte, opts crypto.SignerOpts) ([]
 	if !ok {
 		return nil, errors.New("private key does not implement crypto.Signer")
 	}
+	// Ed25519는 opts가 필요하며 digest를 해시하지 않고 그대로 서명한다
+	if _, isEd25519 := s.PrivateKey.(ed25519.PrivateKey); isEd25519 && opts == nil {
+		opts = crypto.Hash(0)
+	}
 
 	signature, err := signer.Sign(rand, digest, opts)
 	if err != nil {
@@ -56,3 +62,35 @@ func (s *Signer) GetCertificate() *x509.Certificate {
 func (s *Signer) Validate() error {
 	return s.Identity.Validate()
 }
+
+func (s *Signer) Verify(msg []byte, sig []byte) error {
+	return s.Identity.Verify(msg, sig)
+}
+
+func (s *Signer) GetPublicKeyDER() ([]byte, error) {
+	cert := s.GetCertificate()
+	if cert == nil {
+		return nil, errors.New("signer has no certificate")
+	}
+	der, err := x509.MarshalPKIXPublicKey(cert.PublicKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal public key")
+	}
+	return der, nil
+}
+
+func (s *Signer) GetPublicKeyPEM() ([]byte, error) {
+	der, err := s.GetPublicKeyDER()
+	if err != nil {
+		return nil, err
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}), nil
+}
+
+func (s *Signer) GetSigningCertPEM() ([]byte, error) {
+	cert := s.GetCertificate()
+	if cert == nil {
+		return nil, errors.New("signer has no certificate")
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Raw}), nil
+}
@@ -0,0 +1,117 @@
+package msp
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"reflect"
+	"testing"
+	"time"
+
+	mspcrypto "github.com/ddr4869/minifab/common/crypto"
+)
+
+// newTestSigner 자체 서명 인증서를 가진 privateKey의 Signer
+func newTestSigner(t *testing.T, privateKey crypto.Signer) *Signer {
+	t.Helper()
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "signer"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, privateKey.Public(), privateKey)
+	if err != nil {
+		t.Fatalf("CreateCertificate: %v", err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	signer, err := NewSigner(NewIdentity(cert, cert.PublicKey, "Org1MSP"), privateKey)
+	if err != nil {
+		t.Fatalf("NewSigner: %v", err)
+	}
+	return signer
+}
+
+func TestSignerSignsDigestForEveryKeyType(t *testing.T) {
+	ecdsaKey, err := mspcrypto.DefaultECDSAKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	ed25519Key, err := mspcrypto.GenerateEd25519Key()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	message := []byte("block header")
+	for name, key := range map[string]crypto.Signer{"ECDSA": ecdsaKey, "Ed25519": ed25519Key} {
+		t.Run(name, func(t *testing.T) {
+			signer := newTestSigner(t, key)
+			digest := sha256.Sum256(message)
+			// 저장소 전체가 opts 없이 메시지의 SHA256 해시에 서명한다
+			signature, err := signer.Sign(rand.Reader, digest[:], nil)
+			if err != nil {
+				t.Fatalf("Sign: %v", err)
+			}
+			if err := signer.Verify(message, signature); err != nil {
+				t.Fatalf("Verify: %v", err)
+			}
+			if err := signer.Verify([]byte("other header"), signature); err == nil {
+				t.Fatal("Verify accepted a signature over a different message")
+			}
+		})
+	}
+}
+
+func TestSignerPublicKeyExportRoundTrip(t *testing.T) {
+	ecdsaKey, err := mspcrypto.DefaultECDSAKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	ed25519Key, err := mspcrypto.GenerateEd25519Key()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for name, key := range map[string]crypto.Signer{"ECDSA": ecdsaKey, "Ed25519": ed25519Key} {
+		t.Run(name, func(t *testing.T) {
+			var signer SigningIdentity = newTestSigner(t, key)
+
+			der, err := signer.GetPublicKeyDER()
+			if err != nil {
+				t.Fatalf("GetPublicKeyDER: %v", err)
+			}
+			publicKey, err := x509.ParsePKIXPublicKey(der)
+			if err != nil {
+				t.Fatalf("ParsePKIXPublicKey: %v", err)
+			}
+			if !reflect.DeepEqual(publicKey, key.Public()) {
+				t.Errorf("DER public key = %v, want %v", publicKey, key.Public())
+			}
+
+			pemBytes, err := signer.GetPublicKeyPEM()
+			if err != nil {
+				t.Fatalf("GetPublicKeyPEM: %v", err)
+			}
+			block, rest := pem.Decode(pemBytes)
+			if block == nil || block.Type != "PUBLIC KEY" || len(rest) != 0 {
+				t.Fatalf("GetPublicKeyPEM returned %q", pemBytes)
+			}
+			publicKey, err = x509.ParsePKIXPublicKey(block.Bytes)
+			if err != nil {
+				t.Fatalf("ParsePKIXPublicKey(PEM): %v", err)
+			}
+			if !reflect.DeepEqual(publicKey, key.Public()) {
+				t.Errorf("PEM public key = %v, want %v", publicKey, key.Public())
+			}
+		})
+	}
+}
@@ -0,0 +1,60 @@
+package msp
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"os"
+
+	"github.com/pkg/errors"
+)
+
+// BuildServerTLSConfig 서버 인증서/키와 클라이언트 검증용 CA로 mutual TLS 서버 설정 생성
+// caFile로 서명된 클라이언트 인증서를 제시하지 않는 연결은 handshake 단계에서 거부된다.
+func BuildServerTLSConfig(certFile, keyFile, caFile string) (*tls.Config, error) {
+	serverCert, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to load TLS key pair (cert: %s, key: %s)", certFile, keyFile)
+	}
+
+	caPEM, err := os.ReadFile(caFile)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read TLS CA file: %s", caFile)
+	}
+	clientCAs := x509.NewCertPool()
+	if !clientCAs.AppendCertsFromPEM(caPEM) {
+		return nil, errors.Errorf("no valid certificate found in TLS CA file: %s", caFile)
+	}
+
+	return &tls.Config{
+		Certificates: []tls.Certificate{serverCert},
+		ClientCAs:    clientCAs,
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		MinVersion:   tls.VersionTLS12,
+	}, nil
+}
+
+// BuildTLSClientConfig MSP의 TLS root CA로 서버 인증서를 검증하는 클라이언트 TLS 설정 생성
+// TLS root CA가 없으면 MSP root CA를 사용하며, 서명 인증서를 mutual TLS 클라이언트 인증서로 제시한다.
+func BuildTLSClientConfig(m MSP, serverName string) (*tls.Config, error) {
+	rootCAs, err := m.GetTLSRootCertPool()
+	if err != nil {
+		if rootCAs, err = m.GetRootCertPool(); err != nil {
+			return nil, errors.Wrap(err, "failed to get root cert pool")
+		}
+	}
+
+	tlsConfig := &tls.Config{
+		RootCAs:    rootCAs,
+		ServerName: serverName,
+		MinVersion: tls.VersionTLS12,
+	}
+	if signer := m.GetSigningIdentity(); signer != nil {
+		signCert := signer.GetCertificate()
+		tlsConfig.Certificates = []tls.Certificate{{
+			Certificate: [][]byte{signCert.Raw},
+			PrivateKey:  signer,
+			Leaf:        signCert,
+		}}
+	}
+	return tlsConfig, nil
+}
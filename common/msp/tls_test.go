@@ -0,0 +1,92 @@
+package msp_test
+
+import (
+	"crypto/x509"
+	"testing"
+
+	"github.com/ddr4869/minifab/common/msp"
+	"github.com/ddr4869/minifab/common/msp/msptest"
+)
+
+func TestGetRootCertPool(t *testing.T) {
+	org := msptest.NewOrg(t, "Org1MSP")
+	other := msptest.NewOrg(t, "Org2MSP")
+
+	pool, err := org.MSP.GetRootCertPool()
+	if err != nil {
+		t.Fatalf("GetRootCertPool: %v", err)
+	}
+	opts := x509.VerifyOptions{Roots: pool, KeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageAny}}
+	if _, err := org.SignCert.Verify(opts); err != nil {
+		t.Errorf("certificate issued by the root did not verify: %v", err)
+	}
+	if _, err := other.SignCert.Verify(opts); err == nil {
+		t.Error("certificate from another CA verified against the pool")
+	}
+
+	if _, err := msp.NewFabricMSP().GetRootCertPool(); err == nil {
+		t.Error("GetRootCertPool succeeded without a root certificate")
+	}
+}
+
+func TestGetTLSRootCertPool(t *testing.T) {
+	org := msptest.NewOrg(t, "Org1MSP")
+	if _, err := org.MSP.GetTLSRootCertPool(); err == nil {
+		t.Fatal("GetTLSRootCertPool succeeded without TLS root certificates")
+	}
+
+	tlsCA1 := msptest.NewOrg(t, "Org1TLS1")
+	tlsCA2 := msptest.NewOrg(t, "Org1TLS2")
+	org.MSP.TLSRootCerts = []*x509.Certificate{tlsCA1.CACert, tlsCA2.CACert}
+
+	pool, err := org.MSP.GetTLSRootCertPool()
+	if err != nil {
+		t.Fatalf("GetTLSRootCertPool: %v", err)
+	}
+	opts := x509.VerifyOptions{Roots: pool, DNSName: "orderer0", KeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth}}
+	for _, ca := range []*msptest.Org{tlsCA1, tlsCA2} {
+		cert, _ := ca.IssueTLS(t, "orderer0", "orderer0")
+		if _, err := cert.Verify(opts); err != nil {
+			t.Errorf("TLS certificate issued by %s did not verify: %v", ca.MSPID, err)
+		}
+	}
+	// MSP root CA는 TLS pool에 포함되지 않는다
+	cert, _ := org.IssueTLS(t, "orderer0", "orderer0")
+	if _, err := cert.Verify(opts); err == nil {
+		t.Error("certificate from the MSP root CA verified against the TLS pool")
+	}
+}
+
+func TestBuildTLSClientConfig(t *testing.T) {
+	org := msptest.NewOrg(t, "Org1MSP")
+	serverCert, _ := org.IssueTLS(t, "orderer0", "orderer0")
+
+	tlsConfig, err := msp.BuildTLSClientConfig(org.MSP, "orderer0")
+	if err != nil {
+		t.Fatalf("BuildTLSClientConfig: %v", err)
+	}
+	if tlsConfig.ServerName != "orderer0" {
+		t.Errorf("ServerName = %q, want orderer0", tlsConfig.ServerName)
+	}
+	// TLS root CA가 없으면 MSP root CA로 서버 인증서를 검증한다
+	if _, err := serverCert.Verify(x509.VerifyOptions{Roots: tlsConfig.RootCAs, DNSName: "orderer0"}); err != nil {
+		t.Errorf("server certificate did not verify with the fallback root pool: %v", err)
+	}
+	if len(tlsConfig.Certificates) != 1 || tlsConfig.Certificates[0].Leaf != org.SignCert {
+		t.Error("client certificate is not the MSP signing certificate")
+	}
+
+	tlsCA := msptest.NewOrg(t, "Org1TLS")
+	org.MSP.TLSRootCerts = []*x509.Certificate{tlsCA.CACert}
+	tlsConfig, err = msp.BuildTLSClientConfig(org.MSP, "orderer0")
+	if err != nil {
+		t.Fatalf("BuildTLSClientConfig: %v", err)
+	}
+	if _, err := serverCert.Verify(x509.VerifyOptions{Roots: tlsConfig.RootCAs, DNSName: "orderer0"}); err == nil {
+		t.Error("MSP root CA was trusted although TLS root certificates are configured")
+	}
+	tlsServerCert, _ := tlsCA.IssueTLS(t, "orderer0", "orderer0")
+	if _, err := tlsServerCert.Verify(x509.VerifyOptions{Roots: tlsConfig.RootCAs, DNSName: "orderer0"}); err != nil {
+		t.Errorf("TLS server certificate did not verify: %v", err)
+	}
+}
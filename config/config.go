@@ -3,7 +3,9 @@ package config
 import (
 	"bufio"
 	"os"
+	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/ddr4869/minifab/common/logger"
 	"github.com/ddr4869/minifab/common/msp"
@@ -11,13 +13,17 @@ import (
 )
 
 type PeerCfg struct {
-	MSPPath        string
-	MSPID          string
-	MSP            msp.MSP
-	Address        string
-	FilesystemPath string
-	TLSEnabled     bool
-	TLSCertFile    string
+	ID              string
+	MSPPath         string
+	MSPID           string
+	MSP             msp.MSP
+	Address         string
+	FilesystemPath  string
+	LedgerPath      string
+	TLSEnabled      bool
+	TLSCertFile     string
+	TLSKeyFile      string
+	TLSRootCertFile string
 }
 
 type OrdererCfg struct {
@@ -27,8 +33,13 @@ type OrdererCfg struct {
 	Address        string
 	FilesystemPath string
 	GenesisPath    string
+	AdminAddress   string
+	DrainTimeout   time.Duration
 }
 
+// DefaultDrainTimeout orderer 종료 시 대기 중인 트랜잭션을 블록으로 저장하기까지 기다리는 기본 시간
+const DefaultDrainTimeout = 5 * time.Second
+
 type ClientCfg struct {
 	MSPPath string
 	MSPID   string
@@ -64,12 +75,15 @@ func LoadPeerConfig(peerName string) (*Config, error) {
 
 	config := &Config{
 		Peer: &PeerCfg{
-			MSPPath:        getEnvOrDefault(envPrefix+"MSP_PATH", "/Users/mac/go/src/github.com/ddr4869/minifab/ca/Org1/ca-client/peer0"),
-			MSPID:          getEnvOrDefault(envPrefix+"MSPID", "Org1MSP"),
-			Address:        getEnvOrDefault(envPrefix+"ADDRESS", "127.0.0.1:7051"),
-			FilesystemPath: getEnvOrDefault(envPrefix+"FILESYSTEM_PATH", "/Users/mac/go/src/github.com/ddr4869/minifab/nodedata/org1peer0"),
-			TLSEnabled:     getEnvBoolOrDefault("TLS_ENABLED", false),
-			TLSCertFile:    getEnvOrDefault("TLS_ROOTCERT_FILE", "/Users/mac/go/src/github.com/ddr4869/minifab/ca/Org1/ca-client/peer0/cacerts/ca.crt"),
+			ID:              peerName,
+			MSPPath:         getEnvOrDefault(envPrefix+"MSP_PATH", "/Users/mac/go/src/github.com/ddr4869/minifab/ca/Org1/ca-client/peer0"),
+			MSPID:           getEnvOrDefault(envPrefix+"MSPID", "Org1MSP"),
+			Address:         getEnvOrDefault(envPrefix+"ADDRESS", "127.0.0.1:7051"),
+			FilesystemPath:  getEnvOrDefault(envPrefix+"FILESYSTEM_PATH", "/Users/mac/go/src/github.com/ddr4869/minifab/nodedata/org1peer0"),
+			TLSEnabled:      getEnvBoolOrDefault("TLS_ENABLED", false),
+			TLSCertFile:     getEnvOrDefault(envPrefix+"TLS_CERT_FILE", "/Users/mac/go/src/github.com/ddr4869/minifab/ca/Org1/ca-client/peer0/signcerts/cert.pem"),
+			TLSKeyFile:      getEnvOrDefault(envPrefix+"TLS_KEY_FILE", ""),
+			TLSRootCertFile: getEnvOrDefault("TLS_ROOTCERT_FILE", "/Users/mac/go/src/github.com/ddr4869/minifab/ca/Org1/ca-client/peer0/cacerts/ca.crt"),
 		},
 		Orderer: &OrdererCfg{
 			MSPPath:        getEnvOrDefault("ORDERER_MSP_PATH", "/Users/mac/go/src/github.com/ddr4869/minifab/ca/OrdererOrg/ca-client/orderer0"),
@@ -87,6 +101,8 @@ func LoadPeerConfig(peerName string) (*Config, error) {
 			Profile: getEnvOrDefault("PROFILE_NAME", "testchannel0"),
 		},
 	}
+	// 블록 저장 경로는 지정되지 않으면 peer 파일 시스템 경로 하위의 blocks 폴더를 사용
+	config.Peer.LedgerPath = getEnvOrDefault(envPrefix+"LEDGER_PATH", filepath.Join(config.Peer.FilesystemPath, "blocks"))
 
 	return config, nil
 }
@@ -104,6 +120,8 @@ func LoadOrdererConfig(ordererId string) (*OrdererCfg, error) {
 		Address:        getEnvOrDefault("ORDERER_ADDRESS", "127.0.0.1:7050"),
 		GenesisPath:    getEnvOrDefault("GENESIS_PATH", "/Users/mac/go/src/github.com/ddr4869/minifab/nodedata/orderer0/genesis.block"),
 		FilesystemPath: getEnvOrDefault("ORDERER_FILESYSTEM_PATH", "/Users/mac/go/src/github.com/ddr4869/minifab/nodedata/orderer0"),
+		AdminAddress:   getEnvOrDefault("ORDERER_ADMIN_ADDRESS", ""),
+		DrainTimeout:   getEnvDurationOrDefault("ORDERER_DRAIN_TIMEOUT", DefaultDrainTimeout),
 	}
 
 	return ordererCfg, nil
@@ -208,6 +226,18 @@ func getEnvBoolOrDefault(key string, defaultValue bool) bool {
 	return defaultValue
 }
 
+func getEnvDurationOrDefault(key string, defaultValue time.Duration) time.Duration {
+	if value := os.Getenv(key); value != "" {
+		duration, err := time.ParseDuration(value)
+		if err != nil {
+			logger.Warnf("Invalid duration for %s: %s, using default %s", key, value, defaultValue)
+			return defaultValue
+		}
+		return duration
+	}
+	return defaultValue
+}
+
 func (c *Config) GetPeerMSPPath() string {
 	return c.Peer.MSPPath
 }
@@ -225,6 +255,7 @@ func (c *Config) PrintConfig() {
 	logger.Infof(" > Peer MSP Path: %s", c.Peer.MSPPath)
 	logger.Infof(" > Peer MSP ID: %s", c.Peer.MSPID)
 	logger.Infof(" > Peer Address: %s", c.Peer.Address)
+	logger.Infof(" > Peer Ledger Path: %s", c.Peer.LedgerPath)
 	logger.Infof(" > TLS Enabled: %t", c.Peer.TLSEnabled)
 	logger.Infof(" > Orderer MSP Path: %s", c.Orderer.MSPPath)
 	logger.Infof(" > Orderer Address: %s", c.Orderer.Address)
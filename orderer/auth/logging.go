@@ -0,0 +1,72 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"time"
+
+	"github.com/ddr4869/minifab/common/logger"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// UnaryLoggingInterceptor unary RPC의 시작/종료를 request_id와 함께 기록
+func UnaryLoggingInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		done := logRPCStart(ctx, info.FullMethod)
+		resp, err := handler(ctx, req)
+		done(err)
+		return resp, err
+	}
+}
+
+// StreamLoggingInterceptor stream RPC의 시작/종료를 request_id와 함께 기록
+func StreamLoggingInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		done := logRPCStart(ss.Context(), info.FullMethod)
+		err := handler(srv, ss)
+		done(err)
+		return err
+	}
+}
+
+// logRPCStart 시작 로그를 남기고, RPC 종료 시 호출할 종료 로그 함수를 반환
+// 성공은 Debug, 실패는 Error 레벨로 기록한다.
+func logRPCStart(ctx context.Context, method string) func(err error) {
+	log := logger.Named("grpc").With(
+		"request_id", newRequestID(),
+		"method", method,
+		"peer", peerAddress(ctx),
+	)
+	start := time.Now()
+	log.Debugw("RPC started", "start_time", start.Format(time.RFC3339Nano))
+
+	return func(err error) {
+		code := status.Code(err)
+		fields := []any{"duration", time.Since(start), "code", code.String()}
+		if err != nil {
+			log.Errorw("RPC failed", append(fields, zap.Error(err))...)
+			return
+		}
+		log.Debugw("RPC finished", fields...)
+	}
+}
+
+func peerAddress(ctx context.Context) string {
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		return p.Addr.String()
+	}
+	return "unknown"
+}
+
+// newRequestID 16자리 hex 문자열의 request ID 생성
+func newRequestID() string {
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		return "0000000000000000"
+	}
+	return hex.EncodeToString(b)
+}
@@ -0,0 +1,124 @@
+package auth
+
+import (
+	"context"
+	"net"
+	"regexp"
+	"testing"
+
+	"github.com/ddr4869/minifab/common/logger"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// observeLogs 전역 logger를 Debug 이상을 기록하는 observer로 교체 (테스트 종료 시 복원)
+func observeLogs(t *testing.T) *observer.ObservedLogs {
+	t.Helper()
+
+	core, logs := observer.New(zapcore.DebugLevel)
+	previous := logger.GetLogger()
+	logger.Logger = zap.New(core).Sugar()
+	t.Cleanup(func() { logger.Logger = previous })
+	return logs
+}
+
+// testServerStream Context만 제공하는 grpc.ServerStream
+type testServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *testServerStream) Context() context.Context { return s.ctx }
+
+func peerContext() context.Context {
+	return peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.IPv4(10, 0, 0, 7), Port: 7051}})
+}
+
+var requestIDPattern = regexp.MustCompile(`^[0-9a-f]{16}$`)
+
+// assertRPCLogPair 시작/종료 로그 두 줄이 같은 request_id와 method, peer를 가지는지 확인
+func assertRPCLogPair(t *testing.T, entries []observer.LoggedEntry, method string, exitLevel zapcore.Level, code codes.Code) {
+	t.Helper()
+
+	if len(entries) != 2 {
+		t.Fatalf("got %d log entries, want 2", len(entries))
+	}
+	entry, exit := entries[0].ContextMap(), entries[1].ContextMap()
+	requestID, _ := entry["request_id"].(string)
+	if !requestIDPattern.MatchString(requestID) {
+		t.Errorf("request_id = %q, want 16 hex characters", requestID)
+	}
+	if exit["request_id"] != requestID {
+		t.Errorf("exit request_id = %v, want %s", exit["request_id"], requestID)
+	}
+	for _, fields := range []map[string]any{entry, exit} {
+		if fields["method"] != method || fields["peer"] != "10.0.0.7:7051" {
+			t.Errorf("method/peer = %v/%v, want %s/10.0.0.7:7051", fields["method"], fields["peer"], method)
+		}
+	}
+	if _, ok := entry["start_time"]; !ok {
+		t.Error("entry line has no start_time")
+	}
+	if _, ok := exit["duration"]; !ok {
+		t.Error("exit line has no duration")
+	}
+	if exit["code"] != code.String() {
+		t.Errorf("exit code = %v, want %s", exit["code"], code)
+	}
+	if entries[0].Level != zapcore.DebugLevel || entries[1].Level != exitLevel {
+		t.Errorf("levels = %s/%s, want debug/%s", entries[0].Level, entries[1].Level, exitLevel)
+	}
+	if entries[0].LoggerName != "grpc" {
+		t.Errorf("logger name = %q, want grpc", entries[0].LoggerName)
+	}
+}
+
+func TestUnaryLoggingInterceptor(t *testing.T) {
+	logs := observeLogs(t)
+	info := &grpc.UnaryServerInfo{FullMethod: "/orderer.OrdererService/GetBlock"}
+
+	_, err := UnaryLoggingInterceptor()(peerContext(), nil, info, func(ctx context.Context, req any) (any, error) {
+		return "ok", nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertRPCLogPair(t, logs.TakeAll(), info.FullMethod, zapcore.DebugLevel, codes.OK)
+
+	_, err = UnaryLoggingInterceptor()(peerContext(), nil, info, func(ctx context.Context, req any) (any, error) {
+		return nil, status.Error(codes.NotFound, "no block")
+	})
+	if status.Code(err) != codes.NotFound {
+		t.Fatalf("err = %v, want NotFound", err)
+	}
+	assertRPCLogPair(t, logs.TakeAll(), info.FullMethod, zapcore.ErrorLevel, codes.NotFound)
+}
+
+func TestStreamLoggingInterceptor(t *testing.T) {
+	logs := observeLogs(t)
+	info := &grpc.StreamServerInfo{FullMethod: "/orderer.OrdererService/DeliverBlocks", IsServerStream: true}
+
+	err := StreamLoggingInterceptor()(nil, &testServerStream{ctx: peerContext()}, info, func(srv any, stream grpc.ServerStream) error {
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertRPCLogPair(t, logs.TakeAll(), info.FullMethod, zapcore.DebugLevel, codes.OK)
+}
+
+func TestRequestIDsAreUnique(t *testing.T) {
+	seen := make(map[string]bool)
+	for i := 0; i < 100; i++ {
+		id := newRequestID()
+		if seen[id] {
+			t.Fatalf("duplicate request ID %s", id)
+		}
+		seen[id] = true
+	}
+}
@@ -0,0 +1,35 @@
+package auth
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// RPCMetricsRecorder RPC가 끝날 때마다 method, 결과 코드, 처리 시간을 받는 metric 수집기
+type RPCMetricsRecorder interface {
+	ObserveRPC(method string, code codes.Code, duration time.Duration)
+}
+
+// UnaryMetricsInterceptor unary RPC의 결과와 처리 시간을 recorder에 기록
+func UnaryMetricsInterceptor(recorder RPCMetricsRecorder) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		start := time.Now()
+		resp, err := handler(ctx, req)
+		recorder.ObserveRPC(info.FullMethod, status.Code(err), time.Since(start))
+		return resp, err
+	}
+}
+
+// StreamMetricsInterceptor stream RPC의 결과와 전체 처리 시간을 recorder에 기록
+func StreamMetricsInterceptor(recorder RPCMetricsRecorder) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		start := time.Now()
+		err := handler(srv, ss)
+		recorder.ObserveRPC(info.FullMethod, status.Code(err), time.Since(start))
+		return err
+	}
+}
@@ -0,0 +1,96 @@
+package auth
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+)
+
+// PrometheusRPCMetrics RPC 결과를 모아 Prometheus text 형식으로 내보내는 RPCMetricsRecorder
+// Prometheus client library 없이 admin 서버의 /metrics endpoint에서 바로 수집할 수 있다.
+type PrometheusRPCMetrics struct {
+	mutex     sync.Mutex
+	requests  map[rpcCodeKey]uint64
+	durations map[string]*durationSummary
+}
+
+// rpcCodeKey minifab_orderer_grpc_requests_total의 label 조합
+type rpcCodeKey struct {
+	method string
+	code   codes.Code
+}
+
+// durationSummary method별 처리 시간 합계(초)와 RPC 수
+type durationSummary struct {
+	sum   float64
+	count uint64
+}
+
+func NewPrometheusRPCMetrics() *PrometheusRPCMetrics {
+	return &PrometheusRPCMetrics{
+		requests:  make(map[rpcCodeKey]uint64),
+		durations: make(map[string]*durationSummary),
+	}
+}
+
+// ObserveRPC method와 결과 코드별 요청 수와 method별 처리 시간 누적
+func (m *PrometheusRPCMetrics) ObserveRPC(method string, code codes.Code, duration time.Duration) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	m.requests[rpcCodeKey{method: method, code: code}]++
+	summary, exists := m.durations[method]
+	if !exists {
+		summary = &durationSummary{}
+		m.durations[method] = summary
+	}
+	summary.sum += duration.Seconds()
+	summary.count++
+}
+
+// WriteMetrics minifab_orderer_grpc_requests_total counter와
+// minifab_orderer_grpc_request_duration_seconds summary를 Prometheus text 형식으로 기록
+func (m *PrometheusRPCMetrics) WriteMetrics(w io.Writer) {
+	m.mutex.Lock()
+	requests := make(map[rpcCodeKey]uint64, len(m.requests))
+	for key, count := range m.requests {
+		requests[key] = count
+	}
+	durations := make(map[string]durationSummary, len(m.durations))
+	for method, summary := range m.durations {
+		durations[method] = *summary
+	}
+	m.mutex.Unlock()
+
+	keys := make([]rpcCodeKey, 0, len(requests))
+	for key := range requests {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].method != keys[j].method {
+			return keys[i].method < keys[j].method
+		}
+		return keys[i].code < keys[j].code
+	})
+	methods := make([]string, 0, len(durations))
+	for method := range durations {
+		methods = append(methods, method)
+	}
+	sort.Strings(methods)
+
+	fmt.Fprintln(w, "# HELP minifab_orderer_grpc_requests_total Number of gRPC requests handled, by method and status code.")
+	fmt.Fprintln(w, "# TYPE minifab_orderer_grpc_requests_total counter")
+	for _, key := range keys {
+		fmt.Fprintf(w, "minifab_orderer_grpc_requests_total{method=%q,code=%q} %d\n", key.method, key.code.String(), requests[key])
+	}
+	fmt.Fprintln(w, "# HELP minifab_orderer_grpc_request_duration_seconds Time spent handling gRPC requests, by method.")
+	fmt.Fprintln(w, "# TYPE minifab_orderer_grpc_request_duration_seconds summary")
+	for _, method := range methods {
+		fmt.Fprintf(w, "minifab_orderer_grpc_request_duration_seconds_sum{method=%q} %g\n", method, durations[method].sum)
+		fmt.Fprintf(w, "minifab_orderer_grpc_request_duration_seconds_count{method=%q} %d\n", method, durations[method].count)
+	}
+}
@@ -0,0 +1,39 @@
+package auth
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+)
+
+func TestPrometheusRPCMetrics(t *testing.T) {
+	metrics := NewPrometheusRPCMetrics()
+	metrics.ObserveRPC("/orderer.OrdererService/Echo", codes.OK, 100*time.Millisecond)
+	metrics.ObserveRPC("/orderer.OrdererService/Echo", codes.OK, 300*time.Millisecond)
+	metrics.ObserveRPC("/orderer.OrdererService/Echo", codes.ResourceExhausted, 0)
+	metrics.ObserveRPC("/orderer.OrdererService/CreateChannel", codes.InvalidArgument, time.Second)
+
+	var out bytes.Buffer
+	metrics.WriteMetrics(&out)
+	for _, want := range []string{
+		"# TYPE minifab_orderer_grpc_requests_total counter",
+		`minifab_orderer_grpc_requests_total{method="/orderer.OrdererService/CreateChannel",code="InvalidArgument"} 1`,
+		`minifab_orderer_grpc_requests_total{method="/orderer.OrdererService/Echo",code="OK"} 2`,
+		`minifab_orderer_grpc_requests_total{method="/orderer.OrdererService/Echo",code="ResourceExhausted"} 1`,
+		"# TYPE minifab_orderer_grpc_request_duration_seconds summary",
+		`minifab_orderer_grpc_request_duration_seconds_sum{method="/orderer.OrdererService/Echo"} 0.4`,
+		`minifab_orderer_grpc_request_duration_seconds_count{method="/orderer.OrdererService/Echo"} 3`,
+		`minifab_orderer_grpc_request_duration_seconds_count{method="/orderer.OrdererService/CreateChannel"} 1`,
+	} {
+		if !strings.Contains(out.String(), want+"\n") {
+			t.Errorf("metrics output is missing %q:\n%s", want, out.String())
+		}
+	}
+	// label 순서가 고정되어 있어 scrape마다 같은 순서로 기록된다
+	if strings.Index(out.String(), "CreateChannel") > strings.Index(out.String(), "/Echo") {
+		t.Errorf("methods are not sorted:\n%s", out.String())
+	}
+}
@@ -0,0 +1,64 @@
+package auth
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// RateLimiter 초당 rps개의 토큰을 채우고 최대 burst개까지 쌓아 두는 token bucket
+type RateLimiter struct {
+	mutex  sync.Mutex
+	rps    float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+func NewRateLimiter(rps float64, burst int) *RateLimiter {
+	return &RateLimiter{
+		rps:    rps,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   time.Now(),
+	}
+}
+
+// Allow 토큰이 남아 있으면 하나를 소비하고 true 반환
+func (l *RateLimiter) Allow() bool {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	now := time.Now()
+	l.tokens = min(l.burst, l.tokens+now.Sub(l.last).Seconds()*l.rps)
+	l.last = now
+	if l.tokens < 1 {
+		return false
+	}
+	l.tokens--
+	return true
+}
+
+// UnaryRateLimitInterceptor 한도를 넘은 unary RPC를 ResourceExhausted로 거부
+func UnaryRateLimitInterceptor(limiter *RateLimiter) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if !limiter.Allow() {
+			return nil, status.Errorf(codes.ResourceExhausted, "rate limit exceeded for %s", info.FullMethod)
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamRateLimitInterceptor 한도를 넘은 stream RPC를 ResourceExhausted로 거부 (stream 개설 시 한 번 확인)
+func StreamRateLimitInterceptor(limiter *RateLimiter) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if !limiter.Allow() {
+			return status.Errorf(codes.ResourceExhausted, "rate limit exceeded for %s", info.FullMethod)
+		}
+		return handler(srv, ss)
+	}
+}
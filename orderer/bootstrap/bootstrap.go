@@ -2,7 +2,10 @@ package bootstrap
 
 import (
 	"encoding/json"
+	"net"
 	"os"
+	"strings"
+	"time"
 
 	"github.com/ddr4869/minifab/common/blockutil"
 	"github.com/ddr4869/minifab/common/configtx"
@@ -19,8 +22,13 @@ var (
 	mspPath      string
 	genesisPath  string
 	configTxPath string
+	genesisJSON  string
 	profile      string
 	bootstrap    bool
+	// migrateGenesis 제네시스 블록을 새로 만들지 않고 예전 JSON 제네시스를 protobuf로 변환만 함
+	migrateGenesis bool
+	// ordererEndpoints --orderer-endpoint로 지정한 orderer endpoint 목록 (지정하면 configtx의 목록을 대체)
+	ordererEndpoints []string
 )
 
 const (
@@ -45,22 +53,51 @@ This command should be run once when setting up a new network.`,
 	// Bootstrap command flags
 	bootstrapCmd.Flags().StringVar(&genesisPath, "genesisPath", "/Users/mac/go/src/github.com/ddr4869/minifab/nodedata/orderer0/genesis.block", "Path to save/load genesis block file")
 	bootstrapCmd.Flags().StringVar(&configTxPath, "configtx", "/Users/mac/go/src/github.com/ddr4869/minifab/config/configtx.yaml", "Path to configtx.yaml file")
+	bootstrapCmd.Flags().StringVar(&genesisJSON, "genesis-config-json", "", "Path to a pre-generated genesis config JSON file (alternative to --configtx)")
 	bootstrapCmd.Flags().StringVar(&profile, "profile", "SystemChannel", "Profile name to use for genesis block")
+	bootstrapCmd.MarkFlagsMutuallyExclusive("configtx", "genesis-config-json")
 	bootstrapCmd.Flags().BoolVar(&bootstrap, "bootstrap", false, "Bootstrap network with genesis block")
+	bootstrapCmd.Flags().BoolVar(&migrateGenesis, "migrate-genesis", false, "Convert the legacy JSON genesis next to --genesisPath to protobuf instead of generating a new genesis block")
+	bootstrapCmd.Flags().StringArrayVar(&ordererEndpoints, "orderer-endpoint", nil, "Orderer endpoint (host:port) to record in the genesis block; repeat for each orderer")
+
+	bootstrapCmd.AddCommand(verifyCmd())
+	bootstrapCmd.AddCommand(initCmd())
 
 	return bootstrapCmd
 }
 
 func runBootstrap(cmd *cobra.Command, args []string) {
+	if migrateGenesis {
+		if _, err := blockutil.LoadSystemChannelConfigWithMigration(genesisPath); err != nil {
+			logger.Fatalf("Failed to migrate genesis block: %v", err)
+		}
+		logger.Infof("Genesis block is available at %s", genesisPath)
+		return
+	}
+
 	logger.Info("Starting network bootstrap process...")
 
-	// configtx.yaml에서 제네시스 설정 생성 (profile 인자 추가)
-	genesisConfig, err := CreateGenesisConfigFromConfigTx(configTxPath, profile)
+	// configtx.yaml 또는 미리 생성된 JSON에서 제네시스 설정 생성 (profile 인자 추가)
+	configSource := configTxPath
+	var genesisConfig *configtx.SystemChannelInfo
+	var err error
+	if genesisJSON != "" {
+		configSource = genesisJSON
+		genesisConfig, err = CreateGenesisConfigFromJSON(genesisJSON)
+	} else {
+		genesisConfig, err = CreateGenesisConfigFromConfigTx(configTxPath, profile)
+	}
 	if err != nil {
-		logger.Fatalf("Failed to load configtx.yaml: %v", err)
+		logger.Fatalf("Failed to load genesis config from %s: %v", configSource, err)
+	}
+	if len(ordererEndpoints) > 0 {
+		WithOrdererEndpoints(ordererEndpoints)(genesisConfig)
+		if err := validateOrdererEndpoints(genesisConfig.Orderer.Organization.OrdererEndpoints); err != nil {
+			logger.Fatalf("Invalid --orderer-endpoint: %v", err)
+		}
 	}
 
-	logger.Info("Successfully loaded configuration from configtx.yaml")
+	logger.Infof("Successfully loaded configuration from %s", configSource)
 
 	// 네트워크 부트스트랩 실행
 	if err := bootstrapNetwork(genesisConfig); err != nil {
@@ -68,13 +105,17 @@ func runBootstrap(cmd *cobra.Command, args []string) {
 	}
 
 	logger.Info("Network bootstrap completed successfully!")
-	logger.Infof("Configuration loaded from: %s", configTxPath)
+	logger.Infof("Configuration loaded from: %s", configSource)
 	logger.Info("You can now start the orderer with: ./bin/orderer")
 }
 
 // CreateGenesisConfigFromConfigTx configtx.yaml 파일에서 ConfigTx 생성
 func CreateGenesisConfigFromConfigTx(configTxPath string, profile string) (*configtx.SystemChannelInfo, error) {
+	return CreateGenesisConfigFromConfigTxWithOptions(configTxPath, profile)
+}
 
+// CreateGenesisConfigFromConfigTxWithOptions configtx.yaml 파일에서 ConfigTx 생성 후 옵션을 순서대로 적용
+func CreateGenesisConfigFromConfigTxWithOptions(configTxPath string, profile string, opts ...GenesisOption) (*configtx.SystemChannelInfo, error) {
 	ccfg, err := configtx.ConvertConfigtx(configTxPath)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to convert configtx")
@@ -84,12 +125,100 @@ func CreateGenesisConfigFromConfigTx(configTxPath string, profile string) (*conf
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to convert configtx to genesis config")
 	}
+	for _, opt := range opts {
+		opt(genesisConfig)
+	}
+	for _, org := range genesisConfig.Consortiums {
+		for _, anchorPeer := range org.AnchorPeers {
+			logger.Infof("Anchor peer of %s: %s:%d", org.ID, anchorPeer.Host, anchorPeer.Port)
+		}
+	}
 
 	logger.Infof("Successfully loaded configuration from %s with profile %s", configTxPath, profile)
 
 	return genesisConfig, nil
 }
 
+// CreateGenesisConfigFromJSON 미리 생성된 JSON 파일(SystemChannelInfo 형식)에서 제네시스 설정을 읽고 검증
+func CreateGenesisConfigFromJSON(jsonPath string) (*configtx.SystemChannelInfo, error) {
+	file, err := os.Open(jsonPath)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to open genesis config JSON: %s", jsonPath)
+	}
+	defer file.Close()
+
+	decoder := json.NewDecoder(file)
+	decoder.DisallowUnknownFields()
+	genesisConfig := &configtx.SystemChannelInfo{}
+	if err := decoder.Decode(genesisConfig); err != nil {
+		return nil, errors.Wrapf(err, "failed to decode genesis config JSON: %s", jsonPath)
+	}
+	if err := validateGenesisConfig(genesisConfig); err != nil {
+		return nil, errors.Wrapf(err, "invalid genesis config: %s", jsonPath)
+	}
+
+	logger.Infof("Successfully loaded genesis config from %s", jsonPath)
+	return genesisConfig, nil
+}
+
+// validateGenesisConfig 제네시스 블록 생성 전 orderer 조직, BatchTimeout, consortium CA 인증서 검증
+// 발견한 모든 문제를 하나의 에러로 묶어 반환한다.
+func validateGenesisConfig(genesisConfig *configtx.SystemChannelInfo) error {
+	return joinGenesisErrors(genesisConfigErrors(genesisConfig))
+}
+
+// genesisConfigErrors 제네시스 설정의 모든 검증을 실행하고 발견한 에러를 순서대로 반환
+func genesisConfigErrors(genesisConfig *configtx.SystemChannelInfo) []error {
+	if genesisConfig == nil {
+		return []error{errors.New("system channel config is nil")}
+	}
+
+	var errs []error
+	ordererOrg := genesisConfig.Orderer.Organization
+	if ordererOrg.Name == "" || ordererOrg.ID == "" {
+		errs = append(errs, errors.New("orderer organization name and MSP ID are required"))
+	}
+	if genesisConfig.Orderer.BatchTimeout != "" {
+		if _, err := time.ParseDuration(genesisConfig.Orderer.BatchTimeout); err != nil {
+			errs = append(errs, errors.Wrapf(err, "invalid BatchTimeout %q", genesisConfig.Orderer.BatchTimeout))
+		}
+	}
+	if genesisConfig.Orderer.BatchSize.MaxMessageCount < 0 {
+		errs = append(errs, errors.Errorf("invalid MaxMessageCount %d", genesisConfig.Orderer.BatchSize.MaxMessageCount))
+	}
+	for _, endpoint := range ordererOrg.OrdererEndpoints {
+		if _, _, err := net.SplitHostPort(endpoint); err != nil {
+			errs = append(errs, errors.Wrapf(err, "invalid orderer endpoint %q", endpoint))
+		}
+	}
+	return append(errs, consortiumCertErrors(genesisConfig)...)
+}
+
+// joinGenesisErrors 검증 에러 목록을 하나의 에러로 합침 (에러가 없으면 nil)
+func joinGenesisErrors(errs []error) error {
+	if len(errs) == 0 {
+		return nil
+	}
+	if len(errs) == 1 {
+		return errs[0]
+	}
+	messages := make([]string, len(errs))
+	for i, err := range errs {
+		messages[i] = err.Error()
+	}
+	return errors.Errorf("%d genesis config errors: %s", len(errs), strings.Join(messages, "; "))
+}
+
+// validateOrdererEndpoints orderer endpoint가 host:port 형식인지 검증
+func validateOrdererEndpoints(endpoints []string) error {
+	for _, endpoint := range endpoints {
+		if _, _, err := net.SplitHostPort(endpoint); err != nil {
+			return errors.Wrapf(err, "invalid orderer endpoint %q", endpoint)
+		}
+	}
+	return nil
+}
+
 func bootstrapNetwork(genesisConfig *configtx.SystemChannelInfo) error {
 
 	err := generateGenesisBlock(genesisConfig)
@@ -103,16 +232,13 @@ func bootstrapNetwork(genesisConfig *configtx.SystemChannelInfo) error {
 }
 
 func generateGenesisBlock(genesisConfig *configtx.SystemChannelInfo) error {
-	configTxData, err := json.Marshal(genesisConfig)
-	if err != nil {
-		return errors.Wrap(err, "failed to marshal genesis config")
-	}
 	msp, err := msp.LoadMSPFromFiles(mspID, mspPath)
 	if err != nil {
 		return errors.Wrap(err, "failed to load MSP")
 	}
 
-	genesisBlock, err := blockutil.GenerateConfigBlock(configTxData, systemChannelName, msp.GetSigningIdentity())
+	signer := msp.GetSigningIdentity()
+	genesisBlock, err := NewGenesisBlockGenerator(genesisConfig, signer).SignWith(signer).GenerateGenesisBlock()
 	if err != nil {
 		return errors.Wrap(err, "failed to generate genesis block")
 	}
@@ -125,6 +251,10 @@ func generateGenesisBlock(genesisConfig *configtx.SystemChannelInfo) error {
 	if err := os.WriteFile(genesisPath, protoData, 0644); err != nil {
 		return errors.Wrap(err, "failed to write genesis block file")
 	}
+	// orderer가 시작할 때와 같은 경로로 다시 읽어 저장된 제네시스 블록 검증
+	if _, err := blockutil.LoadSystemChannelConfig(genesisPath); err != nil {
+		return errors.Wrap(err, "failed to verify written genesis block")
+	}
 	logger.Info("Genesis block created and saved at %s successfully", genesisPath)
 
 	jsonData, err := json.MarshalIndent(genesisBlock, "", "  ")
@@ -0,0 +1,77 @@
+package bootstrap
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+// writeGenesisConfigJSON data를 임시 디렉토리의 genesis-config.json에 쓰고 경로 반환
+func writeGenesisConfigJSON(t *testing.T, data []byte) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "genesis-config.json")
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestCreateGenesisConfigFromJSONRoundTrip(t *testing.T) {
+	want, err := CreateGenesisConfigFromConfigTx(writeTestConfigTx(t), "SystemChannel")
+	if err != nil {
+		t.Fatalf("CreateGenesisConfigFromConfigTx: %v", err)
+	}
+	data, err := json.Marshal(want)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := CreateGenesisConfigFromJSON(writeGenesisConfigJSON(t, data))
+	if err != nil {
+		t.Fatalf("CreateGenesisConfigFromJSON: %v", err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("round-tripped genesis config = %+v, want %+v", got, want)
+	}
+}
+
+func TestCreateGenesisConfigFromJSONRejectsInvalidConfig(t *testing.T) {
+	genesisConfig, err := CreateGenesisConfigFromConfigTx(writeTestConfigTx(t), "SystemChannel")
+	if err != nil {
+		t.Fatalf("CreateGenesisConfigFromConfigTx: %v", err)
+	}
+	valid, err := json.Marshal(genesisConfig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 유효한 설정에 알 수 없는 필드 하나만 추가
+	unknownField := append([]byte(`{"unknown_field":true,`), valid[1:]...)
+	genesisConfig.Orderer.BatchTimeout = "soon"
+	badTimeout, err := json.Marshal(genesisConfig)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name string
+		data []byte
+	}{
+		{"bad batch timeout", badTimeout},
+		{"unknown field", unknownField},
+		{"malformed JSON", []byte(`{"name":`)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := CreateGenesisConfigFromJSON(writeGenesisConfigJSON(t, tt.data)); err == nil {
+				t.Fatal("CreateGenesisConfigFromJSON accepted an invalid genesis config")
+			}
+		})
+	}
+
+	if _, err := CreateGenesisConfigFromJSON(filepath.Join(t.TempDir(), "missing.json")); err == nil {
+		t.Error("CreateGenesisConfigFromJSON accepted a missing file")
+	}
+}
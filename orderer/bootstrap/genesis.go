@@ -0,0 +1,66 @@
+package bootstrap
+
+import (
+	"encoding/json"
+
+	"github.com/ddr4869/minifab/common/blockutil"
+	"github.com/ddr4869/minifab/common/configtx"
+	"github.com/ddr4869/minifab/common/msp"
+	pb_common "github.com/ddr4869/minifab/proto/common"
+	"github.com/pkg/errors"
+)
+
+// GenesisBlockGenerator 시스템 채널 설정으로 제네시스 블록을 생성
+// SignWith로 서명자를 지정하면 블록 헤더에 대한 서명을 BlockMetadata에 기록한다 (blockutil.SignBlock).
+type GenesisBlockGenerator struct {
+	config  *configtx.SystemChannelInfo
+	creator msp.SigningIdentity
+	signer  msp.SigningIdentity
+}
+
+// NewGenesisBlockGenerator 설정 트랜잭션 생성자(creator)로 제네시스 블록 생성기 생성
+func NewGenesisBlockGenerator(config *configtx.SystemChannelInfo, creator msp.SigningIdentity) *GenesisBlockGenerator {
+	return &GenesisBlockGenerator{
+		config:  config,
+		creator: creator,
+	}
+}
+
+// SignWith 블록 서명에 사용할 identity 지정
+func (g *GenesisBlockGenerator) SignWith(identity msp.SigningIdentity) *GenesisBlockGenerator {
+	g.signer = identity
+	return g
+}
+
+// Validate 제네시스 설정의 모든 검증을 실행하고 발견한 문제를 한 번에 반환 (문제가 없으면 nil)
+func (g *GenesisBlockGenerator) Validate() []error {
+	errs := genesisConfigErrors(g.config)
+	if g.creator == nil {
+		errs = append(errs, errors.New("creator identity is nil"))
+	}
+	return errs
+}
+
+// GenerateGenesisBlock 설정을 검증한 뒤 제네시스 블록 생성 (서명자가 지정된 경우 블록에 서명)
+func (g *GenesisBlockGenerator) GenerateGenesisBlock() (*pb_common.Block, error) {
+	if err := joinGenesisErrors(g.Validate()); err != nil {
+		return nil, errors.Wrap(err, "invalid genesis config")
+	}
+
+	configTxData, err := json.Marshal(g.config)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal genesis config")
+	}
+	block, err := blockutil.GenerateConfigBlock(configTxData, systemChannelName, g.creator)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to generate genesis block")
+	}
+	if g.signer == nil {
+		return block, nil
+	}
+
+	if err := blockutil.SignBlock(block, g.signer); err != nil {
+		return nil, errors.Wrap(err, "failed to sign genesis block")
+	}
+	return block, nil
+}
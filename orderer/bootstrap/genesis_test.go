@@ -0,0 +1,174 @@
+package bootstrap
+
+import (
+	"bytes"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/ddr4869/minifab/common/blockutil"
+	"github.com/ddr4869/minifab/common/configtx"
+	"github.com/ddr4869/minifab/common/msp/msptest"
+)
+
+func TestGenerateGenesisBlockWithoutSigner(t *testing.T) {
+	genesisConfig, err := CreateGenesisConfigFromConfigTx(writeTestConfigTx(t), "SystemChannel")
+	if err != nil {
+		t.Fatalf("CreateGenesisConfigFromConfigTx: %v", err)
+	}
+	org := msptest.NewOrg(t, "OrdererMSP")
+
+	block, err := NewGenesisBlockGenerator(genesisConfig, org.SigningIdentity()).GenerateGenesisBlock()
+	if err != nil {
+		t.Fatalf("GenerateGenesisBlock: %v", err)
+	}
+	if failed := failedChecks(VerifyGenesisBlock(block)); len(failed) != 0 {
+		t.Errorf("unsigned genesis block failed %v", failed)
+	}
+	if err := blockutil.VerifyBlockSignature(block, org.MSP); err == nil || !strings.Contains(err.Error(), "not signed") {
+		t.Errorf("VerifyBlockSignature of unsigned block error = %v", err)
+	}
+}
+
+func TestGenerateGenesisBlockSignWith(t *testing.T) {
+	genesisConfig, err := CreateGenesisConfigFromConfigTx(writeTestConfigTx(t), "SystemChannel")
+	if err != nil {
+		t.Fatalf("CreateGenesisConfigFromConfigTx: %v", err)
+	}
+	creator := msptest.NewOrg(t, "OrdererMSP")
+	signerOrg := msptest.NewOrg(t, "OrdererMSP")
+
+	block, err := NewGenesisBlockGenerator(genesisConfig, creator.SigningIdentity()).SignWith(signerOrg.SigningIdentity()).GenerateGenesisBlock()
+	if err != nil {
+		t.Fatalf("GenerateGenesisBlock: %v", err)
+	}
+	if !bytes.Equal(block.Metadata.Identity.Creator, signerOrg.SignCert.Raw) {
+		t.Error("metadata identity is not the signer")
+	}
+	if err := blockutil.VerifyBlockSignature(block, signerOrg.MSP); err != nil {
+		t.Fatalf("VerifyBlockSignature: %v", err)
+	}
+	if err := blockutil.VerifyBlockSignature(block, creator.MSP); err == nil {
+		t.Error("signature verified against an MSP that did not issue the signer")
+	}
+
+	block.Header.DataHash = blockutil.CalculateDataHash([][]byte{[]byte("tampered")})
+	if err := blockutil.VerifyBlockSignature(block, signerOrg.MSP); err == nil {
+		t.Error("signature verified after the block header was tampered with")
+	}
+}
+
+func TestGenesisBlockGeneratorValidate(t *testing.T) {
+	genesisConfig, err := CreateGenesisConfigFromConfigTx(writeTestConfigTx(t), "SystemChannel")
+	if err != nil {
+		t.Fatalf("CreateGenesisConfigFromConfigTx: %v", err)
+	}
+
+	if _, err := NewGenesisBlockGenerator(genesisConfig, nil).GenerateGenesisBlock(); err == nil || !strings.Contains(err.Error(), "creator identity is nil") {
+		t.Errorf("GenerateGenesisBlock without creator error = %v", err)
+	}
+}
+
+func TestGenesisBlockContainsAnchorPeers(t *testing.T) {
+	dir := t.TempDir()
+	opts := configtx.DefaultSampleConfigTxOptions()
+	opts.OrdererMSPDir = msptest.NewOrg(t, "OrdererMSP").WriteMSPDir(t, filepath.Join(dir, "orderer"))
+	opts.PeerMSPDir = msptest.NewOrg(t, "Org1MSP").WriteMSPDir(t, filepath.Join(dir, "org1"))
+	opts.AnchorPeer = configtx.AnchorPeer{Host: "peer0.org1.example.com", Port: 9051}
+	path := filepath.Join(dir, "configtx.yaml")
+	if err := configtx.WriteSampleConfigTx(path, opts); err != nil {
+		t.Fatalf("WriteSampleConfigTx: %v", err)
+	}
+
+	genesisConfig, err := CreateGenesisConfigFromConfigTx(path, "SystemChannel")
+	if err != nil {
+		t.Fatalf("CreateGenesisConfigFromConfigTx: %v", err)
+	}
+	block, err := NewGenesisBlockGenerator(genesisConfig, msptest.NewOrg(t, "OrdererMSP").SigningIdentity()).GenerateGenesisBlock()
+	if err != nil {
+		t.Fatalf("GenerateGenesisBlock: %v", err)
+	}
+
+	info, err := blockutil.ExtractSystemChannelConfigFromBlock(block)
+	if err != nil {
+		t.Fatalf("ExtractSystemChannelConfigFromBlock: %v", err)
+	}
+	var org1 *configtx.Organization
+	for i := range info.Consortiums {
+		if info.Consortiums[i].ID == "Org1MSP" {
+			org1 = &info.Consortiums[i]
+		}
+	}
+	if org1 == nil {
+		t.Fatalf("genesis consortium %v has no Org1MSP", info.Consortiums)
+	}
+	want := []configtx.AnchorPeer{{Host: "peer0.org1.example.com", Port: 9051}}
+	if !reflect.DeepEqual(org1.AnchorPeers, want) {
+		t.Errorf("AnchorPeers = %v, want %v", org1.AnchorPeers, want)
+	}
+}
+
+func TestGenesisBlockContainsOrdererEndpoints(t *testing.T) {
+	endpoints := []string{"orderer0.example.com:7050", "orderer1.example.com:7050", "orderer2.example.com:7050"}
+	genesisConfig, err := CreateGenesisConfigFromConfigTxWithOptions(writeTestConfigTx(t), "SystemChannel", WithOrdererEndpoints(endpoints))
+	if err != nil {
+		t.Fatalf("CreateGenesisConfigFromConfigTxWithOptions: %v", err)
+	}
+	if err := validateGenesisConfig(genesisConfig); err != nil {
+		t.Fatalf("validateGenesisConfig: %v", err)
+	}
+	block, err := NewGenesisBlockGenerator(genesisConfig, msptest.NewOrg(t, "OrdererMSP").SigningIdentity()).GenerateGenesisBlock()
+	if err != nil {
+		t.Fatalf("GenerateGenesisBlock: %v", err)
+	}
+
+	info, err := blockutil.ExtractSystemChannelConfigFromBlock(block)
+	if err != nil {
+		t.Fatalf("ExtractSystemChannelConfigFromBlock: %v", err)
+	}
+	if got := info.Orderer.Organization.OrdererEndpoints; !reflect.DeepEqual(got, endpoints) {
+		t.Errorf("genesis orderer endpoints = %v, want %v", got, endpoints)
+	}
+}
+
+func TestValidateGenesisConfigRejectsInvalidOrdererEndpoint(t *testing.T) {
+	genesisConfig, err := CreateGenesisConfigFromConfigTxWithOptions(writeTestConfigTx(t), "SystemChannel", WithOrdererEndpoints([]string{"orderer0.example.com:7050", "bad"}))
+	if err != nil {
+		t.Fatalf("CreateGenesisConfigFromConfigTxWithOptions: %v", err)
+	}
+	if err := validateGenesisConfig(genesisConfig); err == nil || !strings.Contains(err.Error(), `"bad"`) {
+		t.Errorf("validateGenesisConfig error = %v, want the endpoint without a port rejected", err)
+	}
+}
+
+func TestGenesisBlockGeneratorValidateReportsEveryError(t *testing.T) {
+	genesisConfig, err := CreateGenesisConfigFromConfigTx(writeTestConfigTx(t), "SystemChannel")
+	if err != nil {
+		t.Fatalf("CreateGenesisConfigFromConfigTx: %v", err)
+	}
+	generator := NewGenesisBlockGenerator(genesisConfig, msptest.NewOrg(t, "OrdererMSP").SigningIdentity())
+	if errs := generator.Validate(); len(errs) != 0 {
+		t.Fatalf("Validate of a valid config = %v, want no errors", errs)
+	}
+
+	genesisConfig.Orderer.Organization.ID = ""
+	genesisConfig.Orderer.BatchTimeout = "soon"
+	genesisConfig.Orderer.BatchSize.MaxMessageCount = -1
+	genesisConfig.Consortiums[0].MSPCaCert = nil
+
+	errs := generator.Validate()
+	want := []string{"orderer organization name and MSP ID", "invalid BatchTimeout", "invalid MaxMessageCount", "has no MSP CA cert"}
+	if len(errs) != len(want) {
+		t.Fatalf("Validate returned %d errors, want %d: %v", len(errs), len(want), errs)
+	}
+	for i, err := range errs {
+		if !strings.Contains(err.Error(), want[i]) {
+			t.Errorf("error %d = %v, want it to contain %q", i, err, want[i])
+		}
+	}
+
+	if _, err := generator.GenerateGenesisBlock(); err == nil || !strings.Contains(err.Error(), "4 genesis config errors") {
+		t.Errorf("GenerateGenesisBlock error = %v, want all 4 errors reported", err)
+	}
+}
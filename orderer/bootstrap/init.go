@@ -0,0 +1,40 @@
+package bootstrap
+
+import (
+	"github.com/ddr4869/minifab/common/configtx"
+	"github.com/ddr4869/minifab/common/logger"
+	"github.com/spf13/cobra"
+)
+
+func initCmd() *cobra.Command {
+	var outputPath string
+	var opts configtx.SampleConfigTxOptions
+	defaults := configtx.DefaultSampleConfigTxOptions()
+
+	cmd := &cobra.Command{
+		Use:   "init",
+		Short: "Write a starter configtx.yaml with one orderer org and one peer org",
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := configtx.WriteSampleConfigTx(outputPath, opts); err != nil {
+				logger.Fatalf("Failed to write sample configtx: %v", err)
+			}
+			logger.Infof("✅ Sample configtx.yaml written to %s (profiles: SystemChannel, AppChannel)", outputPath)
+		},
+	}
+
+	// 조직 이름과 MSP ID는 비워 두면 WriteSampleConfigTx에서 기본값 또는 <이름>MSP로 채워진다.
+	cmd.Flags().StringVar(&outputPath, "config-output", "", "Path to write configtx.yaml (required)")
+	cmd.Flags().StringVar(&opts.OrdererOrgName, "orderer-org", "", "Orderer organization name (default \""+defaults.OrdererOrgName+"\")")
+	cmd.Flags().StringVar(&opts.OrdererMSPID, "orderer-mspid", "", "Orderer organization MSP ID (default \""+defaults.OrdererMSPID+"\", or <orderer-org>MSP)")
+	cmd.Flags().StringVar(&opts.OrdererMSPDir, "orderer-mspdir", defaults.OrdererMSPDir, "Orderer organization MSP directory")
+	cmd.Flags().StringVar(&opts.OrdererEndpoint, "orderer-endpoint", defaults.OrdererEndpoint, "Orderer endpoint (host:port)")
+	cmd.Flags().StringVar(&opts.PeerOrgName, "peer-org", "", "Peer organization name (default \""+defaults.PeerOrgName+"\")")
+	cmd.Flags().StringVar(&opts.PeerMSPID, "peer-mspid", "", "Peer organization MSP ID (default <peer-org>MSP)")
+	cmd.Flags().StringVar(&opts.PeerMSPDir, "peer-mspdir", defaults.PeerMSPDir, "Peer organization MSP directory")
+	cmd.Flags().StringVar(&opts.AnchorPeer.Host, "anchor-peer-host", defaults.AnchorPeer.Host, "Anchor peer host of the peer organization")
+	cmd.Flags().IntVar(&opts.AnchorPeer.Port, "anchor-peer-port", defaults.AnchorPeer.Port, "Anchor peer port of the peer organization")
+	cmd.Flags().BoolVar(&opts.Overwrite, "force", false, "Overwrite the output file if it exists")
+	cmd.MarkFlagRequired("config-output")
+
+	return cmd
+}
@@ -0,0 +1,54 @@
+package bootstrap
+
+import (
+	"time"
+
+	"github.com/ddr4869/minifab/common/configtx"
+)
+
+// GenesisOption configtx.yaml에서 읽은 제네시스 설정의 특정 값을 덮어쓰는 옵션
+type GenesisOption func(*configtx.SystemChannelInfo)
+
+// WithTimestamp 제네시스 설정의 생성 시각 지정 (테스트에서 결정적인 해시를 얻기 위해 사용)
+func WithTimestamp(t time.Time) GenesisOption {
+	return func(info *configtx.SystemChannelInfo) {
+		timestamp := t.UTC()
+		info.Timestamp = &timestamp
+	}
+}
+
+// WithBatchTimeout orderer BatchTimeout 덮어쓰기
+func WithBatchTimeout(d time.Duration) GenesisOption {
+	return func(info *configtx.SystemChannelInfo) {
+		info.Orderer.BatchTimeout = d.String()
+	}
+}
+
+// WithExtraCapability capability 추가 (이미 있으면 무시)
+func WithExtraCapability(name string) GenesisOption {
+	return func(info *configtx.SystemChannelInfo) {
+		for _, capability := range info.Capabilities {
+			if capability == name {
+				return
+			}
+		}
+		info.Capabilities = append(info.Capabilities, name)
+	}
+}
+
+// WithOrdererEndpoints orderer 조직의 endpoint 목록을 endpoints로 교체 (중복은 한 번만 기록)
+// 제네시스 블록에 모든 orderer endpoint가 기록되어 peer가 그중 어느 orderer에도 연결할 수 있다.
+func WithOrdererEndpoints(endpoints []string) GenesisOption {
+	return func(info *configtx.SystemChannelInfo) {
+		seen := make(map[string]bool, len(endpoints))
+		unique := make([]string, 0, len(endpoints))
+		for _, endpoint := range endpoints {
+			if seen[endpoint] {
+				continue
+			}
+			seen[endpoint] = true
+			unique = append(unique, endpoint)
+		}
+		info.Orderer.Organization.OrdererEndpoints = unique
+	}
+}
@@ -0,0 +1,90 @@
+package bootstrap
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ddr4869/minifab/common/configtx"
+	"github.com/ddr4869/minifab/common/msp/msptest"
+)
+
+// writeTestConfigTx 실제 MSP 디렉터리를 가진 샘플 configtx.yaml 작성
+func writeTestConfigTx(t *testing.T) string {
+	t.Helper()
+
+	dir := t.TempDir()
+	opts := configtx.DefaultSampleConfigTxOptions()
+	opts.OrdererMSPDir = msptest.NewOrg(t, "OrdererMSP").WriteMSPDir(t, filepath.Join(dir, "orderer"))
+	opts.PeerMSPDir = msptest.NewOrg(t, "Org1MSP").WriteMSPDir(t, filepath.Join(dir, "org1"))
+	path := filepath.Join(dir, "configtx.yaml")
+	if err := configtx.WriteSampleConfigTx(path, opts); err != nil {
+		t.Fatalf("WriteSampleConfigTx: %v", err)
+	}
+	return path
+}
+
+func TestCreateGenesisConfigFromConfigTxWithoutOptions(t *testing.T) {
+	path := writeTestConfigTx(t)
+
+	genesisConfig, err := CreateGenesisConfigFromConfigTx(path, "SystemChannel")
+	if err != nil {
+		t.Fatalf("CreateGenesisConfigFromConfigTx: %v", err)
+	}
+	if genesisConfig.Timestamp != nil {
+		t.Errorf("Timestamp = %v, want nil without WithTimestamp", genesisConfig.Timestamp)
+	}
+	if genesisConfig.Orderer.BatchTimeout != "2s" {
+		t.Errorf("BatchTimeout = %q, want 2s from configtx.yaml", genesisConfig.Orderer.BatchTimeout)
+	}
+	if len(genesisConfig.Capabilities) != 0 {
+		t.Errorf("Capabilities = %v, want none", genesisConfig.Capabilities)
+	}
+}
+
+func TestGenesisOptions(t *testing.T) {
+	path := writeTestConfigTx(t)
+	timestamp := time.Date(2024, 1, 2, 12, 0, 0, 0, time.FixedZone("KST", 9*60*60))
+
+	tests := []struct {
+		name  string
+		opts  []GenesisOption
+		check func(t *testing.T, info *configtx.SystemChannelInfo)
+	}{
+		{"WithTimestamp", []GenesisOption{WithTimestamp(timestamp)}, func(t *testing.T, info *configtx.SystemChannelInfo) {
+			if info.Timestamp == nil || !info.Timestamp.Equal(timestamp) || info.Timestamp.Location() != time.UTC {
+				t.Errorf("Timestamp = %v, want %v in UTC", info.Timestamp, timestamp)
+			}
+		}},
+		{"WithBatchTimeout", []GenesisOption{WithBatchTimeout(500 * time.Millisecond)}, func(t *testing.T, info *configtx.SystemChannelInfo) {
+			if info.Orderer.BatchTimeout != "500ms" {
+				t.Errorf("BatchTimeout = %q, want 500ms", info.Orderer.BatchTimeout)
+			}
+		}},
+		{"WithExtraCapability", []GenesisOption{WithExtraCapability("V2_0"), WithExtraCapability("V2_5"), WithExtraCapability("V2_0")}, func(t *testing.T, info *configtx.SystemChannelInfo) {
+			if len(info.Capabilities) != 2 || info.Capabilities[0] != "V2_0" || info.Capabilities[1] != "V2_5" {
+				t.Errorf("Capabilities = %v, want [V2_0 V2_5]", info.Capabilities)
+			}
+		}},
+		{"WithOrdererEndpoints", []GenesisOption{WithOrdererEndpoints([]string{"127.0.0.1:7050", "127.0.0.1:8050", "127.0.0.1:7050"})}, func(t *testing.T, info *configtx.SystemChannelInfo) {
+			endpoints := info.Orderer.Organization.OrdererEndpoints
+			if len(endpoints) != 2 || endpoints[0] != "127.0.0.1:7050" || endpoints[1] != "127.0.0.1:8050" {
+				t.Errorf("OrdererEndpoints = %v, want [127.0.0.1:7050 127.0.0.1:8050]", endpoints)
+			}
+		}},
+		{"later option wins", []GenesisOption{WithBatchTimeout(time.Second), WithBatchTimeout(3 * time.Second)}, func(t *testing.T, info *configtx.SystemChannelInfo) {
+			if info.Orderer.BatchTimeout != "3s" {
+				t.Errorf("BatchTimeout = %q, want 3s", info.Orderer.BatchTimeout)
+			}
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			info, err := CreateGenesisConfigFromConfigTxWithOptions(path, "SystemChannel", tt.opts...)
+			if err != nil {
+				t.Fatalf("CreateGenesisConfigFromConfigTxWithOptions: %v", err)
+			}
+			tt.check(t, info)
+		})
+	}
+}
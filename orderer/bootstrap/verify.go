@@ -0,0 +1,126 @@
+package bootstrap
+
+import (
+	"bytes"
+	"crypto/x509"
+	"fmt"
+	"os"
+
+	"github.com/ddr4869/minifab/common/blockutil"
+	"github.com/ddr4869/minifab/common/configtx"
+	pb_common "github.com/ddr4869/minifab/proto/common"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+// VerifyCheck 제네시스 블록 검증 항목 하나의 결과
+type VerifyCheck struct {
+	Name string
+	Err  error
+}
+
+// Passed 검증 항목 통과 여부
+func (c VerifyCheck) Passed() bool {
+	return c.Err == nil
+}
+
+// VerifyGenesisBlock 제네시스 블록의 헤더 타입, 데이터 해시, 시스템 채널 설정, consortium CA 인증서를 검증
+// 앞 항목이 실패해도 나머지 항목은 모두 검사한다.
+func VerifyGenesisBlock(block *pb_common.Block) []VerifyCheck {
+	checks := []VerifyCheck{
+		{Name: "header type is BLOCK_TYPE_CONFIG", Err: verifyHeaderType(block)},
+		{Name: "data hash matches transactions", Err: verifyDataHash(block)},
+	}
+
+	systemChannelInfo, err := blockutil.ExtractSystemChannelConfigFromBlock(block)
+	checks = append(checks, VerifyCheck{Name: "config transaction is SystemChannelInfo", Err: err})
+
+	if err != nil {
+		err = errors.New("system channel config is unavailable")
+	} else {
+		err = verifyConsortiumCerts(systemChannelInfo)
+	}
+	checks = append(checks, VerifyCheck{Name: "consortium MSP CA certs parse", Err: err})
+
+	return checks
+}
+
+func verifyHeaderType(block *pb_common.Block) error {
+	if block.Header == nil {
+		return errors.New("block header is empty")
+	}
+	if block.Header.HeaderType != pb_common.BlockType_BLOCK_TYPE_CONFIG {
+		return errors.Errorf("header type is %s", block.Header.HeaderType)
+	}
+	return nil
+}
+
+func verifyDataHash(block *pb_common.Block) error {
+	if block.Header == nil || block.Data == nil {
+		return errors.New("block header or data is empty")
+	}
+	expected := blockutil.CalculateDataHash(block.Data.Transactions)
+	if !bytes.Equal(block.Header.DataHash, expected) {
+		return errors.Errorf("data hash is %x, expected %x", block.Header.DataHash, expected)
+	}
+	return nil
+}
+
+func verifyConsortiumCerts(systemChannelInfo *configtx.SystemChannelInfo) error {
+	if errs := consortiumCertErrors(systemChannelInfo); len(errs) > 0 {
+		return errs[0]
+	}
+	return nil
+}
+
+// consortiumCertErrors consortium 조직마다 MSP CA 인증서를 확인하고 문제가 있는 조직의 에러를 모두 반환
+func consortiumCertErrors(systemChannelInfo *configtx.SystemChannelInfo) []error {
+	if len(systemChannelInfo.Consortiums) == 0 {
+		return []error{errors.New("no consortium organizations found")}
+	}
+	var errs []error
+	for _, org := range systemChannelInfo.Consortiums {
+		if len(org.MSPCaCert) == 0 {
+			errs = append(errs, errors.Errorf("organization %s has no MSP CA cert", org.Name))
+			continue
+		}
+		if _, err := x509.ParseCertificate(org.MSPCaCert); err != nil {
+			errs = append(errs, errors.Wrapf(err, "organization %s has an invalid MSP CA cert", org.Name))
+		}
+	}
+	return errs
+}
+
+func verifyCmd() *cobra.Command {
+	var genesisFile string
+
+	cmd := &cobra.Command{
+		Use:   "verify",
+		Short: "Verify a genesis block before starting the orderer",
+		Run: func(cmd *cobra.Command, args []string) {
+			block, err := blockutil.LoadBlock(genesisFile)
+			if err != nil {
+				fmt.Printf("FAIL load genesis block: %v\n", err)
+				os.Exit(1)
+			}
+
+			failed := false
+			for _, check := range VerifyGenesisBlock(block) {
+				if check.Passed() {
+					fmt.Printf("PASS %s\n", check.Name)
+					continue
+				}
+				failed = true
+				fmt.Printf("FAIL %s: %v\n", check.Name, check.Err)
+			}
+			if failed {
+				os.Exit(1)
+			}
+		},
+	}
+
+	cmd.Flags().StringVar(&genesisFile, "genesis-file", "", "Path to the genesis block file (required)")
+	cmd.MarkFlagRequired("genesis-file")
+
+	return cmd
+}
@@ -0,0 +1,77 @@
+package bootstrap
+
+import (
+	"testing"
+
+	"github.com/ddr4869/minifab/common/msp/msptest"
+	pb_common "github.com/ddr4869/minifab/proto/common"
+)
+
+// newTestGenesisBlock 샘플 configtx.yaml의 SystemChannel profile로 만든 서명된 제네시스 블록
+func newTestGenesisBlock(t *testing.T) *pb_common.Block {
+	t.Helper()
+
+	genesisConfig, err := CreateGenesisConfigFromConfigTx(writeTestConfigTx(t), "SystemChannel")
+	if err != nil {
+		t.Fatalf("CreateGenesisConfigFromConfigTx: %v", err)
+	}
+	signer := msptest.NewOrg(t, "OrdererMSP").SigningIdentity()
+	block, err := NewGenesisBlockGenerator(genesisConfig, signer).SignWith(signer).GenerateGenesisBlock()
+	if err != nil {
+		t.Fatalf("GenerateGenesisBlock: %v", err)
+	}
+	return block
+}
+
+// failedChecks 실패한 검증 항목 이름 목록
+func failedChecks(checks []VerifyCheck) []string {
+	var failed []string
+	for _, check := range checks {
+		if !check.Passed() {
+			failed = append(failed, check.Name)
+		}
+	}
+	return failed
+}
+
+func TestVerifyGenesisBlock(t *testing.T) {
+	block := newTestGenesisBlock(t)
+
+	checks := VerifyGenesisBlock(block)
+	if len(checks) != 4 {
+		t.Fatalf("got %d checks, want 4", len(checks))
+	}
+	if failed := failedChecks(checks); len(failed) != 0 {
+		t.Fatalf("fresh genesis block failed %v", failed)
+	}
+
+	// 데이터 해시만 변조하면 해당 항목 하나만 실패한다
+	block.Header.DataHash = append([]byte(nil), block.Header.DataHash...)
+	block.Header.DataHash[0] ^= 0xff
+	failed := failedChecks(VerifyGenesisBlock(block))
+	if len(failed) != 1 || failed[0] != "data hash matches transactions" {
+		t.Errorf("tampered genesis block failed %v, want only the data hash check", failed)
+	}
+}
+
+func TestVerifyGenesisBlockReportsEveryFailure(t *testing.T) {
+	block := newTestGenesisBlock(t)
+	block.Header.HeaderType = pb_common.BlockType_BLOCK_TYPE_DATA
+	block.Data.Transactions = [][]byte{[]byte("not a transaction")}
+
+	failed := failedChecks(VerifyGenesisBlock(block))
+	want := []string{
+		"header type is BLOCK_TYPE_CONFIG",
+		"data hash matches transactions",
+		"config transaction is SystemChannelInfo",
+		"consortium MSP CA certs parse",
+	}
+	if len(failed) != len(want) {
+		t.Fatalf("failed checks = %v, want %v", failed, want)
+	}
+	for i := range want {
+		if failed[i] != want[i] {
+			t.Errorf("failed check %d = %q, want %q", i, failed[i], want[i])
+		}
+	}
+}
@@ -0,0 +1,97 @@
+package channel
+
+import (
+	"encoding/json"
+
+	"github.com/ddr4869/minifab/common/configtx"
+	"github.com/ddr4869/minifab/common/logger"
+	"github.com/pkg/errors"
+)
+
+// ChannelBatchSize 채널에 적용되는 BatchSize 설정 (변경하지 않은 채널은 채널 생성 시점의 시스템 채널 설정 값)
+func (cs *ChainSupport) ChannelBatchSize(channelID string) (configtx.BatchSize, error) {
+	channelConfig, exists := cs.Channels.Get(channelID)
+	if !exists {
+		return configtx.BatchSize{}, errors.Errorf("channel not found: %s", channelID)
+	}
+	if channelConfig.SCC == nil {
+		return configtx.BatchSize{}, errors.Errorf("channel %s has no orderer config", channelID)
+	}
+	return channelConfig.SCC.Orderer.BatchSize, nil
+}
+
+// UpdateChannelBatchSize 채널의 BatchSize를 변경하고 변경된 채널 설정을 설정 블록으로 기록
+// 설정은 채널 설정의 orderer 부분(SCC)에 저장되므로 재시작 시 마지막 설정 블록에서 복원되며,
+// BlockCutter는 다음 블록부터 새 MaxMessageCount/PreferredMaxBytes로 블록을 자른다.
+func (cs *ChainSupport) UpdateChannelBatchSize(channelID string, batchSize *configtx.BatchSize) error {
+	if err := validateBatchSize(batchSize); err != nil {
+		return errors.Wrapf(err, "invalid batch size for channel %s", channelID)
+	}
+	if cs.Cutter == nil {
+		return errors.New("block cutter is not running")
+	}
+
+	cs.Mutex.Lock()
+	defer cs.Mutex.Unlock()
+
+	channelConfig, exists := cs.Channels.Get(channelID)
+	if !exists {
+		return errors.Errorf("channel not found: %s", channelID)
+	}
+	if channelConfig.SCC == nil {
+		return errors.Errorf("channel %s has no orderer config", channelID)
+	}
+	if channelConfig.SCC.Orderer.BatchSize == *batchSize {
+		logger.Infof("[Orderer] Batch size update for channel %s has no changes", channelID)
+		return nil
+	}
+
+	// 시스템 채널 설정은 다른 채널과 공유하므로 복사본의 BatchSize만 바꾼다
+	scc := *channelConfig.SCC
+	scc.Orderer.BatchSize = *batchSize
+	updated := &configtx.ChannelConfig{
+		CC:  channelConfig.CC,
+		SCC: &scc,
+	}
+	configData, err := json.Marshal(updated)
+	if err != nil {
+		return errors.Wrapf(err, "failed to marshal config of channel %s", channelID)
+	}
+	if _, err := cs.Cutter.WriteConfigBlock(channelID, configData); err != nil {
+		return errors.Wrapf(err, "failed to write config block of channel %s", channelID)
+	}
+
+	cs.Channels.Set(channelID, updated)
+	old := channelConfig.SCC.Orderer.BatchSize
+	logger.Infof("[Orderer] Channel %s batch size: MaxMessageCount %d -> %d, AbsoluteMaxBytes %q -> %q, PreferredMaxBytes %q -> %q",
+		channelID, old.MaxMessageCount, batchSize.MaxMessageCount,
+		old.AbsoluteMaxBytes, batchSize.AbsoluteMaxBytes, old.PreferredMaxBytes, batchSize.PreferredMaxBytes)
+	return nil
+}
+
+// validateBatchSize MaxMessageCount가 양수이고 바이트 크기가 올바르며 PreferredMaxBytes가 AbsoluteMaxBytes 이하인지 확인
+func validateBatchSize(batchSize *configtx.BatchSize) error {
+	if batchSize == nil {
+		return errors.New("batch size cannot be nil")
+	}
+	if batchSize.MaxMessageCount <= 0 {
+		return errors.Errorf("MaxMessageCount must be positive: %d", batchSize.MaxMessageCount)
+	}
+
+	var absoluteMaxBytes, preferredMaxBytes uint32
+	var err error
+	if batchSize.AbsoluteMaxBytes != "" {
+		if absoluteMaxBytes, err = configtx.ParseBatchSizeBytes(batchSize.AbsoluteMaxBytes); err != nil {
+			return errors.Wrap(err, "invalid AbsoluteMaxBytes")
+		}
+	}
+	if batchSize.PreferredMaxBytes != "" {
+		if preferredMaxBytes, err = configtx.ParseBatchSizeBytes(batchSize.PreferredMaxBytes); err != nil {
+			return errors.Wrap(err, "invalid PreferredMaxBytes")
+		}
+	}
+	if absoluteMaxBytes > 0 && preferredMaxBytes > absoluteMaxBytes {
+		return errors.Errorf("PreferredMaxBytes %s exceeds AbsoluteMaxBytes %s", batchSize.PreferredMaxBytes, batchSize.AbsoluteMaxBytes)
+	}
+	return nil
+}
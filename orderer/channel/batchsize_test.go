@@ -0,0 +1,80 @@
+package channel
+
+import (
+	"testing"
+
+	"github.com/ddr4869/minifab/common/configtx"
+	pb_common "github.com/ddr4869/minifab/proto/common"
+)
+
+func TestUpdateChannelBatchSize(t *testing.T) {
+	n := newTestNetwork(t)
+	n.cs.SystemChannelInfo.Orderer.BatchSize.MaxMessageCount = 100
+	n.createChannel(t, "mychannel")
+	n.createChannel(t, "otherchannel")
+
+	batchSize := configtx.BatchSize{MaxMessageCount: 10, AbsoluteMaxBytes: "10 MB", PreferredMaxBytes: "2 MB"}
+	if err := n.cs.UpdateChannelBatchSize("mychannel", &batchSize); err != nil {
+		t.Fatalf("UpdateChannelBatchSize: %v", err)
+	}
+	if got, err := n.cs.ChannelBatchSize("mychannel"); err != nil || got != batchSize {
+		t.Fatalf("ChannelBatchSize = %+v, %v, want %+v", got, err, batchSize)
+	}
+	if config := n.loadBlock(t, "mychannel", 1); config.Header.HeaderType != pb_common.BlockType_BLOCK_TYPE_CONFIG {
+		t.Fatalf("block 1 is %s, want the config update block", config.Header.HeaderType)
+	}
+
+	n.enqueue(t, n.peerOrg.SigningIdentity(), "mychannel", 15)
+	// CutBlock은 한 번에 MaxMessageCount개까지만 꺼내므로 두 번 자른다
+	for i := 0; i < 2; i++ {
+		if err := n.cs.Cutter.CutBlock("mychannel"); err != nil {
+			t.Fatalf("CutBlock: %v", err)
+		}
+	}
+	for number, want := range map[uint64]int{2: 10, 3: 5} {
+		if got := len(n.loadBlock(t, "mychannel", number).Data.Transactions); got != want {
+			t.Errorf("block %d has %d transactions, want %d", number, got, want)
+		}
+	}
+	if height := n.cs.channelHeight("mychannel"); height != 4 {
+		t.Errorf("height = %d, want 4", height)
+	}
+
+	// 다른 채널은 시스템 채널의 값을 그대로 사용한다
+	if got, err := n.cs.ChannelBatchSize("otherchannel"); err != nil || got.MaxMessageCount != 100 {
+		t.Errorf("otherchannel MaxMessageCount = %d, %v, want 100", got.MaxMessageCount, err)
+	}
+
+	// 재시작하면 마지막 설정 블록에서 변경된 값을 복원한다
+	restarted := n.restart(t)
+	if got, err := restarted.cs.ChannelBatchSize("mychannel"); err != nil || got != batchSize {
+		t.Errorf("ChannelBatchSize after restart = %+v, %v, want %+v", got, err, batchSize)
+	}
+}
+
+func TestUpdateChannelBatchSizeRejectsInvalidConfig(t *testing.T) {
+	n := newTestNetwork(t)
+	n.createChannel(t, "mychannel")
+
+	tests := []struct {
+		name      string
+		channelID string
+		batchSize *configtx.BatchSize
+	}{
+		{"nil batch size", "mychannel", nil},
+		{"zero MaxMessageCount", "mychannel", &configtx.BatchSize{MaxMessageCount: 0}},
+		{"invalid AbsoluteMaxBytes", "mychannel", &configtx.BatchSize{MaxMessageCount: 10, AbsoluteMaxBytes: "lots"}},
+		{"PreferredMaxBytes over AbsoluteMaxBytes", "mychannel", &configtx.BatchSize{MaxMessageCount: 10, AbsoluteMaxBytes: "1 MB", PreferredMaxBytes: "2 MB"}},
+		{"unknown channel", "otherchannel", &configtx.BatchSize{MaxMessageCount: 10}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := n.cs.UpdateChannelBatchSize(tt.channelID, tt.batchSize); err == nil {
+				t.Fatal("UpdateChannelBatchSize succeeded, want error")
+			}
+		})
+	}
+	if height := n.cs.channelHeight("mychannel"); height != 1 {
+		t.Errorf("height after rejected updates = %d, want 1", height)
+	}
+}
@@ -0,0 +1,108 @@
+package channel
+
+import (
+	"fmt"
+	"io"
+	"slices"
+	"sort"
+	"sync"
+)
+
+// DefaultBlockAckRetention 채널별로 ack를 보관하는 최근 블록 수
+const DefaultBlockAckRetention = 1000
+
+// BlockAckStore는 peer들이 보낸 블록 수신 확인(ack)을 채널/블록 번호별로 보관한다.
+// 채널마다 블록 번호가 큰 retention개 블록의 ack만 보관하고 오래된 블록의 ack는 버린다.
+type BlockAckStore struct {
+	mutex     sync.RWMutex
+	acks      map[string]map[uint64][]string
+	retention int
+}
+
+func NewBlockAckStore() *BlockAckStore {
+	return NewBlockAckStoreWithRetention(DefaultBlockAckRetention)
+}
+
+// NewBlockAckStoreWithRetention 채널별로 최근 retention개 블록의 ack만 보관하는 BlockAckStore 생성
+// retention이 0 이하이면 DefaultBlockAckRetention을 사용한다.
+func NewBlockAckStoreWithRetention(retention int) *BlockAckStore {
+	if retention <= 0 {
+		retention = DefaultBlockAckRetention
+	}
+	return &BlockAckStore{
+		acks:      make(map[string]map[uint64][]string),
+		retention: retention,
+	}
+}
+
+// Add 블록의 ack 목록에 peer ID 추가
+// 이미 있거나 보관 범위보다 오래된 블록이면 기록하지 않고 false를 반환한다.
+func (s *BlockAckStore) Add(channelID string, blockNumber uint64, peerID string) bool {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	channelAcks, exists := s.acks[channelID]
+	if !exists {
+		channelAcks = make(map[uint64][]string)
+		s.acks[channelID] = channelAcks
+	}
+	if slices.Contains(channelAcks[blockNumber], peerID) {
+		return false
+	}
+	channelAcks[blockNumber] = append(channelAcks[blockNumber], peerID)
+	s.prune(channelAcks)
+	_, retained := channelAcks[blockNumber]
+	return retained
+}
+
+// prune 보관 중인 블록이 retention개를 넘으면 블록 번호가 작은 블록의 ack부터 삭제
+// mutex를 잡은 상태에서 호출되어야 함
+func (s *BlockAckStore) prune(channelAcks map[uint64][]string) {
+	for len(channelAcks) > s.retention {
+		oldest := uint64(0)
+		first := true
+		for blockNumber := range channelAcks {
+			if first || blockNumber < oldest {
+				oldest, first = blockNumber, false
+			}
+		}
+		delete(channelAcks, oldest)
+	}
+}
+
+// Get 블록을 확인한 peer ID 목록
+func (s *BlockAckStore) Get(channelID string, blockNumber uint64) []string {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	return append([]string(nil), s.acks[channelID][blockNumber]...)
+}
+
+// Count 채널에 보관 중인 전체 ack 개수
+func (s *BlockAckStore) Count(channelID string) int {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	count := 0
+	for _, peerIDs := range s.acks[channelID] {
+		count += len(peerIDs)
+	}
+	return count
+}
+
+// WriteMetrics minifab_orderer_block_ack_count gauge를 Prometheus text 형식으로 기록 (보관 중인 ack 기준)
+func (s *BlockAckStore) WriteMetrics(w io.Writer) {
+	s.mutex.RLock()
+	channels := make([]string, 0, len(s.acks))
+	for channelID := range s.acks {
+		channels = append(channels, channelID)
+	}
+	s.mutex.RUnlock()
+	sort.Strings(channels)
+
+	fmt.Fprintln(w, "# HELP minifab_orderer_block_ack_count Number of block receipt acknowledgements retained for the most recent blocks.")
+	fmt.Fprintln(w, "# TYPE minifab_orderer_block_ack_count gauge")
+	for _, channelID := range channels {
+		fmt.Fprintf(w, "minifab_orderer_block_ack_count{channel=%q} %d\n", channelID, s.Count(channelID))
+	}
+}
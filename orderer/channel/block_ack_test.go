@@ -0,0 +1,135 @@
+package channel
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/ddr4869/minifab/common/blockutil"
+	"github.com/ddr4869/minifab/common/msp"
+	pb_common "github.com/ddr4869/minifab/proto/common"
+	pb_orderer "github.com/ddr4869/minifab/proto/orderer"
+)
+
+// newBlockReceipt signer가 서명한 블록 수신 알림
+func newBlockReceipt(t *testing.T, signer msp.SigningIdentity, channelID string, blockNumber uint64, peerID string) *pb_orderer.BlockReceiptNotification {
+	t.Helper()
+
+	digest := sha256.Sum256(blockutil.BlockReceiptMessage(channelID, blockNumber, peerID))
+	signature, err := signer.Sign(rand.Reader, digest[:], nil)
+	if err != nil {
+		t.Fatalf("Sign: %v", err)
+	}
+	return &pb_orderer.BlockReceiptNotification{
+		ChannelId:   channelID,
+		BlockNumber: blockNumber,
+		PeerId:      peerID,
+		Signature:   signature,
+		Identity: &pb_common.Identity{
+			Creator: signer.GetCertificate().Raw,
+			MspId:   signer.GetIdentifier().Mspid,
+		},
+	}
+}
+
+func TestNotifyBlockReceivedStoresAck(t *testing.T) {
+	n := newTestNetwork(t)
+	n.createChannel(t, "mychannel")
+	signer := n.peerOrg.SigningIdentity()
+
+	for _, peerID := range []string{"peer0", "peer1", "peer0"} {
+		ack, err := n.cs.NotifyBlockReceived(context.Background(), newBlockReceipt(t, signer, "mychannel", 0, peerID))
+		if err != nil {
+			t.Fatalf("NotifyBlockReceived(%s): %v", peerID, err)
+		}
+		if ack.Status != pb_common.Status_OK {
+			t.Fatalf("NotifyBlockReceived(%s) status = %v, want OK", peerID, ack.Status)
+		}
+	}
+
+	// 같은 peer의 중복 ack는 한 번만 기록된다
+	if got := n.cs.GetBlockAcknowledgements("mychannel", 0); fmt.Sprint(got) != "[peer0 peer1]" {
+		t.Errorf("GetBlockAcknowledgements = %v, want [peer0 peer1]", got)
+	}
+	if got := n.cs.GetBlockAcknowledgements("mychannel", 1); len(got) != 0 {
+		t.Errorf("GetBlockAcknowledgements(block 1) = %v, want none", got)
+	}
+
+	var metrics strings.Builder
+	n.cs.BlockAcks.WriteMetrics(&metrics)
+	if want := `minifab_orderer_block_ack_count{channel="mychannel"} 2`; !strings.Contains(metrics.String(), want) {
+		t.Errorf("metrics missing %q:\n%s", want, metrics.String())
+	}
+}
+
+func TestNotifyBlockReceivedRejectsInvalidReceipt(t *testing.T) {
+	n := newTestNetwork(t)
+	n.createChannel(t, "mychannel")
+	signer := n.peerOrg.SigningIdentity()
+
+	tamperedSignature := newBlockReceipt(t, signer, "mychannel", 0, "peer0")
+	tamperedSignature.PeerId = "peer1"
+
+	tests := []struct {
+		name string
+		req  *pb_orderer.BlockReceiptNotification
+		want pb_common.Status
+	}{
+		{"unknown channel", newBlockReceipt(t, signer, "otherchannel", 0, "peer0"), pb_common.Status_CHANNEL_NOT_FOUND},
+		{"missing peer id", newBlockReceipt(t, signer, "mychannel", 0, ""), pb_common.Status_INVALID_ARGUMENT},
+		{"block not yet cut", newBlockReceipt(t, signer, "mychannel", 1, "peer0"), pb_common.Status_NOT_FOUND},
+		{"signature over other peer id", tamperedSignature, pb_common.Status_INVALID_SIGNATURE},
+		{"identity outside consortium", newBlockReceipt(t, n.ordererOrg.SigningIdentity(), "mychannel", 0, "peer0"), pb_common.Status_INVALID_SIGNATURE},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ack, err := n.cs.NotifyBlockReceived(context.Background(), tt.req)
+			if err != nil {
+				t.Fatalf("NotifyBlockReceived: %v", err)
+			}
+			if ack.Status != tt.want {
+				t.Errorf("status = %v, want %v", ack.Status, tt.want)
+			}
+		})
+	}
+
+	if got := n.cs.GetBlockAcknowledgements("mychannel", 0); len(got) != 0 {
+		t.Errorf("GetBlockAcknowledgements = %v, want none after rejected receipts", got)
+	}
+}
+
+func TestBlockAckStorePrunesOldBlocks(t *testing.T) {
+	store := NewBlockAckStoreWithRetention(3)
+	for blockNumber := uint64(0); blockNumber < 5; blockNumber++ {
+		if !store.Add("mychannel", blockNumber, "peer0") {
+			t.Fatalf("Add(block %d) = false, want true", blockNumber)
+		}
+	}
+	store.Add("mychannel", 4, "peer1")
+
+	// 블록 0, 1의 ack는 버려지고 최근 3개 블록의 ack만 남는다
+	for blockNumber, want := range map[uint64]int{0: 0, 1: 0, 2: 1, 3: 1, 4: 2} {
+		if got := store.Get("mychannel", blockNumber); len(got) != want {
+			t.Errorf("Get(block %d) = %v, want %d acks", blockNumber, got, want)
+		}
+	}
+	if count := store.Count("mychannel"); count != 4 {
+		t.Errorf("Count = %d, want 4", count)
+	}
+	var metrics strings.Builder
+	store.WriteMetrics(&metrics)
+	if want := `minifab_orderer_block_ack_count{channel="mychannel"} 4`; !strings.Contains(metrics.String(), want) {
+		t.Errorf("metrics missing %q:\n%s", want, metrics.String())
+	}
+
+	// 보관 범위보다 오래된 블록의 ack는 기록하지 않는다
+	if store.Add("mychannel", 0, "peer1") {
+		t.Error("Add of a block older than the retained range = true, want false")
+	}
+	if count := store.Count("mychannel"); count != 4 {
+		t.Errorf("Count after a late ack = %d, want 4", count)
+	}
+}
@@ -0,0 +1,342 @@
+package channel
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/ddr4869/minifab/common/blockutil"
+	"github.com/ddr4869/minifab/common/configtx"
+	"github.com/ddr4869/minifab/common/logger"
+	pb_common "github.com/ddr4869/minifab/proto/common"
+	"github.com/pkg/errors"
+)
+
+const (
+	DefaultBatchTimeout    = 2 * time.Second
+	DefaultMaxMessageCount = 10
+)
+
+// BlockCutter는 PendingQueue에 쌓인 트랜잭션을 BatchSize/BatchTimeout 기준으로 블록으로 묶어 저장한다.
+// MaxMessageCount만큼 쌓이면 즉시 블록을 자르고, BatchTimeout마다 남은 트랜잭션을 부분 블록으로 자른다.
+type BlockCutter struct {
+	cs              *ChainSupport
+	maxMessageCount int
+	batchTimeout    time.Duration
+	// 블록의 추정 크기 상한 (0이면 크기로 자르지 않음)
+	preferredMaxBytes int
+
+	// 블록 번호와 PreviousHash가 꼬이지 않도록 블록 쓰기를 직렬화
+	writeMutex sync.Mutex
+	notifyCh   chan struct{}
+	stopCh     chan struct{}
+	doneCh     chan struct{}
+	stopOnce   sync.Once
+	running    atomic.Bool
+	draining   atomic.Bool
+	// Accept의 enqueue와 Drain의 draining 설정을 직렬화
+	admitMutex sync.RWMutex
+}
+
+// NewBlockCutter 시스템 채널 설정의 BatchTimeout/BatchSize로 BlockCutter 생성
+// 설정 값이 없거나 잘못된 경우 기본값을 사용한다.
+func NewBlockCutter(cs *ChainSupport) *BlockCutter {
+	bc := &BlockCutter{
+		cs:              cs,
+		maxMessageCount: DefaultMaxMessageCount,
+		batchTimeout:    DefaultBatchTimeout,
+		notifyCh:        make(chan struct{}, 1),
+		stopCh:          make(chan struct{}),
+		doneCh:          make(chan struct{}),
+	}
+
+	if scc := cs.SystemChannelInfo; scc != nil {
+		bc.maxMessageCount, bc.preferredMaxBytes = batchLimits(scc.Orderer.BatchSize, bc.maxMessageCount, bc.preferredMaxBytes)
+		if preferred := scc.Orderer.BatchSize.PreferredMaxBytes; preferred != "" {
+			if _, err := configtx.ParseBatchSizeBytes(preferred); err != nil {
+				logger.Warnf("Invalid PreferredMaxBytes %q, not limiting block size: %v", preferred, err)
+			}
+		}
+		if scc.Orderer.BatchTimeout != "" {
+			if timeout, err := configtx.ParseBatchTimeout(scc.Orderer.BatchTimeout); err == nil {
+				bc.batchTimeout = timeout
+			} else {
+				logger.Warnf("%v, using default %s", err, DefaultBatchTimeout)
+			}
+		}
+	}
+	return bc
+}
+
+// batchLimits BatchSize 설정의 MaxMessageCount/PreferredMaxBytes (값이 없거나 잘못되면 전달된 기본값)
+func batchLimits(batchSize configtx.BatchSize, defaultMaxMessageCount, defaultPreferredMaxBytes int) (int, int) {
+	maxMessageCount, preferredMaxBytes := defaultMaxMessageCount, defaultPreferredMaxBytes
+	if batchSize.MaxMessageCount > 0 {
+		maxMessageCount = batchSize.MaxMessageCount
+	}
+	if preferred := batchSize.PreferredMaxBytes; preferred != "" {
+		if maxBytes, err := configtx.ParseBatchSizeBytes(preferred); err == nil {
+			preferredMaxBytes = int(maxBytes)
+		}
+	}
+	return maxMessageCount, preferredMaxBytes
+}
+
+// channelBatchLimits 채널 설정의 BatchSize로 정한 채널별 MaxMessageCount/PreferredMaxBytes
+// 채널 설정에 orderer 설정이 없으면 시스템 채널 설정 값을 사용한다.
+func (bc *BlockCutter) channelBatchLimits(channelID string) (int, int) {
+	channelConfig, exists := bc.cs.Channels.Get(channelID)
+	if !exists || channelConfig.SCC == nil {
+		return bc.maxMessageCount, bc.preferredMaxBytes
+	}
+	return batchLimits(channelConfig.SCC.Orderer.BatchSize, bc.maxMessageCount, bc.preferredMaxBytes)
+}
+
+// Start Stop 또는 Drain이 호출될 때까지 블록을 자르는 루프를 백그라운드에서 시작
+// running을 goroutine 시작 전에 설정하므로 Start 직후의 Stop도 루프가 끝날 때까지 기다린다.
+func (bc *BlockCutter) Start() {
+	if bc.running.Swap(true) {
+		return
+	}
+	go bc.run()
+}
+
+func (bc *BlockCutter) run() {
+	defer close(bc.doneCh)
+
+	ticker := time.NewTicker(bc.batchTimeout)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-bc.stopCh:
+			return
+		case <-bc.notifyCh:
+			bc.cutAll(false)
+		case <-ticker.C:
+			bc.cutAll(true)
+		}
+	}
+}
+
+// Notify 새 트랜잭션이 큐에 추가되었음을 알림 (가득 찬 배치를 즉시 자르기 위함)
+func (bc *BlockCutter) Notify() {
+	select {
+	case bc.notifyCh <- struct{}{}:
+	default:
+	}
+}
+
+// IsDraining Drain이 시작되어 새 트랜잭션을 받지 않아야 하는지 여부
+func (bc *BlockCutter) IsDraining() bool {
+	return bc.draining.Load()
+}
+
+// Accept Drain이 시작되지 않았으면 enqueue를 실행하고 true 반환
+// Drain은 같은 lock을 잡고 draining을 설정하므로, true를 받은 트랜잭션은 Drain의 마지막 cut에 포함된다.
+func (bc *BlockCutter) Accept(enqueue func()) bool {
+	bc.admitMutex.RLock()
+	defer bc.admitMutex.RUnlock()
+
+	if bc.draining.Load() {
+		return false
+	}
+	enqueue()
+	return true
+}
+
+// Stop 남은 트랜잭션을 처리하지 않고 루프 종료
+func (bc *BlockCutter) Stop() {
+	bc.stopOnce.Do(func() { close(bc.stopCh) })
+	if bc.running.Load() {
+		<-bc.doneCh
+	}
+}
+
+// Drain 루프를 멈추고 대기 중인 트랜잭션을 모두 부분 블록으로 잘라 저장
+// timeout 안에 블록 쓰기가 끝나지 않으면 에러를 반환한다.
+func (bc *BlockCutter) Drain(timeout time.Duration) error {
+	// 진행 중인 Accept가 끝나기를 기다린 뒤 설정하므로 이후에는 큐에 트랜잭션이 추가되지 않는다
+	bc.admitMutex.Lock()
+	bc.draining.Store(true)
+	bc.admitMutex.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		bc.Stop()
+		bc.cutAll(true)
+	}()
+
+	select {
+	case <-done:
+		logger.Info("✅ Block cutter drained")
+		return nil
+	case <-time.After(timeout):
+		return errors.Errorf("block cutter drain timed out after %s", timeout)
+	}
+}
+
+// cutAll 모든 채널에서 가득 찬 배치를 자르고, partial이 true이면 남은 트랜잭션도 부분 블록으로 자른다
+func (bc *BlockCutter) cutAll(partial bool) {
+	channels := bc.cs.ListChannels()
+
+	for _, channelID := range channels {
+		maxMessageCount, _ := bc.channelBatchLimits(channelID)
+		for bc.cs.PendingQueue.Len(channelID) >= maxMessageCount {
+			if err := bc.CutBlock(channelID); err != nil {
+				logger.Errorf("[Orderer] Failed to cut block for channel %s: %v", channelID, err)
+				break
+			}
+		}
+		if partial && bc.cs.PendingQueue.Len(channelID) > 0 {
+			if err := bc.CutBlock(channelID); err != nil {
+				logger.Errorf("[Orderer] Failed to cut block for channel %s: %v", channelID, err)
+			}
+		}
+	}
+}
+
+// CutBlock 채널 큐에서 최대 MaxMessageCount개의 트랜잭션을 꺼내 블록으로 저장
+// 다음 트랜잭션을 더하면 추정 크기가 PreferredMaxBytes를 넘는 경우 새 블록을 시작한다.
+// MaxMessageCount와 PreferredMaxBytes는 채널 설정의 BatchSize를 따른다.
+// 블록 저장에 실패하면 아직 저장되지 않은 트랜잭션을 큐의 앞쪽으로 되돌린다.
+func (bc *BlockCutter) CutBlock(channelID string) error {
+	bc.writeMutex.Lock()
+	defer bc.writeMutex.Unlock()
+
+	maxMessageCount, preferredMaxBytes := bc.channelBatchLimits(channelID)
+	envelopes := bc.cs.PendingQueue.Dequeue(channelID, maxMessageCount)
+	if len(envelopes) == 0 {
+		return nil
+	}
+
+	var pending []pendingTransaction
+	for _, envelope := range envelopes {
+		payload, err := blockutil.UnmarshalPayloadFromProto(envelope.Payload)
+		if err != nil {
+			logger.Warnf("[Orderer] Dropping transaction with invalid payload: %v", err)
+			continue
+		}
+		tx, err := blockutil.UnmarshalTransactionFromProto(payload.Data)
+		if err != nil {
+			logger.Warnf("[Orderer] Dropping invalid transaction: %v", err)
+			continue
+		}
+		pending = append(pending, pendingTransaction{envelope: envelope, sender: payload.GetHeader().GetIdentity().GetCreator(), tx: tx})
+	}
+
+	for len(pending) > 0 {
+		transactions := make([]*pb_common.Transaction, 0, len(pending))
+		for _, p := range pending {
+			transactions = append(transactions, p.tx)
+		}
+		count, err := nextBatchSize(transactions, preferredMaxBytes)
+		if err == nil {
+			err = bc.writeBlock(channelID, transactions[:count])
+		}
+		if err != nil {
+			bc.requeue(channelID, pending)
+			return err
+		}
+		pending = pending[count:]
+	}
+	return nil
+}
+
+// pendingTransaction 큐에서 꺼냈지만 아직 블록으로 저장되지 않은 트랜잭션
+type pendingTransaction struct {
+	envelope *pb_common.Envelope
+	sender   []byte
+	tx       *pb_common.Transaction
+}
+
+// requeue 저장하지 못한 트랜잭션을 원래 순서대로 송신자 큐의 앞쪽에 되돌림
+func (bc *BlockCutter) requeue(channelID string, pending []pendingTransaction) {
+	for i := len(pending) - 1; i >= 0; i-- {
+		bc.cs.PendingQueue.Requeue(channelID, pending[i].sender, pending[i].envelope)
+	}
+	logger.Warnf("[Orderer] Requeued %d unwritten transactions for channel %s", len(pending), channelID)
+}
+
+// nextBatchSize 추정 크기가 PreferredMaxBytes를 넘지 않는 앞쪽 트랜잭션 개수 (최소 1개)
+func nextBatchSize(txs []*pb_common.Transaction, preferredMaxBytes int) (int, error) {
+	if preferredMaxBytes <= 0 {
+		return len(txs), nil
+	}
+	for count := 2; count <= len(txs); count++ {
+		size, err := blockutil.EstimateBlockSize(txs[:count])
+		if err != nil {
+			return 0, errors.Wrap(err, "failed to estimate block size")
+		}
+		if size > preferredMaxBytes {
+			return count - 1, nil
+		}
+	}
+	return len(txs), nil
+}
+
+// writeBlock 트랜잭션들로 채널의 다음 블록을 생성해 저장하고 구독자에게 전달
+func (bc *BlockCutter) writeBlock(channelID string, transactions []*pb_common.Transaction) error {
+	block, err := bc.appendBlock(channelID, func(number uint64, previousHash []byte) (*pb_common.Block, error) {
+		block, err := blockutil.GenerateDataBlock(transactions, channelID, bc.cs.OrdererConfig.MSP.GetSigningIdentity())
+		if err != nil {
+			return nil, err
+		}
+		blockutil.SetBlockPosition(block, number, previousHash)
+		return block, nil
+	})
+	if err != nil {
+		return err
+	}
+	logger.Infof("[Orderer] Block %d cut for channel %s (%d transactions)", block.Header.Number, channelID, len(transactions))
+	return nil
+}
+
+// WriteConfigBlock 채널 설정(JSON)을 담은 설정 블록을 채널의 다음 블록으로 저장하고 구독자에게 전달
+// 트랜잭션 블록과 같은 lock으로 직렬화되어 블록 번호와 PreviousHash가 이어진다.
+func (bc *BlockCutter) WriteConfigBlock(channelID string, channelConfig []byte) (*pb_common.Block, error) {
+	bc.writeMutex.Lock()
+	defer bc.writeMutex.Unlock()
+
+	block, err := bc.appendBlock(channelID, func(number uint64, previousHash []byte) (*pb_common.Block, error) {
+		return blockutil.GenerateConfigUpdateBlock(number, previousHash, channelConfig, channelID, bc.cs.OrdererConfig.MSP.GetSigningIdentity())
+	})
+	if err != nil {
+		return nil, err
+	}
+	logger.Infof("[Orderer] Config block %d written for channel %s", block.Header.Number, channelID)
+	return block, nil
+}
+
+// appendBlock generate로 만든 블록을 채널의 다음 블록으로 서명, 저장, 커밋한 뒤 구독자에게 전달
+// writeMutex를 잡은 상태에서 호출되어야 함
+func (bc *BlockCutter) appendBlock(channelID string, generate func(number uint64, previousHash []byte) (*pb_common.Block, error)) (*pb_common.Block, error) {
+	filesystemPath := bc.cs.OrdererConfig.FilesystemPath
+	height := bc.cs.channelHeight(channelID)
+	if height == 0 {
+		return nil, errors.Errorf("channel %s has no config block", channelID)
+	}
+	previousBlock, err := blockutil.LoadBlock(fmt.Sprintf("%s/%s/blockfile%d", filesystemPath, channelID, height-1))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load previous block")
+	}
+
+	block, err := generate(height, blockutil.CalculateBlockHash(previousBlock))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to generate block")
+	}
+	block.Metadata.AccumulatedHash = blockutil.ComputeAccumulatedHash(previousBlock.Metadata.GetAccumulatedHash(), block.Header.DataHash)
+	if err := blockutil.SignBlock(block, bc.cs.OrdererConfig.MSP.GetSigningIdentity()); err != nil {
+		return nil, err
+	}
+	if err := blockutil.SaveBlockFile(block, channelID, filesystemPath); err != nil {
+		return nil, errors.Wrap(err, "failed to save block")
+	}
+	if err := bc.cs.commitSequence(channelID, height); err != nil {
+		return nil, errors.Wrap(err, "failed to commit sequence")
+	}
+	bc.cs.publishBlock(channelID, block)
+	return block, nil
+}
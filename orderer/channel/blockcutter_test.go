@@ -0,0 +1,246 @@
+package channel
+
+import (
+	"context"
+	"io"
+	"os"
+	"reflect"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ddr4869/minifab/common/blockutil"
+	"github.com/ddr4869/minifab/common/configtx"
+	pb_common "github.com/ddr4869/minifab/proto/common"
+)
+
+// setMaxMessageCount 채널 설정의 BatchSize.MaxMessageCount 변경
+func setMaxMessageCount(cs *ChainSupport, channelID string, maxMessageCount int) {
+	channelConfig, _ := cs.Channels.Get(channelID)
+	scc := *channelConfig.SCC
+	scc.Orderer.BatchSize.MaxMessageCount = maxMessageCount
+	cs.Channels.Set(channelID, &configtx.ChannelConfig{CC: channelConfig.CC, SCC: &scc})
+}
+
+// setPreferredMaxBytes 채널 설정의 BatchSize.PreferredMaxBytes 변경
+func setPreferredMaxBytes(cs *ChainSupport, channelID string, preferredMaxBytes int) {
+	channelConfig, _ := cs.Channels.Get(channelID)
+	scc := *channelConfig.SCC
+	scc.Orderer.BatchSize.PreferredMaxBytes = strconv.Itoa(preferredMaxBytes)
+	cs.Channels.Set(channelID, &configtx.ChannelConfig{CC: channelConfig.CC, SCC: &scc})
+}
+
+func TestBlockCutterDrainWritesPartialBlock(t *testing.T) {
+	n := newTestNetwork(t)
+	n.createChannel(t, "mychannel")
+	setMaxMessageCount(n.cs, "mychannel", 100)
+
+	n.cs.Cutter.Start()
+	txIDs := n.enqueue(t, n.peerOrg.SigningIdentity(), "mychannel", 10)
+	n.cs.Cutter.Notify()
+
+	if err := n.cs.Cutter.Drain(5 * time.Second); err != nil {
+		t.Fatalf("Drain: %v", err)
+	}
+	if height := n.cs.channelHeight("mychannel"); height != 2 {
+		t.Fatalf("channel height = %d, want 2", height)
+	}
+	if got := blockTxIDs(t, n.loadBlock(t, "mychannel", 1)); !reflect.DeepEqual(got, txIDs) {
+		t.Fatalf("partial block has %d transactions %v, want %v", len(got), got, txIDs)
+	}
+	if pending := n.cs.PendingQueue.Len("mychannel"); pending != 0 {
+		t.Fatalf("%d transactions left in queue after drain", pending)
+	}
+}
+
+// blockingCreateChannelStream release가 닫힐 때까지 Recv에서 멈춰 있는 CreateChannel stream
+type blockingCreateChannelStream struct {
+	fakeCreateChannelStream
+	receiving chan struct{}
+	release   chan struct{}
+}
+
+func (s *blockingCreateChannelStream) Recv() (*pb_common.Envelope, error) {
+	close(s.receiving)
+	<-s.release
+	return nil, io.EOF
+}
+
+func TestBlockCutterDrainWhileCreateChannelStreamOpen(t *testing.T) {
+	n := newTestNetwork(t)
+	n.createChannel(t, "mychannel")
+	setMaxMessageCount(n.cs, "mychannel", 100)
+	n.cs.Cutter.Start()
+
+	stream := &blockingCreateChannelStream{receiving: make(chan struct{}), release: make(chan struct{})}
+	streamErr := make(chan error, 1)
+	go func() { streamErr <- n.cs.CreateChannel(stream) }()
+	<-stream.receiving
+
+	txIDs := n.enqueue(t, n.peerOrg.SigningIdentity(), "mychannel", 3)
+	if err := n.cs.Cutter.Drain(2 * time.Second); err != nil {
+		t.Fatalf("Drain with an open CreateChannel stream: %v", err)
+	}
+	if got := blockTxIDs(t, n.loadBlock(t, "mychannel", 1)); !reflect.DeepEqual(got, txIDs) {
+		t.Fatalf("partial block has transactions %v, want %v", got, txIDs)
+	}
+
+	close(stream.release)
+	if err := <-streamErr; err != nil {
+		t.Fatalf("CreateChannel: %v", err)
+	}
+}
+
+func TestBlockCutterStopImmediatelyAfterStart(t *testing.T) {
+	n := newTestNetwork(t)
+
+	n.cs.Cutter.Start()
+	n.cs.Cutter.Stop()
+
+	select {
+	case <-n.cs.Cutter.doneCh:
+	case <-time.After(time.Second):
+		t.Fatal("cutter loop still running after Stop")
+	}
+}
+
+func TestCutBlockRequeuesTransactionsWhenWriteFails(t *testing.T) {
+	n := newTestNetwork(t)
+	n.createChannel(t, "mychannel")
+	txIDs := n.enqueue(t, n.peerOrg.SigningIdentity(), "mychannel", 5)
+
+	// 이전 블록을 읽을 수 없으면 블록 저장이 실패한다
+	genesisPath := n.cs.blockPath("mychannel", 0)
+	genesis, err := os.ReadFile(genesisPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Remove(genesisPath); err != nil {
+		t.Fatal(err)
+	}
+	if err := n.cs.Cutter.CutBlock("mychannel"); err == nil {
+		t.Fatal("expected CutBlock to fail without the previous block")
+	}
+	if pending := n.cs.PendingQueue.Len("mychannel"); pending != len(txIDs) {
+		t.Fatalf("%d transactions in queue after failed write, want %d", pending, len(txIDs))
+	}
+
+	if err := os.WriteFile(genesisPath, genesis, 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := n.cs.Cutter.CutBlock("mychannel"); err != nil {
+		t.Fatalf("CutBlock: %v", err)
+	}
+	if got := blockTxIDs(t, n.loadBlock(t, "mychannel", 1)); !reflect.DeepEqual(got, txIDs) {
+		t.Fatalf("block after retry has %v, want %v", got, txIDs)
+	}
+}
+
+func TestSubmitTransactionDuringDrainIsNeverLost(t *testing.T) {
+	n := newTestNetwork(t)
+	n.createChannel(t, "mychannel")
+	setMaxMessageCount(n.cs, "mychannel", 1000)
+	n.cs.Cutter.Start()
+
+	signer := n.peerOrg.SigningIdentity()
+	var mutex sync.Mutex
+	var accepted []string
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		tx := newTestTransaction(t, signer, i)
+		envelope := newTestEnvelope(t, signer, "mychannel", tx)
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			response, err := n.cs.SubmitTransaction(context.Background(), envelope)
+			if err != nil {
+				t.Errorf("SubmitTransaction: %v", err)
+				return
+			}
+			if response.Status == pb_common.Status_OK {
+				mutex.Lock()
+				accepted = append(accepted, tx.TxId)
+				mutex.Unlock()
+			}
+		}()
+	}
+	if err := n.cs.Cutter.Drain(5 * time.Second); err != nil {
+		t.Fatalf("Drain: %v", err)
+	}
+	wg.Wait()
+
+	committed := make(map[string]bool)
+	for number := uint64(1); number < n.cs.channelHeight("mychannel"); number++ {
+		for _, txID := range blockTxIDs(t, n.loadBlock(t, "mychannel", number)) {
+			committed[txID] = true
+		}
+	}
+	for _, txID := range accepted {
+		if !committed[txID] {
+			t.Fatalf("transaction %s was acknowledged but not written to a block", txID)
+		}
+	}
+	if pending := n.cs.PendingQueue.Len("mychannel"); pending != 0 {
+		t.Fatalf("%d transactions left in queue after drain", pending)
+	}
+
+	response, err := n.cs.SubmitTransaction(context.Background(), newTestEnvelope(t, signer, "mychannel", newTestTransaction(t, signer, 99)))
+	if err != nil || response.Status != pb_common.Status_UNAVAILABLE {
+		t.Fatalf("SubmitTransaction after drain = %v, %v, want UNAVAILABLE", response.GetStatus(), err)
+	}
+}
+
+func TestCutBlockSplitsAtPreferredMaxBytes(t *testing.T) {
+	n := newTestNetwork(t)
+	n.createChannel(t, "mychannel")
+	txIDs := n.enqueue(t, n.peerOrg.SigningIdentity(), "mychannel", 7)
+
+	// 트랜잭션 3개까지만 들어가는 크기로 제한
+	signer := n.peerOrg.SigningIdentity()
+	txs := []*pb_common.Transaction{newTestTransaction(t, signer, 0), newTestTransaction(t, signer, 1), newTestTransaction(t, signer, 2)}
+	size, err := blockutil.EstimateBlockSize(txs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	setPreferredMaxBytes(n.cs, "mychannel", size)
+
+	if err := n.cs.Cutter.CutBlock("mychannel"); err != nil {
+		t.Fatalf("CutBlock: %v", err)
+	}
+	want := [][]string{txIDs[0:3], txIDs[3:6], txIDs[6:7]}
+	for i, wantIDs := range want {
+		if got := blockTxIDs(t, n.loadBlock(t, "mychannel", uint64(i+1))); !reflect.DeepEqual(got, wantIDs) {
+			t.Errorf("block %d has %v, want %v", i+1, got, wantIDs)
+		}
+	}
+	if height := n.cs.channelHeight("mychannel"); height != 4 {
+		t.Errorf("height = %d, want 4", height)
+	}
+
+	// 한 트랜잭션이 상한보다 커도 블록에는 최소 1개가 들어간다
+	setPreferredMaxBytes(n.cs, "mychannel", 1)
+	n.enqueue(t, signer, "mychannel", 2)
+	if err := n.cs.Cutter.CutBlock("mychannel"); err != nil {
+		t.Fatalf("CutBlock: %v", err)
+	}
+	for _, number := range []uint64{4, 5} {
+		if got := blockTxIDs(t, n.loadBlock(t, "mychannel", number)); len(got) != 1 {
+			t.Errorf("block %d has %d transactions, want 1", number, len(got))
+		}
+	}
+}
+
+func TestCutBlockChainsAccumulatedHash(t *testing.T) {
+	n := newTestNetwork(t)
+	n.createChannel(t, "mychannel")
+	n.cutBlocks(t, "mychannel", 3)
+
+	var blocks []*pb_common.Block
+	for number := uint64(0); number < 4; number++ {
+		blocks = append(blocks, n.loadBlock(t, "mychannel", number))
+	}
+	if err := blockutil.VerifyBlockChain(blocks); err != nil {
+		t.Fatalf("VerifyBlockChain: %v", err)
+	}
+}
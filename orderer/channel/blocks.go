@@ -0,0 +1,96 @@
+package channel
+
+import (
+	"context"
+	"os"
+
+	"github.com/ddr4869/minifab/common/blockutil"
+	"github.com/ddr4869/minifab/common/logger"
+	pb_common "github.com/ddr4869/minifab/proto/common"
+	pb_orderer "github.com/ddr4869/minifab/proto/orderer"
+	"github.com/pkg/errors"
+)
+
+// MaxBlockRange GetBlocks RPC 한 번에 조회할 수 있는 최대 블록 수
+const MaxBlockRange = 100
+
+var (
+	// ErrBlockNotFound 조회한 번호의 블록이 아직 커밋되지 않음
+	ErrBlockNotFound = errors.New("block not found")
+	// ErrInvalidBlockRange 시작 번호가 끝 번호보다 작지 않은 범위
+	ErrInvalidBlockRange = errors.New("invalid block range")
+)
+
+// GetBlockRange 파일 시스템의 <FilesystemPath>/<channelID>/blockfileN에서 [start, end) 범위의 블록을 순서대로 읽음
+// end가 채널 높이보다 크면 ErrBlockNotFound를 반환한다.
+func (cs *ChainSupport) GetBlockRange(channelID string, start, end uint64) ([]*pb_common.Block, error) {
+	if start >= end {
+		return nil, errors.Wrapf(ErrInvalidBlockRange, "[%d, %d)", start, end)
+	}
+	if height := cs.channelHeight(channelID); end > height {
+		return nil, errors.Wrapf(ErrBlockNotFound, "block %d of channel %s (height: %d)", end-1, channelID, height)
+	}
+
+	blocks := make([]*pb_common.Block, 0, end-start)
+	for number := start; number < end; number++ {
+		blockPath := cs.blockPath(channelID, number)
+		if _, err := os.Stat(blockPath); os.IsNotExist(err) {
+			return nil, errors.Wrapf(ErrBlockNotFound, "block %d of channel %s", number, channelID)
+		}
+		block, err := blockutil.LoadBlock(blockPath)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to load block %d of channel %s", number, channelID)
+		}
+		blocks = append(blocks, block)
+	}
+	return blocks, nil
+}
+
+// GetBlock 파일 시스템에 저장된 채널의 블록을 번호로 조회
+func (cs *ChainSupport) GetBlock(ctx context.Context, req *pb_orderer.BlockRequest) (*pb_orderer.BlockResponse, error) {
+	if _, exists := cs.GetChannelInfo(req.ChannelId); !exists {
+		return &pb_orderer.BlockResponse{Status: pb_common.Status_CHANNEL_NOT_FOUND}, nil
+	}
+
+	blocks, err := cs.GetBlockRange(req.ChannelId, req.BlockNumber, req.BlockNumber+1)
+	if err != nil {
+		return &pb_orderer.BlockResponse{Status: blockRangeErrorStatus(req.ChannelId, err)}, nil
+	}
+	return &pb_orderer.BlockResponse{
+		Status: pb_common.Status_OK,
+		Block:  blocks[0],
+	}, nil
+}
+
+// GetBlocks [start_block, end_block) 범위의 블록을 한 번에 조회 (최대 MaxBlockRange개)
+func (cs *ChainSupport) GetBlocks(ctx context.Context, req *pb_orderer.BlockRangeRequest) (*pb_orderer.BlockRangeResponse, error) {
+	if _, exists := cs.GetChannelInfo(req.ChannelId); !exists {
+		return &pb_orderer.BlockRangeResponse{Status: pb_common.Status_CHANNEL_NOT_FOUND}, nil
+	}
+	if req.EndBlock > req.StartBlock && req.EndBlock-req.StartBlock > MaxBlockRange {
+		logger.Errorf("[Orderer] Block range [%d, %d) of channel %s exceeds %d blocks", req.StartBlock, req.EndBlock, req.ChannelId, MaxBlockRange)
+		return &pb_orderer.BlockRangeResponse{Status: pb_common.Status_INVALID_ARGUMENT}, nil
+	}
+
+	blocks, err := cs.GetBlockRange(req.ChannelId, req.StartBlock, req.EndBlock)
+	if err != nil {
+		return &pb_orderer.BlockRangeResponse{Status: blockRangeErrorStatus(req.ChannelId, err)}, nil
+	}
+	return &pb_orderer.BlockRangeResponse{
+		Status: pb_common.Status_OK,
+		Blocks: blocks,
+	}, nil
+}
+
+// blockRangeErrorStatus GetBlockRange 에러를 응답 status로 변환
+func blockRangeErrorStatus(channelID string, err error) pb_common.Status {
+	switch {
+	case errors.Is(err, ErrInvalidBlockRange):
+		return pb_common.Status_INVALID_ARGUMENT
+	case errors.Is(err, ErrBlockNotFound):
+		return pb_common.Status_NOT_FOUND
+	default:
+		logger.Errorf("[Orderer] Failed to read blocks of channel %s: %v", channelID, err)
+		return pb_common.Status_LEDGER_ERROR
+	}
+}
@@ -0,0 +1,114 @@
+package channel
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	pb_common "github.com/ddr4869/minifab/proto/common"
+	pb_orderer "github.com/ddr4869/minifab/proto/orderer"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// serve ChainSupport를 임의 포트의 gRPC 서버로 서비스하고 주소 반환
+func (n *testNetwork) serve(t *testing.T) string {
+	t.Helper()
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	server := grpc.NewServer()
+	pb_orderer.RegisterOrdererServiceServer(server, n.cs)
+	go server.Serve(lis)
+	t.Cleanup(server.Stop)
+	return lis.Addr().String()
+}
+
+// serveBlocks ChainSupport를 gRPC로 서비스하고 연결된 클라이언트 반환
+func (n *testNetwork) serveBlocks(t *testing.T) pb_orderer.OrdererServiceClient {
+	t.Helper()
+
+	conn, err := grpc.NewClient(n.serve(t), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatalf("grpc.NewClient: %v", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return pb_orderer.NewOrdererServiceClient(conn)
+}
+
+func TestGetBlocksOverGRPC(t *testing.T) {
+	n := newTestNetwork(t)
+	n.createChannel(t, "mychannel")
+	n.cutBlocks(t, "mychannel", 9)
+	client := n.serveBlocks(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	response, err := client.GetBlocks(ctx, &pb_orderer.BlockRangeRequest{ChannelId: "mychannel", StartBlock: 3, EndBlock: 8})
+	if err != nil {
+		t.Fatalf("GetBlocks: %v", err)
+	}
+	if response.Status != pb_common.Status_OK || len(response.Blocks) != 5 {
+		t.Fatalf("GetBlocks = %s with %d blocks, want OK with 5", response.Status, len(response.Blocks))
+	}
+	for i, block := range response.Blocks {
+		if want := uint64(3 + i); block.Header.Number != want {
+			t.Errorf("block %d has number %d, want %d", i, block.Header.Number, want)
+		}
+	}
+
+	tests := []struct {
+		name       string
+		channelID  string
+		start, end uint64
+		want       pb_common.Status
+	}{
+		{"past the height", "mychannel", 8, 11, pb_common.Status_NOT_FOUND},
+		{"empty range", "mychannel", 5, 5, pb_common.Status_INVALID_ARGUMENT},
+		{"too many blocks", "mychannel", 0, MaxBlockRange + 1, pb_common.Status_INVALID_ARGUMENT},
+		{"unknown channel", "otherchannel", 0, 1, pb_common.Status_CHANNEL_NOT_FOUND},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			response, err := client.GetBlocks(ctx, &pb_orderer.BlockRangeRequest{ChannelId: tt.channelID, StartBlock: tt.start, EndBlock: tt.end})
+			if err != nil {
+				t.Fatalf("GetBlocks: %v", err)
+			}
+			if response.Status != tt.want || len(response.Blocks) != 0 {
+				t.Fatalf("GetBlocks = %s with %d blocks, want %s", response.Status, len(response.Blocks), tt.want)
+			}
+		})
+	}
+}
+
+func TestGetBlockUsesBlockRange(t *testing.T) {
+	n := newTestNetwork(t)
+	n.createChannel(t, "mychannel")
+	n.cutBlocks(t, "mychannel", 9)
+	client := n.serveBlocks(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	response, err := client.GetBlock(ctx, &pb_orderer.BlockRequest{ChannelId: "mychannel", BlockNumber: 9})
+	if err != nil {
+		t.Fatalf("GetBlock: %v", err)
+	}
+	if response.Status != pb_common.Status_OK || response.Block.GetHeader().GetNumber() != 9 {
+		t.Fatalf("GetBlock(9) = %s block %d, want OK block 9", response.Status, response.Block.GetHeader().GetNumber())
+	}
+	response, err = client.GetBlock(ctx, &pb_orderer.BlockRequest{ChannelId: "mychannel", BlockNumber: 10})
+	if err != nil {
+		t.Fatalf("GetBlock: %v", err)
+	}
+	if response.Status != pb_common.Status_NOT_FOUND {
+		t.Errorf("GetBlock(10) status = %s, want NOT_FOUND", response.Status)
+	}
+
+	if _, err := n.cs.GetBlockRange("mychannel", 4, 2); !errors.Is(err, ErrInvalidBlockRange) {
+		t.Errorf("GetBlockRange(4, 2) error = %v, want ErrInvalidBlockRange", err)
+	}
+}
@@ -0,0 +1,107 @@
+package channel
+
+import (
+	"sync"
+
+	"github.com/ddr4869/minifab/common/logger"
+	pb_common "github.com/ddr4869/minifab/proto/common"
+)
+
+// subscriberBufferSize 구독자별 블록 채널 버퍼 크기
+const subscriberBufferSize = 16
+
+// BlockBroadcaster는 커밋된 블록을 채널별 구독자(deliver stream)들에게 전달한다.
+// 구독자의 버퍼가 가득 차면 해당 구독자에게는 블록을 건너뛰므로, 구독자는 블록 번호의 공백을 저장소에서 채워야 한다.
+type BlockBroadcaster struct {
+	mutex       sync.RWMutex
+	nextID      uint64
+	subscribers map[string]map[uint64]chan *pb_common.Block
+	// 모든 채널의 블록을 받는 구독자 (SubscribeAll)
+	allSubscribers map[uint64]chan ChannelBlock
+}
+
+// ChannelBlock SubscribeAll 구독자에게 전달되는 블록과 그 채널 ID
+type ChannelBlock struct {
+	ChannelID string
+	Block     *pb_common.Block
+}
+
+func NewBlockBroadcaster() *BlockBroadcaster {
+	return &BlockBroadcaster{
+		subscribers:    make(map[string]map[uint64]chan *pb_common.Block),
+		allSubscribers: make(map[uint64]chan ChannelBlock),
+	}
+}
+
+// Subscribe 채널에 커밋되는 블록을 받을 채널과 구독 해제 함수 반환
+func (b *BlockBroadcaster) Subscribe(channelID string) (<-chan *pb_common.Block, func()) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	id := b.nextID
+	b.nextID++
+	blocks := make(chan *pb_common.Block, subscriberBufferSize)
+	if b.subscribers[channelID] == nil {
+		b.subscribers[channelID] = make(map[uint64]chan *pb_common.Block)
+	}
+	b.subscribers[channelID][id] = blocks
+
+	var once sync.Once
+	unsubscribe := func() {
+		once.Do(func() {
+			b.mutex.Lock()
+			defer b.mutex.Unlock()
+
+			delete(b.subscribers[channelID], id)
+			if len(b.subscribers[channelID]) == 0 {
+				delete(b.subscribers, channelID)
+			}
+			close(blocks)
+		})
+	}
+	return blocks, unsubscribe
+}
+
+// SubscribeAll 모든 채널에 커밋되는 블록을 채널 ID와 함께 받을 채널과 구독 해제 함수 반환
+func (b *BlockBroadcaster) SubscribeAll() (<-chan ChannelBlock, func()) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	id := b.nextID
+	b.nextID++
+	blocks := make(chan ChannelBlock, subscriberBufferSize)
+	b.allSubscribers[id] = blocks
+
+	var once sync.Once
+	unsubscribe := func() {
+		once.Do(func() {
+			b.mutex.Lock()
+			defer b.mutex.Unlock()
+
+			delete(b.allSubscribers, id)
+			close(blocks)
+		})
+	}
+	return blocks, unsubscribe
+}
+
+// Publish 채널의 모든 구독자에게 블록 전달 (느린 구독자 때문에 커밋이 막히지 않도록 non-blocking)
+func (b *BlockBroadcaster) Publish(channelID string, block *pb_common.Block) {
+	b.mutex.RLock()
+	defer b.mutex.RUnlock()
+
+	for id, blocks := range b.subscribers[channelID] {
+		select {
+		case blocks <- block:
+		default:
+			logger.Warnf("[Orderer] Subscriber %d of channel %s is slow, skipping block %d", id, channelID, block.Header.Number)
+		}
+	}
+	for id, blocks := range b.allSubscribers {
+		select {
+		case blocks <- ChannelBlock{ChannelID: channelID, Block: block}:
+		default:
+			logger.Warnf("[Orderer] Subscriber %d of all channels is slow, skipping block %d of channel %s", id, block.Header.Number, channelID)
+		}
+	}
+}
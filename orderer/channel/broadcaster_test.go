@@ -0,0 +1,161 @@
+package channel
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	pb_common "github.com/ddr4869/minifab/proto/common"
+	pb_orderer "github.com/ddr4869/minifab/proto/orderer"
+	"google.golang.org/grpc"
+)
+
+func testBlock(number uint64) *pb_common.Block {
+	return &pb_common.Block{Header: &pb_common.BlockHeader{Number: number}}
+}
+
+func TestBlockBroadcasterFanOut(t *testing.T) {
+	b := NewBlockBroadcaster()
+
+	var subscribed sync.WaitGroup
+	subscriptions := make([]<-chan *pb_common.Block, 3)
+	for i := range subscriptions {
+		subscribed.Add(1)
+		go func(i int) {
+			defer subscribed.Done()
+			blocks, unsubscribe := b.Subscribe("mychannel")
+			t.Cleanup(unsubscribe)
+			subscriptions[i] = blocks
+		}(i)
+	}
+	subscribed.Wait()
+	other, unsubscribeOther := b.Subscribe("otherchannel")
+	defer unsubscribeOther()
+	all, unsubscribeAll := b.SubscribeAll()
+	defer unsubscribeAll()
+
+	block := testBlock(1)
+	b.Publish("mychannel", block)
+
+	timeout := time.After(100 * time.Millisecond)
+	for i, blocks := range subscriptions {
+		select {
+		case received := <-blocks:
+			if received != block {
+				t.Errorf("subscriber %d received block %d", i, received.Header.Number)
+			}
+		case <-timeout:
+			t.Fatalf("subscriber %d did not receive the block within 100ms", i)
+		}
+	}
+	select {
+	case received := <-all:
+		if received.ChannelID != "mychannel" || received.Block != block {
+			t.Errorf("SubscribeAll received %+v", received)
+		}
+	case <-timeout:
+		t.Fatal("SubscribeAll subscriber did not receive the block within 100ms")
+	}
+	select {
+	case received := <-other:
+		t.Errorf("subscriber of another channel received block %d", received.Header.Number)
+	default:
+	}
+}
+
+func TestBlockBroadcasterUnsubscribe(t *testing.T) {
+	b := NewBlockBroadcaster()
+	blocks, unsubscribe := b.Subscribe("mychannel")
+	unsubscribe()
+	unsubscribe()
+
+	if _, ok := <-blocks; ok {
+		t.Fatal("block channel is still open after unsubscribe")
+	}
+	if len(b.subscribers) != 0 {
+		t.Errorf("%d channels still have subscribers", len(b.subscribers))
+	}
+	// 구독자가 없어도 Publish는 막히지 않는다
+	b.Publish("mychannel", testBlock(1))
+}
+
+func TestBlockBroadcasterSkipsSlowSubscriber(t *testing.T) {
+	b := NewBlockBroadcaster()
+	slow, unsubscribeSlow := b.Subscribe("mychannel")
+	defer unsubscribeSlow()
+
+	for i := 0; i <= subscriberBufferSize; i++ {
+		b.Publish("mychannel", testBlock(uint64(i)))
+	}
+	if len(slow) != subscriberBufferSize {
+		t.Fatalf("slow subscriber buffered %d blocks, want %d", len(slow), subscriberBufferSize)
+	}
+	for i := 0; i < subscriberBufferSize; i++ {
+		if number := (<-slow).Header.Number; number != uint64(i) {
+			t.Fatalf("block %d has number %d", i, number)
+		}
+	}
+}
+
+// fakeDeliverStream DeliverBlocks가 보낸 응답을 채널로 전달하는 서버 stream
+type fakeDeliverStream struct {
+	grpc.ServerStream
+	ctx       context.Context
+	responses chan *pb_orderer.BlockResponse
+}
+
+func (s *fakeDeliverStream) Context() context.Context { return s.ctx }
+
+func (s *fakeDeliverStream) Send(response *pb_orderer.BlockResponse) error {
+	s.responses <- response
+	return nil
+}
+
+// receiveBlock stream에서 블록 응답 하나를 받아 블록 번호 확인
+func (s *fakeDeliverStream) receiveBlock(t *testing.T, number uint64) {
+	t.Helper()
+
+	select {
+	case response := <-s.responses:
+		if response.Status != pb_common.Status_OK || response.Block.GetHeader().GetNumber() != number {
+			t.Fatalf("received %s block %d, want OK block %d", response.Status, response.Block.GetHeader().GetNumber(), number)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("block %d was not delivered", number)
+	}
+}
+
+func TestDeliverBlocksSendsStoredAndNewBlocks(t *testing.T) {
+	n := newTestNetwork(t)
+	n.createChannel(t, "mychannel")
+	n.cutBlocks(t, "mychannel", 2)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stream := &fakeDeliverStream{ctx: ctx, responses: make(chan *pb_orderer.BlockResponse, 10)}
+	done := make(chan error, 1)
+	go func() {
+		done <- n.cs.DeliverBlocks(&pb_orderer.DeliverRequest{ChannelId: "mychannel", StartBlock: 1}, stream)
+	}()
+
+	stream.receiveBlock(t, 1)
+	stream.receiveBlock(t, 2)
+	n.cutBlocks(t, "mychannel", 1)
+	stream.receiveBlock(t, 3)
+
+	cancel()
+	if err := <-done; err != nil {
+		t.Fatalf("DeliverBlocks: %v", err)
+	}
+	if len(n.cs.Broadcaster.subscribers) != 0 {
+		t.Error("DeliverBlocks did not unsubscribe after the stream ended")
+	}
+
+	stream = &fakeDeliverStream{ctx: context.Background(), responses: make(chan *pb_orderer.BlockResponse, 1)}
+	if err := n.cs.DeliverBlocks(&pb_orderer.DeliverRequest{ChannelId: "nochannel"}, stream); err != nil {
+		t.Fatalf("DeliverBlocks: %v", err)
+	}
+	if response := <-stream.responses; response.Status != pb_common.Status_CHANNEL_NOT_FOUND {
+		t.Errorf("status = %s, want CHANNEL_NOT_FOUND", response.Status)
+	}
+}
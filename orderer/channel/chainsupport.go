@@ -1,16 +1,20 @@
 package channel
 
 import (
+	"bytes"
+	"context"
 	"crypto/x509"
 	"encoding/json"
 	"fmt"
 	"io"
+	"os"
 	"sync"
+	"time"
 
 	"github.com/ddr4869/minifab/common/blockutil"
-	"github.com/ddr4869/minifab/common/cert"
 	"github.com/ddr4869/minifab/common/configtx"
 	"github.com/ddr4869/minifab/common/logger"
+	"github.com/ddr4869/minifab/common/msp"
 	"github.com/ddr4869/minifab/config"
 	pb_common "github.com/ddr4869/minifab/proto/common"
 	pb_orderer "github.com/ddr4869/minifab/proto/orderer"
@@ -21,48 +25,157 @@ import (
 // 지속성 있는 데이터의 경우 Orderer의 파일 시스템에 저장되어야 한다.
 type ChainSupport struct {
 	SystemChannelInfo *configtx.SystemChannelInfo
-	AppChannelConfigs map[string]*configtx.ChannelConfig
+	// MSPManager 시스템 채널 consortium 조직별 MSP (LoadSystemChannelConfig에서 구성)
+	MSPManager msp.MSPManager
+	// Channels application 채널 설정 (Orderer와 공유)
+	Channels *ChannelRegistry
 
 	OrdererConfig *config.OrdererCfg
+	PendingQueue  *FairQueue
+	Cutter        *BlockCutter
+	BlockAcks     *BlockAckStore
+	Sequences     *SequenceStore
+	Broadcaster   *BlockBroadcaster
 	Mutex         sync.RWMutex
 	pb_orderer.UnimplementedOrdererServiceServer
 }
 
-func (cs *ChainSupport) GetSystemChannelConfig() *configtx.SystemChannelInfo {
-	return cs.SystemChannelInfo
-}
-
+// LoadSystemChannelConfig 제네시스 블록에서 시스템 채널 설정 로드 (예전 JSON 제네시스만 있으면 protobuf로 변환)
 func (cs *ChainSupport) LoadSystemChannelConfig(genesisPath string) {
-	scc, err := blockutil.LoadSystemChannelConfig(genesisPath)
+	scc, err := blockutil.LoadSystemChannelConfigWithMigration(genesisPath)
 	if err != nil {
 		logger.Panicf("Failed to load system channel config: %v", err)
 		return
 	}
 	cs.SystemChannelInfo = scc
+	cs.MSPManager = consortiumMSPManager(scc)
+}
+
+// consortiumMSPManager 시스템 채널 consortium 조직마다 CA 인증서로 MSP를 구성해 MSPManager 생성
+func consortiumMSPManager(scc *configtx.SystemChannelInfo) msp.MSPManager {
+	msps := make(map[string]msp.MSP, len(scc.Consortiums))
+	for _, org := range scc.Consortiums {
+		msps[org.ID] = organizationMSP(org)
+	}
+	return msp.NewMSPManager(msps)
+}
+
+// RetryOptions LoadExistingChannels에서 채널 복원 실패 시 재시도 설정
+type RetryOptions struct {
+	// MaxRetries 첫 시도 이후 재시도 횟수
+	MaxRetries int
+	// Delay 재시도 사이 대기 시간
+	Delay time.Duration
 }
 
-func (cs *ChainSupport) LoadExistingChannels(filesystemPath string) {
+// DefaultRetryOptions 기본 재시도 설정 (3회, 500ms 간격)
+func DefaultRetryOptions() RetryOptions {
+	return RetryOptions{
+		MaxRetries: 3,
+		Delay:      500 * time.Millisecond,
+	}
+}
+
+// LoadExistingChannels 파일 시스템의 채널 폴더마다 ReplayChannel을 수행
+// 디스크가 잠시 응답하지 않는 경우를 위해 실패한 채널은 RetryOptions(생략 시 DefaultRetryOptions)만큼 재시도하고,
+// 그래도 실패한 채널은 경고를 남긴 뒤 나머지 채널을 계속 로드한다.
+func (cs *ChainSupport) LoadExistingChannels(filesystemPath string, opts ...RetryOptions) {
+	retry := DefaultRetryOptions()
+	if len(opts) > 0 {
+		retry = opts[0]
+	}
+
 	logger.Info("🔄 Loading existing channel configurations...")
 
-	appChannelConfigs, err := blockutil.LoadAppChannelConfigs(filesystemPath)
+	entries, err := os.ReadDir(filesystemPath)
+	if os.IsNotExist(err) {
+		logger.Infof("Filesystem path does not exist: %s, starting with empty channel configs", filesystemPath)
+		return
+	}
 	if err != nil {
 		logger.Errorf("Failed to load existing channel configs: %v", err)
 		return
 	}
 
-	for channelName, config := range appChannelConfigs {
-		cs.AppChannelConfigs[channelName] = &configtx.ChannelConfig{
-			CC:  config.CC,
-			SCC: config.SCC,
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
 		}
+		if err := cs.replayChannelWithRetry(filesystemPath, entry.Name(), retry); err != nil {
+			logger.Warnf("Failed to replay channel %s: %v", entry.Name(), err)
+		}
+	}
+}
+
+func (cs *ChainSupport) replayChannelWithRetry(filesystemPath, channelID string, retry RetryOptions) error {
+	err := cs.replayChannel(filesystemPath, channelID)
+	for attempt := 1; err != nil && attempt <= retry.MaxRetries; attempt++ {
+		logger.Infof("Retrying replay of channel %s (%d/%d) after %s: %v", channelID, attempt, retry.MaxRetries, retry.Delay, err)
+		time.Sleep(retry.Delay)
+		err = cs.replayChannel(filesystemPath, channelID)
 	}
+	return err
+}
+
+// ReplayChannel 디스크의 blockfile0으로부터 채널 설정을 다시 만들어 Channels에 등록
+func (cs *ChainSupport) ReplayChannel(channelID string) error {
+	return cs.replayChannel(cs.OrdererConfig.FilesystemPath, channelID)
+}
+
+func (cs *ChainSupport) replayChannel(filesystemPath, channelID string) error {
+	if channelID == "" {
+		return errors.New("channel ID cannot be empty")
+	}
+
+	blockPath := fmt.Sprintf("%s/%s/blockfile0", filesystemPath, channelID)
+	channelConfig, err := blockutil.LoadChannelConfigDataFromBlock(blockPath)
+	if err != nil {
+		return errors.Wrapf(err, "failed to load config block of channel %s", channelID)
+	}
+
+	height := blockutil.GetBlockHeight(channelID, filesystemPath)
+	if cs.Sequences != nil {
+		height, err = cs.Sequences.Load(channelID)
+		if err != nil {
+			return errors.Wrapf(err, "failed to load sequence of channel %s", channelID)
+		}
+		logger.Infof("Channel %s resumes at block %d", channelID, height)
+	}
+
+	// UpdateChannelBatchSize 등으로 기록된 이후 설정 블록이 있으면 마지막 설정을 사용
+	updatedConfig, err := latestChannelConfig(filesystemPath, channelID, height)
+	if err != nil {
+		return errors.Wrapf(err, "failed to load latest config of channel %s", channelID)
+	}
+	if updatedConfig != nil {
+		channelConfig = updatedConfig
+	}
+
+	cs.Channels.Set(channelID, &configtx.ChannelConfig{
+		CC:  channelConfig.CC,
+		SCC: channelConfig.SCC,
+	})
+	logger.Infof("✅ Replayed channel config for: %s", channelID)
+	return nil
+}
+
+// latestChannelConfig 블록 1부터 height-1 사이의 마지막 설정 블록에 담긴 채널 설정 (없으면 nil)
+func latestChannelConfig(filesystemPath, channelID string, height uint64) (*configtx.ChannelConfig, error) {
+	for number := height - 1; number >= 1 && number < height; number-- {
+		block, err := blockutil.LoadBlock(fmt.Sprintf("%s/%s/blockfile%d", filesystemPath, channelID, number))
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to load block %d", number)
+		}
+		if block.Header.HeaderType == pb_common.BlockType_BLOCK_TYPE_CONFIG {
+			return blockutil.ExtractChannelConfigFromBlock(block)
+		}
+	}
+	return nil, nil
 }
 
 // check func (h *Handler) ProcessStream(stream ccintf.ChaincodeStream) error
+// 채널 등록과 설정 블록 저장만 cs.Mutex를 잡으므로, 열려 있는 스트림이 Drain 등을 막지 않는다.
 func (cs *ChainSupport) CreateChannel(stream pb_orderer.OrdererService_CreateChannelServer) error {
-	cs.Mutex.Lock()
-	defer cs.Mutex.Unlock()
-
 	for {
 		msg, err := stream.Recv()
 		if err == io.EOF {
@@ -73,6 +186,10 @@ func (cs *ChainSupport) CreateChannel(stream pb_orderer.OrdererService_CreateCha
 			logger.Errorf("[Orderer] Failed to receive message: %v", err)
 			return err
 		}
+		if err := cs.ValidateEnvelopeSize(msg); err != nil {
+			cs.sendErrorResponse(stream, pb_common.Status_REQUEST_ENTITY_TOO_LARGE, fmt.Sprintf("Envelope rejected: %v", err))
+			return err
+		}
 		if err := cs.VerifyChannelCreationEnvelope(msg); err != nil {
 			cs.sendErrorResponse(stream, pb_common.Status_INVALID_SIGNATURE, fmt.Sprintf("Envelope verification failed: %v", err))
 			return err
@@ -97,50 +214,363 @@ func (cs *ChainSupport) CreateChannel(stream pb_orderer.OrdererService_CreateCha
 		}
 		logger.Infof("[Orderer] Received app config: %+v", appConfig)
 
-		if _, exists := cs.AppChannelConfigs[payload.Header.ChannelId]; exists {
-			cs.sendErrorResponse(stream, pb_common.Status_ALREADY_EXISTS, fmt.Sprintf("Channel already exists: %s", payload.Header.ChannelId))
-			return errors.New("channel already exists")
-		}
-
 		appChannelConfig := &configtx.ChannelConfig{
 			CC:  appConfig,
 			SCC: cs.SystemChannelInfo,
 		}
-		cs.AppChannelConfigs[payload.Header.ChannelId] = appChannelConfig
-
-		configDataBytes, err := json.Marshal(appChannelConfig)
+		appBlock, err := cs.registerChannel(stream, payload.Header.ChannelId, appChannelConfig)
 		if err != nil {
-			cs.sendErrorResponse(stream, pb_common.Status_INTERNAL_ERROR, fmt.Sprintf("Failed to marshal channel config data: %v", err))
 			return err
 		}
+		if err := cs.sendSuccessResponse(stream, appBlock, payload.Header.ChannelId); err != nil {
+			return err
+		}
+	}
+}
+
+// registerChannel 채널을 등록하고 설정 블록을 생성, 서명, 저장한 뒤 구독자에게 전달
+// 실패하면 stream에 에러 응답을 보낸다.
+func (cs *ChainSupport) registerChannel(stream pb_orderer.OrdererService_CreateChannelServer, channelID string, appChannelConfig *configtx.ChannelConfig) (*pb_common.Block, error) {
+	cs.Mutex.Lock()
+	defer cs.Mutex.Unlock()
+
+	if !cs.Channels.Add(channelID, appChannelConfig) {
+		cs.sendErrorResponse(stream, pb_common.Status_ALREADY_EXISTS, fmt.Sprintf("Channel already exists: %s", channelID))
+		return nil, errors.New("channel already exists")
+	}
+
+	configDataBytes, err := json.Marshal(appChannelConfig)
+	if err != nil {
+		cs.sendErrorResponse(stream, pb_common.Status_INTERNAL_ERROR, fmt.Sprintf("Failed to marshal channel config data: %v", err))
+		return nil, err
+	}
+
+	appBlock, err := blockutil.GenerateConfigBlock(configDataBytes, channelID, cs.OrdererConfig.MSP.GetSigningIdentity())
+	if err != nil {
+		cs.sendErrorResponse(stream, pb_common.Status_INTERNAL_ERROR, fmt.Sprintf("Failed to generate config block: %v", err))
+		return nil, err
+	}
+	if err := blockutil.SignBlock(appBlock, cs.OrdererConfig.MSP.GetSigningIdentity()); err != nil {
+		cs.sendErrorResponse(stream, pb_common.Status_INTERNAL_ERROR, fmt.Sprintf("Failed to sign config block: %v", err))
+		return nil, err
+	}
+
+	if err := blockutil.SaveBlockFile(appBlock, channelID, cs.OrdererConfig.FilesystemPath); err != nil {
+		cs.sendErrorResponse(stream, pb_common.Status_LEDGER_ERROR, fmt.Sprintf("Failed to save config block: %v", err))
+		return nil, err
+	}
+	if err := cs.commitSequence(channelID, appBlock.Header.Number); err != nil {
+		cs.sendErrorResponse(stream, pb_common.Status_LEDGER_ERROR, fmt.Sprintf("Failed to commit sequence: %v", err))
+		return nil, err
+	}
+	cs.publishBlock(channelID, appBlock)
+	return appBlock, nil
+}
+
+// UpdateChannelConfig 채널의 application 설정을 교체하고 변경된 채널 설정을 설정 블록으로 기록
+// 변경 사항이 없으면 설정 블록을 기록하지 않으며, 재시작 시 마지막 설정 블록에서 설정이 복원된다.
+func (cs *ChainSupport) UpdateChannelConfig(channelID string, newConfig *configtx.AppChannelConfig) error {
+	if newConfig == nil {
+		return errors.New("channel config cannot be nil")
+	}
+	// 호출자가 이후에 설정을 바꿔도 저장된 설정이 바뀌지 않도록 복사본을 검증해 사용
+	newConfig, err := newConfig.Clone()
+	if err != nil {
+		return errors.Wrapf(err, "failed to copy config update for channel %s", channelID)
+	}
+	if err := newConfig.ValidateOrganizations(); err != nil {
+		return errors.Wrapf(err, "invalid config update for channel %s", channelID)
+	}
+	if cs.Cutter == nil {
+		return errors.New("block cutter is not running")
+	}
+
+	cs.Mutex.Lock()
+	defer cs.Mutex.Unlock()
+
+	channelConfig, exists := cs.Channels.Get(channelID)
+	if !exists {
+		return errors.Errorf("channel not found: %s", channelID)
+	}
+
+	// 조직 MSP 변경은 MSP 설정 해시로만 판단하고, 나머지 필드는 Diff로 확인
+	// MSPDir만 바뀌고 CA 인증서가 같은 조직은 변경으로 보지 않아 설정 블록을 기록하지 않는다.
+	changedMSPs, err := changedOrganizationMSPs(channelConfig.CC.Organizations, newConfig.Organizations)
+	if err != nil {
+		return errors.Wrapf(err, "failed to compare MSPs of channel %s", channelID)
+	}
+	changes := withoutMSPMaterial(channelConfig.CC).Diff(withoutMSPMaterial(newConfig))
+	if len(changedMSPs) == 0 && len(changes) == 0 {
+		logger.Infof("[Orderer] Config update for channel %s has no changes", channelID)
+		return nil
+	}
+	for _, mspID := range changedMSPs {
+		logger.Infof("[Orderer] Channel %s config: MSP %s changed", channelID, mspID)
+	}
+	for _, change := range changes {
+		logger.Infof("[Orderer] Channel %s config: %s", channelID, change)
+	}
+
+	updated := &configtx.ChannelConfig{
+		CC:  newConfig,
+		SCC: channelConfig.SCC,
+	}
+	configData, err := json.Marshal(updated)
+	if err != nil {
+		return errors.Wrapf(err, "failed to marshal config of channel %s", channelID)
+	}
+	if _, err := cs.Cutter.WriteConfigBlock(channelID, configData); err != nil {
+		return errors.Wrapf(err, "failed to write config block of channel %s", channelID)
+	}
+
+	cs.Channels.Set(channelID, updated)
+	return nil
+}
 
-		appBlock, err := blockutil.GenerateConfigBlock(configDataBytes, payload.Header.ChannelId, cs.OrdererConfig.MSP.GetSigningIdentity())
+// withoutMSPMaterial 조직의 MSPDir과 root CA 인증서를 비운 설정 복사본 (MSP 변경은 changedOrganizationMSPs가 판단)
+func withoutMSPMaterial(config *configtx.AppChannelConfig) *configtx.AppChannelConfig {
+	if config == nil {
+		return nil
+	}
+	stripped := &configtx.AppChannelConfig{Policies: config.Policies}
+	for _, org := range config.Organizations {
+		org.MSPDir = ""
+		org.MSPCaCert = nil
+		stripped.Organizations = append(stripped.Organizations, org)
+	}
+	return stripped
+}
+
+// changedOrganizationMSPs 두 설정 모두에 있는 조직 중 MSP 설정 해시가 달라진 MSP ID 목록
+// 추가/삭제된 조직은 Diff에서 보고된다.
+func changedOrganizationMSPs(oldOrgs, newOrgs []configtx.Organization) ([]string, error) {
+	oldHashes := make(map[string][]byte, len(oldOrgs))
+	for _, org := range oldOrgs {
+		hash, err := organizationMSP(org).HashConfig()
 		if err != nil {
-			cs.sendErrorResponse(stream, pb_common.Status_INTERNAL_ERROR, fmt.Sprintf("Failed to generate config block: %v", err))
-			return err
+			return nil, err
 		}
+		oldHashes[org.ID] = hash
+	}
 
-		if err := blockutil.SaveBlockFile(appBlock, payload.Header.ChannelId, cs.OrdererConfig.FilesystemPath); err != nil {
-			cs.sendErrorResponse(stream, pb_common.Status_LEDGER_ERROR, fmt.Sprintf("Failed to save config block: %v", err))
-			return err
+	var changed []string
+	for _, org := range newOrgs {
+		oldHash, exists := oldHashes[org.ID]
+		if !exists {
+			continue
 		}
-		if err := cs.sendSuccessResponse(stream, appBlock, payload.Header.ChannelId); err != nil {
-			return err
+		hash, err := organizationMSP(org).HashConfig()
+		if err != nil {
+			return nil, err
+		}
+		if !bytes.Equal(oldHash, hash) {
+			changed = append(changed, org.ID)
 		}
 	}
+	return changed, nil
+}
+
+// organizationMSP 채널 설정의 조직 정보로 비교용 MSP 구성 (서명 identity 없음)
+func organizationMSP(org configtx.Organization) *msp.FabricMSP {
+	fabricMSP := &msp.FabricMSP{MSPID: org.ID}
+	if rootCert, err := x509.ParseCertificate(org.MSPCaCert); err == nil {
+		fabricMSP.RootCerts = rootCert
+	}
+	return fabricMSP
 }
 
 func (cs *ChainSupport) GetChannelInfo(channelName string) (*configtx.ChannelConfig, bool) {
-	config, exists := cs.AppChannelConfigs[channelName]
-	return config, exists
+	return cs.Channels.Get(channelName)
 }
 
+// ListChannels 채널 ID를 오름차순으로 정렬해 반환
 func (cs *ChainSupport) ListChannels() []string {
-	channels := make([]string, 0, len(cs.AppChannelConfigs))
-	for channelName := range cs.AppChannelConfigs {
-		channels = append(channels, channelName)
+	return cs.Channels.List()
+}
+
+// ListChannelsPaginated 알파벳 순으로 정렬된 채널 목록 중 offset부터 최대 limit개 반환
+// limit이 0 이하이면 offset 이후 전체를 반환하며, hasMore는 뒤에 채널이 더 남았는지 여부
+func (cs *ChainSupport) ListChannelsPaginated(offset, limit int) ([]string, bool) {
+	channels := cs.Channels.List()
+
+	if offset < 0 {
+		offset = 0
+	}
+	if offset >= len(channels) {
+		return []string{}, false
+	}
+	end := len(channels)
+	if limit > 0 && offset+limit < end {
+		end = offset + limit
+	}
+	return channels[offset:end], end < len(channels)
+}
+
+// GetChannels ListChannelsPaginated의 gRPC 핸들러
+func (cs *ChainSupport) GetChannels(ctx context.Context, req *pb_orderer.ListChannelsRequest) (*pb_orderer.ListChannelsResponse, error) {
+	channels, hasMore := cs.ListChannelsPaginated(int(req.Offset), int(req.Limit))
+	return &pb_orderer.ListChannelsResponse{
+		Status:   pb_common.Status_OK,
+		Channels: channels,
+		HasMore:  hasMore,
+	}, nil
+}
+
+// DeliverBlocks start_block부터 저장된 블록을 보낸 뒤, 새로 커밋되는 블록을 stream이 끝날 때까지 전달
+func (cs *ChainSupport) DeliverBlocks(req *pb_orderer.DeliverRequest, stream pb_orderer.OrdererService_DeliverBlocksServer) error {
+	if _, exists := cs.GetChannelInfo(req.ChannelId); !exists {
+		return stream.Send(&pb_orderer.BlockResponse{Status: pb_common.Status_CHANNEL_NOT_FOUND})
+	}
+
+	// 저장된 블록을 보내는 동안 커밋되는 블록을 놓치지 않도록 먼저 구독
+	blocks, unsubscribe := cs.Broadcaster.Subscribe(req.ChannelId)
+	defer unsubscribe()
+
+	next := req.StartBlock
+	sendStored := func(until uint64) error {
+		for ; next < until; next++ {
+			block, err := blockutil.LoadBlock(cs.blockPath(req.ChannelId, next))
+			if err != nil {
+				logger.Errorf("[Orderer] Failed to load block %d of channel %s: %v", next, req.ChannelId, err)
+				return stream.Send(&pb_orderer.BlockResponse{Status: pb_common.Status_LEDGER_ERROR})
+			}
+			if err := stream.Send(&pb_orderer.BlockResponse{Status: pb_common.Status_OK, Block: block}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	if err := sendStored(cs.channelHeight(req.ChannelId)); err != nil {
+		return err
+	}
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case block, ok := <-blocks:
+			if !ok {
+				return nil
+			}
+			number := block.Header.Number
+			if number < next {
+				continue
+			}
+			// 구독 버퍼가 넘쳐 건너뛴 블록은 저장소에서 채움
+			if err := sendStored(number); err != nil {
+				return err
+			}
+			if err := stream.Send(&pb_orderer.BlockResponse{Status: pb_common.Status_OK, Block: block}); err != nil {
+				return err
+			}
+			next = number + 1
+		}
+	}
+}
+
+// NotifyBlockReceived peer의 블록 수신 확인(ack)을 검증하여 BlockAcks에 기록
+func (cs *ChainSupport) NotifyBlockReceived(ctx context.Context, req *pb_orderer.BlockReceiptNotification) (*pb_orderer.BlockReceiptAck, error) {
+	if _, exists := cs.GetChannelInfo(req.ChannelId); !exists {
+		return &pb_orderer.BlockReceiptAck{Status: pb_common.Status_CHANNEL_NOT_FOUND}, nil
+	}
+	if req.PeerId == "" {
+		return &pb_orderer.BlockReceiptAck{Status: pb_common.Status_INVALID_ARGUMENT}, nil
+	}
+	if req.BlockNumber >= cs.channelHeight(req.ChannelId) {
+		return &pb_orderer.BlockReceiptAck{Status: pb_common.Status_NOT_FOUND}, nil
+	}
+
+	message := blockutil.BlockReceiptMessage(req.ChannelId, req.BlockNumber, req.PeerId)
+	if err := cs.verifyIdentitySignature(req.Identity, message, req.Signature); err != nil {
+		logger.Errorf("[Orderer] Block receipt verification failed: %v", err)
+		return &pb_orderer.BlockReceiptAck{Status: pb_common.Status_INVALID_SIGNATURE}, nil
+	}
+
+	if cs.BlockAcks.Add(req.ChannelId, req.BlockNumber, req.PeerId) {
+		logger.Infof("[Orderer] Block %d of channel %s acknowledged by %s", req.BlockNumber, req.ChannelId, req.PeerId)
+	}
+	return &pb_orderer.BlockReceiptAck{Status: pb_common.Status_OK}, nil
+}
+
+// GetBlockAcknowledgements 블록 수신을 확인한 peer ID 목록
+func (cs *ChainSupport) GetBlockAcknowledgements(channelID string, blockNumber uint64) []string {
+	return cs.BlockAcks.Get(channelID, blockNumber)
+}
+
+// GetChannelHeight 채널에 저장된 블록 개수(다음 블록 번호) 조회
+func (cs *ChainSupport) GetChannelHeight(ctx context.Context, req *pb_orderer.ChannelHeightRequest) (*pb_orderer.ChannelHeightResponse, error) {
+	if _, exists := cs.GetChannelInfo(req.ChannelId); !exists {
+		return &pb_orderer.ChannelHeightResponse{Status: pb_common.Status_CHANNEL_NOT_FOUND}, nil
+	}
+	return &pb_orderer.ChannelHeightResponse{
+		Status: pb_common.Status_OK,
+		Height: cs.channelHeight(req.ChannelId),
+	}, nil
+}
+
+// Echo 연결 확인용 RPC로 요청 payload를 그대로 돌려준다
+func (cs *ChainSupport) Echo(ctx context.Context, req *pb_orderer.EchoRequest) (*pb_orderer.EchoResponse, error) {
+	return &pb_orderer.EchoResponse{
+		Status:  pb_common.Status_OK,
+		Payload: req.Payload,
+	}, nil
+}
+
+// channelHeight 채널의 다음 블록 번호 (SequenceStore가 없으면 블록 파일 개수)
+func (cs *ChainSupport) channelHeight(channelID string) uint64 {
+	if cs.Sequences != nil {
+		return cs.Sequences.Height(channelID)
+	}
+	return blockutil.GetBlockHeight(channelID, cs.OrdererConfig.FilesystemPath)
+}
+
+// publishBlock 커밋된 블록을 deliver 구독자들에게 전달
+func (cs *ChainSupport) publishBlock(channelID string, block *pb_common.Block) {
+	if cs.Broadcaster != nil {
+		cs.Broadcaster.Publish(channelID, block)
+	}
+}
+
+func (cs *ChainSupport) blockPath(channelID string, blockNumber uint64) string {
+	return fmt.Sprintf("%s/%s/blockfile%d", cs.OrdererConfig.FilesystemPath, channelID, blockNumber)
+}
+
+// commitSequence 블록 저장 후 SequenceStore에 마지막 블록 번호 기록
+func (cs *ChainSupport) commitSequence(channelID string, blockNumber uint64) error {
+	if cs.Sequences == nil {
+		return nil
+	}
+	return cs.Sequences.Commit(channelID, blockNumber)
+}
+
+// DefaultAbsoluteMaxBytes 시스템 채널 설정에 AbsoluteMaxBytes가 없거나 잘못된 경우 사용하는 envelope 최대 크기
+const DefaultAbsoluteMaxBytes = 10 * 1024 * 1024
+
+// ValidateEnvelopeSize payload와 서명 크기의 합이 AbsoluteMaxBytes보다 작은지 확인
+// payload를 unmarshal하기 전에 호출해 과도하게 큰 envelope으로 메모리가 고갈되는 것을 막는다.
+func (cs *ChainSupport) ValidateEnvelopeSize(envelope *pb_common.Envelope) error {
+	if envelope == nil {
+		return errors.New("envelope is nil")
 	}
-	return channels
+	maxBytes := cs.absoluteMaxBytes()
+	if size := uint64(len(envelope.Payload)) + uint64(len(envelope.Signature)); size >= maxBytes {
+		return errors.Errorf("envelope size %d bytes must be less than AbsoluteMaxBytes %d", size, maxBytes)
+	}
+	return nil
+}
+
+// absoluteMaxBytes 시스템 채널 설정의 BatchSize.AbsoluteMaxBytes (없으면 DefaultAbsoluteMaxBytes)
+func (cs *ChainSupport) absoluteMaxBytes() uint64 {
+	scc := cs.SystemChannelInfo
+	if scc == nil || scc.Orderer.BatchSize.AbsoluteMaxBytes == "" {
+		return DefaultAbsoluteMaxBytes
+	}
+	maxBytes, err := configtx.ParseBatchSizeBytes(scc.Orderer.BatchSize.AbsoluteMaxBytes)
+	if err != nil || maxBytes == 0 {
+		logger.Warnf("[Orderer] Invalid AbsoluteMaxBytes %q, using default %d", scc.Orderer.BatchSize.AbsoluteMaxBytes, DefaultAbsoluteMaxBytes)
+		return DefaultAbsoluteMaxBytes
+	}
+	return uint64(maxBytes)
 }
 
 func (cs *ChainSupport) VerifyChannelCreationEnvelope(envelope *pb_common.Envelope) error {
@@ -151,32 +581,102 @@ func (cs *ChainSupport) VerifyChannelCreationEnvelope(envelope *pb_common.Envelo
 	if Payload.Header.Type != pb_common.MessageType_MESSAGE_TYPE_CONFIG {
 		return errors.New("invalid message type")
 	}
-	if cs.AppChannelConfigs[Payload.Header.ChannelId] != nil {
+	if _, exists := cs.Channels.Get(Payload.Header.ChannelId); exists {
 		return errors.New("channel already exists")
 	}
 
-	identity, err := blockutil.GetIdentityFromHeader(Payload.Header)
+	return cs.verifyEnvelopeCreator(envelope, Payload.Header)
+}
+
+// SubmitTransaction 트랜잭션 envelope을 검증한 뒤 채널의 PendingQueue(송신자별 FairQueue)에 추가
+func (cs *ChainSupport) SubmitTransaction(ctx context.Context, envelope *pb_common.Envelope) (*pb_orderer.BroadcastResponse, error) {
+	if cs.Cutter != nil && cs.Cutter.IsDraining() {
+		logger.Warnf("[Orderer] Rejecting transaction while shutting down")
+		return &pb_orderer.BroadcastResponse{Status: pb_common.Status_UNAVAILABLE}, nil
+	}
+	if err := blockutil.ValidateEnvelope(envelope); err != nil {
+		logger.Errorf("[Orderer] Invalid transaction envelope: %v", err)
+		return &pb_orderer.BroadcastResponse{Status: pb_common.Status_INVALID_TRANSACTION_FORMAT}, nil
+	}
+
+	payload, err := blockutil.UnmarshalPayloadFromProto(envelope.Payload)
+	if err != nil {
+		logger.Errorf("[Orderer] Failed to unmarshal payload: %v", err)
+		return &pb_orderer.BroadcastResponse{Status: pb_common.Status_INVALID_TRANSACTION_FORMAT}, nil
+	}
+	if payload.Header.Type != pb_common.MessageType_MESSAGE_TYPE_TRANSACTION {
+		logger.Errorf("[Orderer] Invalid message type: %s", payload.Header.Type)
+		return &pb_orderer.BroadcastResponse{Status: pb_common.Status_INVALID_TRANSACTION_FORMAT}, nil
+	}
+
+	channelID := payload.Header.ChannelId
+	_, exists := cs.Channels.Get(channelID)
+	if !exists {
+		logger.Errorf("[Orderer] Channel not found: %s", channelID)
+		return &pb_orderer.BroadcastResponse{Status: pb_common.Status_CHANNEL_NOT_FOUND}, nil
+	}
+
+	tx, err := blockutil.UnmarshalTransactionFromProto(payload.Data)
+	if err != nil {
+		logger.Errorf("[Orderer] Failed to unmarshal transaction: %v", err)
+		return &pb_orderer.BroadcastResponse{Status: pb_common.Status_INVALID_TRANSACTION_FORMAT}, nil
+	}
+	if err := blockutil.ValidateTransaction(tx, channelID, blockutil.MaxTimestampSkew); err != nil {
+		logger.Errorf("[Orderer] Invalid transaction: %v", err)
+		return &pb_orderer.BroadcastResponse{Status: pb_common.Status_INVALID_TRANSACTION_FORMAT}, nil
+	}
+
+	if err := cs.verifyEnvelopeCreator(envelope, payload.Header); err != nil {
+		logger.Errorf("[Orderer] Transaction verification failed: %v", err)
+		return &pb_orderer.BroadcastResponse{Status: pb_common.Status_INVALID_SIGNATURE}, nil
+	}
+
+	enqueue := func() {
+		cs.PendingQueue.Enqueue(channelID, payload.Header.Identity.Creator, envelope)
+	}
+	// Drain의 마지막 cut 이후에 큐에 들어가 누락되지 않도록 draining 확인과 enqueue를 함께 수행
+	if cs.Cutter == nil {
+		enqueue()
+	} else if !cs.Cutter.Accept(enqueue) {
+		logger.Warnf("[Orderer] Rejecting transaction while shutting down")
+		return &pb_orderer.BroadcastResponse{Status: pb_common.Status_UNAVAILABLE}, nil
+	}
+	logger.Infof("[Orderer] Transaction enqueued for channel %s (pending: %d)", channelID, cs.PendingQueue.Len(channelID))
+	if cs.Cutter != nil {
+		cs.Cutter.Notify()
+	}
+
+	return &pb_orderer.BroadcastResponse{Status: pb_common.Status_OK}, nil
+}
+
+// verifyEnvelopeCreator envelope 서명과 생성자의 consortium MSP를 검증
+func (cs *ChainSupport) verifyEnvelopeCreator(envelope *pb_common.Envelope, header *pb_common.Header) error {
+	identity, err := blockutil.GetIdentityFromHeader(header)
 	if err != nil {
 		return errors.Wrap(err, "failed to get identity from header")
 	}
+	return cs.verifyIdentitySignature(identity, envelope.Payload, envelope.Signature)
+}
+
+// verifyIdentitySignature message에 대한 identity의 서명과 consortium MSP를 검증
+func (cs *ChainSupport) verifyIdentitySignature(identity *pb_common.Identity, message, signature []byte) error {
+	if identity == nil {
+		return errors.New("identity is empty")
+	}
 	creatorCert, err := x509.ParseCertificate(identity.Creator)
 	if err != nil {
 		logger.Error("failed to parse certificate from identity")
 		return errors.Wrap(err, "failed to parse certificate from identity")
 	}
 
-	// #1 : verify sender(client) signature
-	ok, err := cert.VerifySignature(creatorCert.PublicKey, envelope.Payload, envelope.Signature)
-	if err != nil {
+	// #1 : verify sender(client) signature (MSP 검증 전에 인증서 공개키만으로 빠르게 확인)
+	if err := msp.NewIdentity(creatorCert, creatorCert.PublicKey, identity.MspId).Verify(message, signature); err != nil {
 		return errors.Wrap(err, "failed to verify signature")
 	}
-	if !ok {
-		return errors.New("failed to verify signature")
-	}
-	logger.Infof("[Orderer] Signature verified: %v", ok)
+	logger.Infof("[Orderer] Signature verified")
 
 	// #2 : verify certificate chain & MSPID in consortiums
-	ok, err = cs.VerifyConsortiumMSP(creatorCert, identity.MspId)
+	ok, err := cs.VerifyConsortiumMSP(creatorCert, identity.MspId)
 	if err != nil {
 		return errors.Wrap(err, "failed to verify rootCACerts")
 	}
@@ -187,23 +687,38 @@ func (cs *ChainSupport) VerifyChannelCreationEnvelope(envelope *pb_common.Envelo
 }
 
 func (cs *ChainSupport) VerifyConsortiumMSP(creatorCert *x509.Certificate, mspId string) (bool, error) {
-	scc := cs.GetSystemChannelConfig()
+	scc := cs.SystemChannelInfo
 	if scc == nil {
 		return false, errors.New("system channel config is not loaded")
 	}
 
+	// consortium 조직이 아니면 인증서 검증 전에 거부
+	if cs.MSPManager != nil {
+		if _, err := cs.MSPManager.GetMSP(mspId); err != nil {
+			return false, nil
+		}
+	}
+
 	for _, consortium := range scc.Consortiums {
 		if consortium.ID == mspId {
 			logger.Infof("[Orderer] MSPID verified: %s", mspId)
-			consortiumCert, err := x509.ParseCertificate(consortium.MSPCaCert)
-			if err != nil {
-				return false, errors.Wrap(err, "failed to parse certificate")
+			// MSPCaCerts가 없는 이전 설정은 MSPCaCert 하나로 검증
+			caCerts := consortium.MSPCaCerts
+			if len(caCerts) == 0 {
+				caCerts = [][]byte{consortium.MSPCaCert}
 			}
-			if err := creatorCert.CheckSignatureFrom(consortiumCert); err != nil {
-				return false, errors.Wrap(err, "failed to verify certificate chain")
+			var verifyErr error
+			for _, caCertBytes := range caCerts {
+				consortiumCert, err := x509.ParseCertificate(caCertBytes)
+				if err != nil {
+					return false, errors.Wrap(err, "failed to parse certificate")
+				}
+				if verifyErr = creatorCert.CheckSignatureFrom(consortiumCert); verifyErr == nil {
+					logger.Infof("[Orderer] Certificate chain verified")
+					return true, nil
+				}
 			}
-			logger.Infof("[Orderer] Certificate chain verified")
-			return true, nil
+			return false, errors.Wrap(verifyErr, "failed to verify certificate chain")
 		}
 	}
 	return false, nil
@@ -0,0 +1,279 @@
+package channel
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/ddr4869/minifab/common/blockutil"
+	"github.com/ddr4869/minifab/common/configtx"
+	"github.com/ddr4869/minifab/common/msp/msptest"
+	pb_common "github.com/ddr4869/minifab/proto/common"
+	pb_orderer "github.com/ddr4869/minifab/proto/orderer"
+)
+
+func TestListChannelsPaginated(t *testing.T) {
+	n := newTestNetwork(t)
+	var want []string
+	for i := 0; i < 50; i++ {
+		channelID := fmt.Sprintf("channel%02d", i)
+		n.cs.Channels.Add(channelID, &configtx.ChannelConfig{CC: &configtx.AppChannelConfig{}})
+		want = append(want, channelID)
+	}
+
+	seen := make(map[string]bool)
+	var got []string
+	for page := 0; page < 5; page++ {
+		channels, hasMore := n.cs.ListChannelsPaginated(page*10, 10)
+		if len(channels) != 10 {
+			t.Fatalf("page %d: got %d channels, want 10", page, len(channels))
+		}
+		if hasMore != (page < 4) {
+			t.Errorf("page %d: hasMore = %v", page, hasMore)
+		}
+		for _, channelID := range channels {
+			if seen[channelID] {
+				t.Errorf("page %d: duplicate channel %s", page, channelID)
+			}
+			seen[channelID] = true
+		}
+		got = append(got, channels...)
+	}
+	if !sort.StringsAreSorted(got) {
+		t.Errorf("channels are not in alphabetical order: %v", got)
+	}
+	if fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("channels = %v, want %v", got, want)
+	}
+
+	if channels, hasMore := n.cs.ListChannelsPaginated(50, 10); len(channels) != 0 || hasMore {
+		t.Errorf("past the end: got %v, hasMore %v", channels, hasMore)
+	}
+	if channels, hasMore := n.cs.ListChannelsPaginated(45, 0); len(channels) != 5 || hasMore {
+		t.Errorf("limit 0: got %d channels, hasMore %v", len(channels), hasMore)
+	}
+
+	resp, err := n.cs.GetChannels(context.Background(), &pb_orderer.ListChannelsRequest{Offset: 40, Limit: 20})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Channels) != 10 || resp.HasMore {
+		t.Errorf("GetChannels: got %d channels, hasMore %v", len(resp.Channels), resp.HasMore)
+	}
+}
+
+func TestReplayChannelRestoresCorruptedConfig(t *testing.T) {
+	n := newTestNetwork(t)
+	n.createChannel(t, "mychannel")
+
+	// 메모리 상 채널 설정을 잃어버리거나 깨진 상태
+	n.cs.Channels.Remove("mychannel")
+	if _, exists := n.cs.GetChannelInfo("mychannel"); exists {
+		t.Fatal("channel is still registered after removal")
+	}
+
+	if err := n.cs.ReplayChannel("mychannel"); err != nil {
+		t.Fatalf("ReplayChannel: %v", err)
+	}
+	channelConfig, exists := n.cs.Channels.Get("mychannel")
+	if !exists {
+		t.Fatal("channel is not registered after ReplayChannel")
+	}
+	if orgs := channelConfig.CC.Organizations; len(orgs) != 1 || orgs[0].ID != "Org1MSP" {
+		t.Errorf("replayed organizations = %+v, want Org1MSP", orgs)
+	}
+
+	n.cs.Channels.Set("mychannel", &configtx.ChannelConfig{CC: &configtx.AppChannelConfig{}})
+	if err := n.cs.ReplayChannel("mychannel"); err != nil {
+		t.Fatalf("ReplayChannel: %v", err)
+	}
+	if channelConfig, _ := n.cs.Channels.Get("mychannel"); len(channelConfig.CC.Organizations) != 1 {
+		t.Errorf("ReplayChannel did not overwrite the corrupted config: %+v", channelConfig.CC)
+	}
+
+	if err := n.cs.ReplayChannel("nochannel"); err == nil {
+		t.Error("ReplayChannel succeeded for a channel without blocks")
+	}
+}
+
+func TestLoadExistingChannelsSkipsBrokenChannel(t *testing.T) {
+	n := newTestNetwork(t)
+	n.createChannel(t, "goodchannel")
+	brokenDir := filepath.Join(n.cs.OrdererConfig.FilesystemPath, "brokenchannel")
+	if err := os.MkdirAll(brokenDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(brokenDir, "blockfile0"), []byte("corrupted"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	restarted := newTestNetwork(t)
+	restarted.cs.Sequences = NewSequenceStore(n.cs.OrdererConfig.FilesystemPath)
+	restarted.cs.LoadExistingChannels(n.cs.OrdererConfig.FilesystemPath, RetryOptions{})
+
+	if _, exists := restarted.cs.Channels.Get("goodchannel"); !exists {
+		t.Error("goodchannel was not loaded")
+	}
+	if _, exists := restarted.cs.Channels.Get("brokenchannel"); exists {
+		t.Error("brokenchannel was loaded from a corrupted block")
+	}
+}
+
+func TestLoadExistingChannelsRetriesUnavailableBlock(t *testing.T) {
+	n := newTestNetwork(t)
+	n.createChannel(t, "mychannel")
+	blockPath := filepath.Join(n.cs.OrdererConfig.FilesystemPath, "mychannel", "blockfile0")
+	hiddenPath := blockPath + ".unavailable"
+	if err := os.Rename(blockPath, hiddenPath); err != nil {
+		t.Fatal(err)
+	}
+
+	// 첫 시도가 실패한 뒤, 첫 재시도 전에 블록 파일이 다시 나타난다
+	restored := make(chan error, 1)
+	time.AfterFunc(50*time.Millisecond, func() { restored <- os.Rename(hiddenPath, blockPath) })
+
+	restarted := newTestNetwork(t)
+	restarted.cs.Sequences = NewSequenceStore(n.cs.OrdererConfig.FilesystemPath)
+	restarted.cs.LoadExistingChannels(n.cs.OrdererConfig.FilesystemPath, RetryOptions{MaxRetries: 3, Delay: 200 * time.Millisecond})
+
+	if err := <-restored; err != nil {
+		t.Fatal(err)
+	}
+	if _, exists := restarted.cs.Channels.Get("mychannel"); !exists {
+		t.Fatal("mychannel was not loaded after its block file became available")
+	}
+}
+
+func TestLoadExistingChannelsGivesUpAfterMaxRetries(t *testing.T) {
+	n := newTestNetwork(t)
+	n.createChannel(t, "mychannel")
+	if err := os.Remove(filepath.Join(n.cs.OrdererConfig.FilesystemPath, "mychannel", "blockfile0")); err != nil {
+		t.Fatal(err)
+	}
+
+	restarted := newTestNetwork(t)
+	restarted.cs.Sequences = NewSequenceStore(n.cs.OrdererConfig.FilesystemPath)
+	retry := RetryOptions{MaxRetries: 2, Delay: 20 * time.Millisecond}
+	start := time.Now()
+	restarted.cs.LoadExistingChannels(n.cs.OrdererConfig.FilesystemPath, retry)
+
+	if elapsed := time.Since(start); elapsed < time.Duration(retry.MaxRetries)*retry.Delay {
+		t.Errorf("LoadExistingChannels returned after %s, want at least %d retries of %s", elapsed, retry.MaxRetries, retry.Delay)
+	}
+	if _, exists := restarted.cs.Channels.Get("mychannel"); exists {
+		t.Error("mychannel was loaded without a block file")
+	}
+}
+
+func TestUpdateChannelConfigWritesConfigBlock(t *testing.T) {
+	n := newTestNetwork(t)
+	n.createChannel(t, "mychannel")
+
+	org1 := configtx.Organization{Name: "Org1", ID: "Org1MSP", MSPCaCert: n.peerOrg.CACert.Raw}
+	org2 := msptest.NewOrg(t, "Org2MSP")
+	updated := &configtx.AppChannelConfig{Organizations: []configtx.Organization{org1, {Name: "Org2", ID: "Org2MSP", MSPCaCert: org2.CACert.Raw}}}
+	if err := n.cs.UpdateChannelConfig("mychannel", updated); err != nil {
+		t.Fatalf("UpdateChannelConfig: %v", err)
+	}
+	if height := n.cs.channelHeight("mychannel"); height != 2 {
+		t.Fatalf("height = %d, want 2", height)
+	}
+	block := n.loadBlock(t, "mychannel", 1)
+	if block.Header.HeaderType != pb_common.BlockType_BLOCK_TYPE_CONFIG {
+		t.Fatalf("block 1 is %s, want the config update block", block.Header.HeaderType)
+	}
+	written, err := blockutil.ExtractChannelConfigFromBlock(block)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(written.CC.Organizations) != 2 || written.CC.Organizations[1].ID != "Org2MSP" {
+		t.Fatalf("config block organizations = %+v, want Org1MSP and Org2MSP", written.CC.Organizations)
+	}
+
+	// 재시작하면 마지막 설정 블록에서 변경된 설정을 복원한다
+	restarted := n.restart(t)
+	restored, exists := restarted.cs.Channels.Get("mychannel")
+	if !exists || len(restored.CC.Organizations) != 2 {
+		t.Fatalf("channel config after restart = %+v, want two organizations", restored)
+	}
+}
+
+func TestUpdateChannelConfigSkipsUnchangedMSPs(t *testing.T) {
+	n := newTestNetwork(t)
+	n.createChannel(t, "mychannel")
+	before, _ := n.cs.Channels.Get("mychannel")
+
+	// MSPDir만 다르고 CA 인증서가 같으면 MSP 설정 해시가 같으므로 변경이 아니다
+	unchanged := &configtx.AppChannelConfig{
+		Organizations: []configtx.Organization{{Name: "Org1", ID: "Org1MSP", MSPDir: "/elsewhere/msp", MSPCaCert: n.peerOrg.CACert.Raw}},
+	}
+	if err := n.cs.UpdateChannelConfig("mychannel", unchanged); err != nil {
+		t.Fatalf("UpdateChannelConfig: %v", err)
+	}
+	if after, _ := n.cs.Channels.Get("mychannel"); after != before {
+		t.Fatal("no-op config update replaced the channel config")
+	}
+	if height := n.cs.channelHeight("mychannel"); height != 1 {
+		t.Fatalf("height after no-op update = %d, want 1 (no config block)", height)
+	}
+
+	// 같은 MSP ID에 다른 CA 인증서를 쓰면 MSP 변경으로 취급
+	newCA := msptest.NewOrg(t, "Org1MSP")
+	changed := &configtx.AppChannelConfig{
+		Organizations: []configtx.Organization{{Name: "Org1", ID: "Org1MSP", MSPCaCert: newCA.CACert.Raw}},
+	}
+	changedMSPs, err := changedOrganizationMSPs(before.CC.Organizations, changed.Organizations)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(changedMSPs) != 1 || changedMSPs[0] != "Org1MSP" {
+		t.Fatalf("changedOrganizationMSPs = %v, want [Org1MSP]", changedMSPs)
+	}
+	if err := n.cs.UpdateChannelConfig("mychannel", changed); err != nil {
+		t.Fatalf("UpdateChannelConfig: %v", err)
+	}
+	after, _ := n.cs.Channels.Get("mychannel")
+	if after == before || !bytes.Equal(after.CC.Organizations[0].MSPCaCert, newCA.CACert.Raw) {
+		t.Fatal("config update with a new CA cert was not applied")
+	}
+	if height := n.cs.channelHeight("mychannel"); height != 2 {
+		t.Fatalf("height after MSP update = %d, want 2", height)
+	}
+}
+
+func TestUpdateChannelConfigRejectsDuplicateOrganizations(t *testing.T) {
+	n := newTestNetwork(t)
+	n.createChannel(t, "mychannel")
+	before, _ := n.cs.Channels.Get("mychannel")
+
+	org1 := configtx.Organization{Name: "Org1", ID: "Org1MSP", MSPCaCert: n.peerOrg.CACert.Raw}
+	duplicate := &configtx.AppChannelConfig{Organizations: []configtx.Organization{org1, org1}}
+	if err := n.cs.UpdateChannelConfig("mychannel", duplicate); err == nil {
+		t.Fatal("UpdateChannelConfig accepted an update listing Org1 twice")
+	}
+	if after, _ := n.cs.Channels.Get("mychannel"); after != before {
+		t.Fatal("rejected config update replaced the channel config")
+	}
+
+	org2 := msptest.NewOrg(t, "Org2MSP")
+	valid := &configtx.AppChannelConfig{Organizations: []configtx.Organization{org1, {Name: "Org2", ID: "Org2MSP", MSPCaCert: org2.CACert.Raw}}}
+	if err := n.cs.UpdateChannelConfig("mychannel", valid); err != nil {
+		t.Fatalf("UpdateChannelConfig: %v", err)
+	}
+	after, _ := n.cs.Channels.Get("mychannel")
+	if len(after.CC.Organizations) != 2 || after.CC.Organizations[1].ID != "Org2MSP" {
+		t.Errorf("organizations after update = %+v, want Org1MSP and Org2MSP", after.CC.Organizations)
+	}
+
+	// 저장된 설정은 호출자의 설정과 메모리를 공유하지 않는다
+	want := bytes.Clone(valid.Organizations[1].MSPCaCert)
+	valid.Organizations[1].MSPCaCert[0] ^= 0xff
+	if after, _ := n.cs.Channels.Get("mychannel"); !bytes.Equal(after.CC.Organizations[1].MSPCaCert, want) {
+		t.Error("changing the caller's CA cert bytes changed the stored config")
+	}
+}
@@ -1 +1,81 @@
 package channel
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ddr4869/minifab/common/logger"
+	pb_orderer "github.com/ddr4869/minifab/proto/orderer"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+var ordererAddress string
+
+// Cmd returns the orderer channel command with all subcommands
+func Cmd() *cobra.Command {
+	channelCmd := &cobra.Command{
+		Use:   "channel",
+		Short: "실행 중인 orderer의 채널 정보를 조회합니다",
+		Long:  `실행 중인 orderer에 접속하여 채널 목록 등 채널 관련 정보를 조회합니다.`,
+	}
+
+	channelCmd.PersistentFlags().StringVar(&ordererAddress, "orderer", "localhost:7050", "Orderer server address")
+
+	channelCmd.AddCommand(channelListCmd())
+	channelCmd.AddCommand(channelDescribeCmd())
+
+	return channelCmd
+}
+
+func channelListCmd() *cobra.Command {
+	var pageSize uint32
+	var page uint64
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "orderer가 관리하는 채널 목록을 조회합니다",
+		Long:  `orderer가 관리하는 채널 목록을 알파벳 순으로 페이지 단위로 조회합니다.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if page == 0 {
+				return errors.New("page must be 1 or greater")
+			}
+			return listChannels(ordererAddress, (page-1)*uint64(pageSize), pageSize)
+		},
+	}
+
+	cmd.Flags().Uint32Var(&pageSize, "page-size", 0, "Number of channels per page (0 lists all channels)")
+	cmd.Flags().Uint64Var(&page, "page", 1, "Page number to list, starting from 1")
+
+	return cmd
+}
+
+func listChannels(address string, offset uint64, limit uint32) error {
+	conn, err := grpc.NewClient(address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return errors.Wrap(err, "failed to connect to orderer")
+	}
+	defer conn.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	resp, err := pb_orderer.NewOrdererServiceClient(conn).GetChannels(ctx, &pb_orderer.ListChannelsRequest{
+		Offset: offset,
+		Limit:  limit,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to list channels")
+	}
+
+	for _, channelName := range resp.Channels {
+		fmt.Println(channelName)
+	}
+	if resp.HasMore {
+		logger.Infof("More channels available, use --page to see the next page")
+	}
+	return nil
+}
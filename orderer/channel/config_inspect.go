@@ -0,0 +1,112 @@
+package channel
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/ddr4869/minifab/common/logger"
+	pb_common "github.com/ddr4869/minifab/proto/common"
+	pb_orderer "github.com/ddr4869/minifab/proto/orderer"
+	"github.com/pkg/errors"
+)
+
+const (
+	// ChannelConfigPath admin 서버의 채널 설정 조회 endpoint
+	ChannelConfigPath = "GET /channels/{id}/config"
+	// SystemConfigPath admin 서버의 시스템 채널 설정 조회 endpoint
+	SystemConfigPath = "GET /system/config"
+)
+
+// ErrConfigNotFound 조회한 채널/시스템 채널 설정이 없음
+var ErrConfigNotFound = errors.New("config not found")
+
+// GetChannelConfigBytes 채널 설정을 들여쓰기된 JSON으로 반환
+func (cs *ChainSupport) GetChannelConfigBytes(channelID string) ([]byte, error) {
+	channelConfig, exists := cs.Channels.Get(channelID)
+	if !exists {
+		return nil, errors.Wrapf(ErrConfigNotFound, "channel %s", channelID)
+	}
+	data, err := json.MarshalIndent(channelConfig, "", "  ")
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to marshal config of channel %s", channelID)
+	}
+	return data, nil
+}
+
+// GetOrdererConfigBytes 시스템 채널 설정을 들여쓰기된 JSON으로 반환
+func (cs *ChainSupport) GetOrdererConfigBytes() ([]byte, error) {
+	cs.Mutex.RLock()
+	defer cs.Mutex.RUnlock()
+
+	if cs.SystemChannelInfo == nil {
+		return nil, errors.Wrap(ErrConfigNotFound, "system channel")
+	}
+	data, err := json.MarshalIndent(cs.SystemChannelInfo, "", "  ")
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal system channel config")
+	}
+	return data, nil
+}
+
+// GetChannelConfig GetChannelConfigBytes의 gRPC 핸들러
+func (cs *ChainSupport) GetChannelConfig(ctx context.Context, req *pb_orderer.ChannelConfigRequest) (*pb_orderer.ChannelConfigResponse, error) {
+	data, err := cs.GetChannelConfigBytes(req.ChannelId)
+	if errors.Is(err, ErrConfigNotFound) {
+		return &pb_orderer.ChannelConfigResponse{Status: pb_common.Status_CHANNEL_NOT_FOUND}, nil
+	}
+	if err != nil {
+		logger.Errorf("[Orderer] Failed to get config of channel %s: %v", req.ChannelId, err)
+		return &pb_orderer.ChannelConfigResponse{Status: pb_common.Status_INTERNAL_ERROR}, nil
+	}
+	return &pb_orderer.ChannelConfigResponse{
+		Status: pb_common.Status_OK,
+		Config: data,
+	}, nil
+}
+
+// GetSystemChannelConfig GetOrdererConfigBytes의 gRPC 핸들러
+func (cs *ChainSupport) GetSystemChannelConfig(ctx context.Context, req *pb_orderer.SystemChannelRequest) (*pb_orderer.SystemChannelResponse, error) {
+	data, err := cs.GetOrdererConfigBytes()
+	if errors.Is(err, ErrConfigNotFound) {
+		return &pb_orderer.SystemChannelResponse{Status: pb_common.Status_NOT_FOUND}, nil
+	}
+	if err != nil {
+		logger.Errorf("[Orderer] Failed to get system channel config: %v", err)
+		return &pb_orderer.SystemChannelResponse{Status: pb_common.Status_INTERNAL_ERROR}, nil
+	}
+	return &pb_orderer.SystemChannelResponse{
+		Status: pb_common.Status_OK,
+		Config: data,
+	}, nil
+}
+
+// ChannelConfigHandler admin 서버의 ChannelConfigPath 핸들러
+func (cs *ChainSupport) ChannelConfigHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		writeConfig(w, func() ([]byte, error) {
+			return cs.GetChannelConfigBytes(r.PathValue("id"))
+		})
+	})
+}
+
+// SystemConfigHandler admin 서버의 SystemConfigPath 핸들러
+func (cs *ChainSupport) SystemConfigHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		writeConfig(w, cs.GetOrdererConfigBytes)
+	})
+}
+
+func writeConfig(w http.ResponseWriter, getConfig func() ([]byte, error)) {
+	data, err := getConfig()
+	if errors.Is(err, ErrConfigNotFound) {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(data)
+}
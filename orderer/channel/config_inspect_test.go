@@ -0,0 +1,129 @@
+package channel
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/ddr4869/minifab/common/configtx"
+	"github.com/ddr4869/minifab/peer/common"
+	pb_common "github.com/ddr4869/minifab/proto/common"
+	pb_orderer "github.com/ddr4869/minifab/proto/orderer"
+)
+
+// serveConfig admin 서버와 같은 경로로 등록한 설정 조회 핸들러에 GET 요청
+func (n *testNetwork) serveConfig(t *testing.T, path string) *httptest.ResponseRecorder {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	mux.Handle(ChannelConfigPath, n.cs.ChannelConfigHandler())
+	mux.Handle(SystemConfigPath, n.cs.SystemConfigHandler())
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+	return rec
+}
+
+func TestChannelConfigHandler(t *testing.T) {
+	n := newTestNetwork(t)
+	channelConfig := n.createChannel(t, "mychannel")
+
+	rec := n.serveConfig(t, "/channels/mychannel/config")
+	if rec.Code != http.StatusOK || rec.Header().Get("Content-Type") != "application/json" {
+		t.Fatalf("GET /channels/mychannel/config = %d %s", rec.Code, rec.Header().Get("Content-Type"))
+	}
+	var got configtx.ChannelConfig
+	if err := json.Unmarshal(rec.Body.Bytes(), &got); err != nil {
+		t.Fatalf("response is not a ChannelConfig: %v", err)
+	}
+	if !reflect.DeepEqual(&got, channelConfig) {
+		t.Errorf("channel config = %+v, want %+v", got, channelConfig)
+	}
+
+	if rec := n.serveConfig(t, "/channels/nochannel/config"); rec.Code != http.StatusNotFound {
+		t.Errorf("GET /channels/nochannel/config = %d, want 404", rec.Code)
+	}
+}
+
+func TestSystemConfigHandler(t *testing.T) {
+	n := newTestNetwork(t)
+
+	rec := n.serveConfig(t, "/system/config")
+	if rec.Code != http.StatusOK {
+		t.Fatalf("GET /system/config = %d", rec.Code)
+	}
+	var got configtx.SystemChannelInfo
+	if err := json.Unmarshal(rec.Body.Bytes(), &got); err != nil {
+		t.Fatalf("response is not a SystemChannelInfo: %v", err)
+	}
+	if !reflect.DeepEqual(&got, n.cs.SystemChannelInfo) {
+		t.Errorf("system config = %+v, want %+v", got, n.cs.SystemChannelInfo)
+	}
+
+	n.cs.SystemChannelInfo = nil
+	if rec := n.serveConfig(t, "/system/config"); rec.Code != http.StatusNotFound {
+		t.Errorf("GET /system/config without system channel = %d, want 404", rec.Code)
+	}
+}
+
+func TestGetChannelConfigStatus(t *testing.T) {
+	n := newTestNetwork(t)
+	n.createChannel(t, "mychannel")
+
+	response, err := n.cs.GetChannelConfig(context.Background(), &pb_orderer.ChannelConfigRequest{ChannelId: "mychannel"})
+	if err != nil || response.Status != pb_common.Status_OK || len(response.Config) == 0 {
+		t.Fatalf("GetChannelConfig = %v, %v", response, err)
+	}
+	response, err = n.cs.GetChannelConfig(context.Background(), &pb_orderer.ChannelConfigRequest{ChannelId: "nochannel"})
+	if err != nil || response.Status != pb_common.Status_CHANNEL_NOT_FOUND {
+		t.Errorf("GetChannelConfig(nochannel) = %v, %v, want CHANNEL_NOT_FOUND", response, err)
+	}
+
+	n.cs.SystemChannelInfo = nil
+	systemResponse, err := n.cs.GetSystemChannelConfig(context.Background(), &pb_orderer.SystemChannelRequest{})
+	if err != nil || systemResponse.Status != pb_common.Status_NOT_FOUND {
+		t.Errorf("GetSystemChannelConfig without system channel = %v, %v, want NOT_FOUND", systemResponse, err)
+	}
+}
+
+func TestGetSystemChannelInfoOverGRPC(t *testing.T) {
+	n := newTestNetwork(t)
+	n.cs.SystemChannelInfo.Consortiums = []configtx.Organization{
+		{Name: "Org1", ID: "Org1MSP", MSPCaCert: n.peerOrg.CACert.Raw},
+		{Name: "Org2", ID: "Org2MSP"},
+	}
+	n.cs.SystemChannelInfo.Orderer.Organization.OrdererEndpoints = []string{"orderer0:7050", "orderer1:7050"}
+
+	client, err := common.NewOrdererClient(n.serve(t))
+	if err != nil {
+		t.Fatalf("NewOrdererClient: %v", err)
+	}
+	t.Cleanup(func() { client.Close() })
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	info, err := client.GetSystemChannelInfo(ctx)
+	if err != nil {
+		t.Fatalf("GetSystemChannelInfo: %v", err)
+	}
+	var names []string
+	for _, org := range info.Consortiums {
+		names = append(names, org.Name)
+	}
+	if !reflect.DeepEqual(names, []string{"Org1", "Org2"}) {
+		t.Errorf("consortium names = %v, want [Org1 Org2]", names)
+	}
+	if got := info.Orderer.Organization.OrdererEndpoints; !reflect.DeepEqual(got, []string{"orderer0:7050", "orderer1:7050"}) {
+		t.Errorf("orderer endpoints = %v, want [orderer0:7050 orderer1:7050]", got)
+	}
+
+	n.cs.Mutex.Lock()
+	n.cs.SystemChannelInfo = nil
+	n.cs.Mutex.Unlock()
+	if _, err := client.GetSystemChannelInfo(ctx); err == nil {
+		t.Error("GetSystemChannelInfo succeeded without a system channel config")
+	}
+}
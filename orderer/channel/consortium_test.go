@@ -0,0 +1,74 @@
+package channel
+
+import (
+	"testing"
+
+	"github.com/ddr4869/minifab/common/configtx"
+	"github.com/ddr4869/minifab/common/msp/msptest"
+)
+
+func TestVerifyConsortiumMSPWithIntermediateCA(t *testing.T) {
+	n := newTestNetwork(t)
+	intermediate := n.peerOrg.NewIntermediate(t, "Org1MSP-ica")
+	n.cs.SystemChannelInfo.Consortiums = []configtx.Organization{{
+		Name:       "Org1",
+		ID:         "Org1MSP",
+		MSPCaCert:  n.peerOrg.CACert.Raw,
+		MSPCaCerts: [][]byte{n.peerOrg.CACert.Raw, intermediate.CACert.Raw},
+	}}
+
+	for name, org := range map[string]*msptest.Org{"root": n.peerOrg, "intermediate": intermediate} {
+		if ok, err := n.cs.VerifyConsortiumMSP(org.SignCert, "Org1MSP"); !ok || err != nil {
+			t.Errorf("cert issued by the %s CA: ok=%v err=%v, want accepted", name, ok, err)
+		}
+	}
+
+	other := msptest.NewOrg(t, "Org1MSP")
+	if ok, err := n.cs.VerifyConsortiumMSP(other.SignCert, "Org1MSP"); ok || err == nil {
+		t.Errorf("cert from an unrelated CA: ok=%v err=%v, want rejected", ok, err)
+	}
+
+	// MSPCaCerts가 없는 이전 설정은 MSPCaCert만 신뢰한다
+	n.cs.SystemChannelInfo.Consortiums[0].MSPCaCerts = nil
+	if ok, err := n.cs.VerifyConsortiumMSP(n.peerOrg.SignCert, "Org1MSP"); !ok || err != nil {
+		t.Errorf("cert issued by MSPCaCert: ok=%v err=%v, want accepted", ok, err)
+	}
+	if ok, _ := n.cs.VerifyConsortiumMSP(intermediate.SignCert, "Org1MSP"); ok {
+		t.Error("cert issued by the intermediate CA was accepted without MSPCaCerts")
+	}
+
+	if ok, err := n.cs.VerifyConsortiumMSP(n.peerOrg.SignCert, "Org9MSP"); ok || err != nil {
+		t.Errorf("non-consortium MSP: ok=%v err=%v, want rejected without error", ok, err)
+	}
+}
+
+func TestConsortiumMSPManager(t *testing.T) {
+	n := newTestNetwork(t)
+	otherOrg := msptest.NewOrg(t, "Org2MSP")
+	scc := &configtx.SystemChannelInfo{Consortiums: []configtx.Organization{
+		{Name: "Org1", ID: "Org1MSP", MSPCaCert: n.peerOrg.CACert.Raw},
+		{Name: "Org2", ID: "Org2MSP", MSPCaCert: otherOrg.CACert.Raw},
+	}}
+
+	manager := consortiumMSPManager(scc)
+	if got := manager.GetMSPIDs(); len(got) != 2 || got[0] != "Org1MSP" || got[1] != "Org2MSP" {
+		t.Fatalf("GetMSPIDs() = %v, want [Org1MSP Org2MSP]", got)
+	}
+	orgMSP, err := manager.GetMSP("Org2MSP")
+	if err != nil {
+		t.Fatalf("GetMSP: %v", err)
+	}
+	if !orgMSP.GetRootCertificates().Equal(otherOrg.CACert) {
+		t.Error("Org2MSP was built with another organization's CA certificate")
+	}
+
+	// MSPManager에 없는 조직은 consortium 설정에 있더라도 거부
+	n.cs.SystemChannelInfo = scc
+	n.cs.MSPManager = consortiumMSPManager(&configtx.SystemChannelInfo{Consortiums: scc.Consortiums[:1]})
+	if ok, err := n.cs.VerifyConsortiumMSP(n.peerOrg.SignCert, "Org1MSP"); !ok || err != nil {
+		t.Errorf("Org1MSP member: ok=%v err=%v, want accepted", ok, err)
+	}
+	if ok, err := n.cs.VerifyConsortiumMSP(otherOrg.SignCert, "Org2MSP"); ok || err != nil {
+		t.Errorf("MSP missing from MSPManager: ok=%v err=%v, want rejected without error", ok, err)
+	}
+}
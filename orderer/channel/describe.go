@@ -0,0 +1,109 @@
+package channel
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/ddr4869/minifab/common/configtx"
+	pb_common "github.com/ddr4869/minifab/proto/common"
+	pb_orderer "github.com/ddr4869/minifab/proto/orderer"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/protobuf/encoding/prototext"
+	"gopkg.in/yaml.v3"
+)
+
+func channelDescribeCmd() *cobra.Command {
+	var channelID, format string
+
+	cmd := &cobra.Command{
+		Use:   "describe",
+		Short: "채널 설정 전체를 조회합니다",
+		Long: `orderer가 보관한 채널 설정을 출력합니다.
+--format json(기본)과 yaml은 채널 설정을, proto는 채널 설정 블록(블록 0)을 protobuf text 형식으로 출력합니다.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			switch format {
+			case "json", "yaml", "proto":
+			default:
+				return errors.Errorf("unsupported format: %s (json|proto|yaml)", format)
+			}
+			return describeChannel(os.Stdout, ordererAddress, channelID, format)
+		},
+	}
+
+	cmd.Flags().StringVarP(&channelID, "channelID", "c", "", "Channel name (required)")
+	cmd.Flags().StringVar(&format, "format", "json", "Output format (json|proto|yaml)")
+	cmd.MarkFlagRequired("channelID")
+
+	return cmd
+}
+
+func describeChannel(w io.Writer, address, channelID, format string) error {
+	conn, err := grpc.NewClient(address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return errors.Wrap(err, "failed to connect to orderer")
+	}
+	defer conn.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	client := pb_orderer.NewOrdererServiceClient(conn)
+	if format == "proto" {
+		resp, err := client.GetBlock(ctx, &pb_orderer.BlockRequest{ChannelId: channelID, BlockNumber: 0})
+		if err != nil {
+			return errors.Wrap(err, "failed to get config block")
+		}
+		if resp.Status != pb_common.Status_OK {
+			return errors.Errorf("failed to get config block of channel %s: %s", channelID, resp.Status)
+		}
+		return PrintConfigBlock(w, resp.Block)
+	}
+
+	resp, err := client.GetChannelConfig(ctx, &pb_orderer.ChannelConfigRequest{ChannelId: channelID})
+	if err != nil {
+		return errors.Wrap(err, "failed to get channel config")
+	}
+	if resp.Status != pb_common.Status_OK {
+		return errors.Errorf("failed to get config of channel %s: %s", channelID, resp.Status)
+	}
+	return PrintChannelConfig(w, resp.Config, format)
+}
+
+// PrintChannelConfig GetChannelConfigBytes가 반환한 JSON 채널 설정을 json 또는 yaml 형식으로 출력
+func PrintChannelConfig(w io.Writer, configJSON []byte, format string) error {
+	switch format {
+	case "json":
+		_, err := fmt.Fprintln(w, string(configJSON))
+		return err
+	case "yaml":
+		var channelConfig configtx.ChannelConfig
+		if err := json.Unmarshal(configJSON, &channelConfig); err != nil {
+			return errors.Wrap(err, "failed to unmarshal channel config")
+		}
+		encoder := yaml.NewEncoder(w)
+		encoder.SetIndent(2)
+		if err := encoder.Encode(&channelConfig); err != nil {
+			return errors.Wrap(err, "failed to marshal channel config to YAML")
+		}
+		return encoder.Close()
+	default:
+		return errors.Errorf("unsupported format: %s", format)
+	}
+}
+
+// PrintConfigBlock 채널 설정 블록을 protobuf text 형식으로 출력
+func PrintConfigBlock(w io.Writer, block *pb_common.Block) error {
+	data, err := prototext.MarshalOptions{Multiline: true, Indent: "  "}.Marshal(block)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal config block")
+	}
+	_, err = w.Write(data)
+	return err
+}
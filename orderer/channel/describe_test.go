@@ -0,0 +1,72 @@
+package channel
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/ddr4869/minifab/common/configtx"
+	"gopkg.in/yaml.v3"
+)
+
+func TestDescribeChannel(t *testing.T) {
+	n := newTestNetwork(t)
+	n.createChannel(t, "mychannel")
+	address := n.serve(t)
+
+	t.Run("json", func(t *testing.T) {
+		var out bytes.Buffer
+		if err := describeChannel(&out, address, "mychannel", "json"); err != nil {
+			t.Fatalf("describeChannel: %v", err)
+		}
+		var channelConfig configtx.ChannelConfig
+		if err := json.Unmarshal(out.Bytes(), &channelConfig); err != nil {
+			t.Fatalf("describe output is not valid JSON: %v\n%s", err, out.String())
+		}
+		if orgs := channelConfig.CC.Organizations; len(orgs) != 1 || orgs[0].Name != "Org1" || orgs[0].ID != "Org1MSP" {
+			t.Errorf("application organizations = %+v, want Org1", orgs)
+		}
+		if name := channelConfig.SCC.Orderer.Organization.ID; name != "OrdererMSP" {
+			t.Errorf("orderer organization = %q, want OrdererMSP", name)
+		}
+	})
+
+	t.Run("yaml", func(t *testing.T) {
+		var out bytes.Buffer
+		if err := describeChannel(&out, address, "mychannel", "yaml"); err != nil {
+			t.Fatalf("describeChannel: %v", err)
+		}
+		var channelConfig configtx.ChannelConfig
+		if err := yaml.Unmarshal(out.Bytes(), &channelConfig); err != nil {
+			t.Fatalf("describe output is not valid YAML: %v\n%s", err, out.String())
+		}
+		if orgs := channelConfig.CC.Organizations; len(orgs) != 1 || orgs[0].Name != "Org1" {
+			t.Errorf("application organizations = %+v, want Org1", orgs)
+		}
+	})
+
+	t.Run("proto", func(t *testing.T) {
+		var out bytes.Buffer
+		if err := describeChannel(&out, address, "mychannel", "proto"); err != nil {
+			t.Fatalf("describeChannel: %v", err)
+		}
+		if !strings.Contains(out.String(), "BLOCK_TYPE_CONFIG") {
+			t.Errorf("proto output is not the config block:\n%s", out.String())
+		}
+	})
+
+	if err := describeChannel(&bytes.Buffer{}, address, "otherchannel", "json"); err == nil || !strings.Contains(err.Error(), "CHANNEL_NOT_FOUND") {
+		t.Errorf("describe of an unknown channel error = %v, want CHANNEL_NOT_FOUND", err)
+	}
+}
+
+func TestChannelDescribeCmdRejectsUnknownFormat(t *testing.T) {
+	cmd := channelDescribeCmd()
+	cmd.SetArgs([]string{"-c", "mychannel", "--format", "xml"})
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+	if err := cmd.Execute(); err == nil || !strings.Contains(err.Error(), "unsupported format") {
+		t.Fatalf("describe --format xml error = %v, want unsupported format", err)
+	}
+}
@@ -0,0 +1,76 @@
+package channel
+
+import (
+	"io"
+	"testing"
+
+	pb_common "github.com/ddr4869/minifab/proto/common"
+	pb_orderer "github.com/ddr4869/minifab/proto/orderer"
+	"google.golang.org/grpc"
+)
+
+// fakeCreateChannelStream envelopes를 순서대로 전달하고 CreateChannel의 응답을 기록하는 서버 stream
+type fakeCreateChannelStream struct {
+	grpc.ServerStream
+	envelopes []*pb_common.Envelope
+	responses []*pb_orderer.BroadcastResponse
+}
+
+func (s *fakeCreateChannelStream) Recv() (*pb_common.Envelope, error) {
+	if len(s.envelopes) == 0 {
+		return nil, io.EOF
+	}
+	envelope := s.envelopes[0]
+	s.envelopes = s.envelopes[1:]
+	return envelope, nil
+}
+
+func (s *fakeCreateChannelStream) Send(response *pb_orderer.BroadcastResponse) error {
+	s.responses = append(s.responses, response)
+	return nil
+}
+
+func TestCreateChannelRejectsOversizedEnvelope(t *testing.T) {
+	n := newTestNetwork(t)
+	n.cs.SystemChannelInfo.Orderer.BatchSize.AbsoluteMaxBytes = "1 KB"
+
+	stream := &fakeCreateChannelStream{envelopes: []*pb_common.Envelope{{Payload: make([]byte, 1024+1)}}}
+	if err := n.cs.CreateChannel(stream); err == nil {
+		t.Fatal("CreateChannel accepted an envelope larger than AbsoluteMaxBytes")
+	}
+	if len(stream.responses) != 1 || stream.responses[0].Status != pb_common.Status_REQUEST_ENTITY_TOO_LARGE {
+		t.Fatalf("responses = %v, want one REQUEST_ENTITY_TOO_LARGE", stream.responses)
+	}
+}
+
+func TestValidateEnvelopeSize(t *testing.T) {
+	tests := []struct {
+		name             string
+		absoluteMaxBytes string
+		payload          int
+		signature        int
+		wantErr          bool
+	}{
+		{"below limit", "1 KB", 1000, 23, false},
+		{"payload and signature reach limit", "1 KB", 1000, 24, true},
+		{"payload alone over limit", "1 KB", 1025, 0, true},
+		{"no limit uses default", "", DefaultAbsoluteMaxBytes - 1, 0, false},
+		{"default limit reached", "", DefaultAbsoluteMaxBytes, 0, true},
+		{"invalid limit uses default", "lots", 2048, 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			n := newTestNetwork(t)
+			n.cs.SystemChannelInfo.Orderer.BatchSize.AbsoluteMaxBytes = tt.absoluteMaxBytes
+
+			envelope := &pb_common.Envelope{Payload: make([]byte, tt.payload), Signature: make([]byte, tt.signature)}
+			if err := n.cs.ValidateEnvelopeSize(envelope); (err != nil) != tt.wantErr {
+				t.Fatalf("ValidateEnvelopeSize error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+
+	if err := newTestNetwork(t).cs.ValidateEnvelopeSize(nil); err == nil {
+		t.Error("ValidateEnvelopeSize accepted a nil envelope")
+	}
+}
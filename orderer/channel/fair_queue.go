@@ -0,0 +1,123 @@
+package channel
+
+import (
+	"sync"
+
+	pb_common "github.com/ddr4869/minifab/proto/common"
+)
+
+// FairQueue는 블록으로 묶이기 전의 트랜잭션 envelope을 채널별로 보관한다.
+// 채널 안에서는 송신자(Identity.Creator)별 FIFO 큐를 두고, Dequeue 시 비어있지 않은
+// 송신자 큐를 round-robin으로 돌며 하나씩 꺼내어 한 송신자가 다른 송신자를 굶기지 않도록 한다.
+type FairQueue struct {
+	mutex  sync.Mutex
+	queues map[string]*channelQueue
+}
+
+type channelQueue struct {
+	// 대기 중인 envelope이 있는 송신자들의 round-robin 순서
+	senders []string
+	next    int
+	pending map[string][]*pb_common.Envelope
+	size    int
+}
+
+func NewFairQueue() *FairQueue {
+	return &FairQueue{
+		queues: make(map[string]*channelQueue),
+	}
+}
+
+// Enqueue 송신자 큐의 마지막에 envelope 추가
+func (q *FairQueue) Enqueue(channelID string, sender []byte, envelope *pb_common.Envelope) {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	cq, exists := q.queues[channelID]
+	if !exists {
+		cq = &channelQueue{pending: make(map[string][]*pb_common.Envelope)}
+		q.queues[channelID] = cq
+	}
+
+	key := string(sender)
+	if len(cq.pending[key]) == 0 {
+		cq.senders = append(cq.senders, key)
+	}
+	cq.pending[key] = append(cq.pending[key], envelope)
+	cq.size++
+}
+
+// Requeue 꺼냈지만 처리하지 못한 envelope을 송신자 큐의 맨 앞으로 되돌림
+// 대기 중인 envelope이 없던 송신자는 round-robin의 바로 다음 차례가 된다.
+// 여러 개를 되돌릴 때는 원래 순서의 역순으로 호출해야 원래 순서가 유지된다.
+func (q *FairQueue) Requeue(channelID string, sender []byte, envelope *pb_common.Envelope) {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	cq, exists := q.queues[channelID]
+	if !exists {
+		cq = &channelQueue{pending: make(map[string][]*pb_common.Envelope)}
+		q.queues[channelID] = cq
+	}
+
+	key := string(sender)
+	if len(cq.pending[key]) == 0 {
+		if cq.next > len(cq.senders) {
+			cq.next = len(cq.senders)
+		}
+		cq.senders = append(cq.senders[:cq.next], append([]string{key}, cq.senders[cq.next:]...)...)
+	}
+	cq.pending[key] = append([]*pb_common.Envelope{envelope}, cq.pending[key]...)
+	cq.size++
+}
+
+// Dequeue 송신자들을 round-robin으로 돌며 최대 max개의 envelope을 꺼낸다
+// max가 0 이하이면 대기 중인 envelope을 모두 꺼낸다.
+func (q *FairQueue) Dequeue(channelID string, max int) []*pb_common.Envelope {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	cq, exists := q.queues[channelID]
+	if !exists {
+		return nil
+	}
+	if max <= 0 || max > cq.size {
+		max = cq.size
+	}
+
+	envelopes := make([]*pb_common.Envelope, 0, max)
+	for len(envelopes) < max {
+		if cq.next >= len(cq.senders) {
+			cq.next = 0
+		}
+		sender := cq.senders[cq.next]
+		queue := cq.pending[sender]
+
+		envelopes = append(envelopes, queue[0])
+		cq.size--
+		if len(queue) == 1 {
+			// 비워진 송신자는 순서에서 제거하고, 같은 인덱스의 다음 송신자가 이어서 처리된다
+			delete(cq.pending, sender)
+			cq.senders = append(cq.senders[:cq.next], cq.senders[cq.next+1:]...)
+		} else {
+			cq.pending[sender] = queue[1:]
+			cq.next++
+		}
+	}
+
+	if cq.size == 0 {
+		delete(q.queues, channelID)
+	}
+	return envelopes
+}
+
+// Len 채널에 대기 중인 envelope 개수
+func (q *FairQueue) Len(channelID string) int {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	if cq, exists := q.queues[channelID]; exists {
+		return cq.size
+	}
+	return 0
+}
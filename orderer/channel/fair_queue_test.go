@@ -0,0 +1,124 @@
+package channel
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+	"testing"
+
+	pb_common "github.com/ddr4869/minifab/proto/common"
+)
+
+// queueEnvelope payload가 "<sender>-<i>"인 envelope
+func queueEnvelope(sender string, i int) *pb_common.Envelope {
+	return &pb_common.Envelope{Payload: []byte(fmt.Sprintf("%s-%d", sender, i))}
+}
+
+func envelopePayloads(envelopes []*pb_common.Envelope) []string {
+	payloads := make([]string, len(envelopes))
+	for i, envelope := range envelopes {
+		payloads[i] = string(envelope.Payload)
+	}
+	return payloads
+}
+
+func TestFairQueueRoundRobinAcrossSenders(t *testing.T) {
+	q := NewFairQueue()
+	for i := 0; i < 5; i++ {
+		q.Enqueue("mychannel", []byte("A"), queueEnvelope("A", i))
+	}
+	q.Enqueue("mychannel", []byte("B"), queueEnvelope("B", 0))
+	q.Enqueue("mychannel", []byte("C"), queueEnvelope("C", 0))
+	q.Enqueue("mychannel", []byte("C"), queueEnvelope("C", 1))
+
+	// A가 먼저 많이 보냈어도 B와 C가 A의 두 번째 envelope보다 먼저 나온다
+	want := []string{"A-0", "B-0", "C-0", "A-1", "C-1", "A-2", "A-3", "A-4"}
+	if got := envelopePayloads(q.Dequeue("mychannel", 0)); !reflect.DeepEqual(got, want) {
+		t.Fatalf("dequeue order = %v, want %v", got, want)
+	}
+	if q.Len("mychannel") != 0 {
+		t.Fatalf("Len = %d after dequeuing everything", q.Len("mychannel"))
+	}
+}
+
+func TestFairQueueResumesRoundRobinBetweenBatches(t *testing.T) {
+	q := NewFairQueue()
+	for i := 0; i < 3; i++ {
+		q.Enqueue("mychannel", []byte("A"), queueEnvelope("A", i))
+		q.Enqueue("mychannel", []byte("B"), queueEnvelope("B", i))
+		q.Enqueue("mychannel", []byte("C"), queueEnvelope("C", i))
+	}
+	q.Enqueue("otherchannel", []byte("A"), queueEnvelope("X", 0))
+
+	if got, want := envelopePayloads(q.Dequeue("mychannel", 2)), []string{"A-0", "B-0"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("first batch = %v, want %v", got, want)
+	}
+	// 다음 배치는 C부터 이어진다
+	if got, want := envelopePayloads(q.Dequeue("mychannel", 4)), []string{"C-0", "A-1", "B-1", "C-1"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("second batch = %v, want %v", got, want)
+	}
+	if q.Len("mychannel") != 3 || q.Len("otherchannel") != 1 {
+		t.Fatalf("Len = %d/%d, want 3/1", q.Len("mychannel"), q.Len("otherchannel"))
+	}
+}
+
+func TestFairQueueRequeueRestoresOrder(t *testing.T) {
+	q := NewFairQueue()
+	for i := 0; i < 2; i++ {
+		q.Enqueue("mychannel", []byte("A"), queueEnvelope("A", i))
+		q.Enqueue("mychannel", []byte("B"), queueEnvelope("B", i))
+	}
+	batch := q.Dequeue("mychannel", 3)
+	senders := []string{"A", "B", "A"}
+	for i := len(batch) - 1; i >= 0; i-- {
+		q.Requeue("mychannel", []byte(senders[i]), batch[i])
+	}
+
+	want := []string{"A-0", "B-0", "A-1", "B-1"}
+	if got := envelopePayloads(q.Dequeue("mychannel", 0)); !reflect.DeepEqual(got, want) {
+		t.Fatalf("order after requeue = %v, want %v", got, want)
+	}
+}
+
+// BenchmarkFairQueue 송신 비율이 10:1인 두 송신자가 동시에 제출할 때 배치 안의 처리 비율 측정
+// 두 송신자 모두 대기 중인 동안 round-robin이면 작은 송신자의 몫(small-share)은 0.5에 가깝다.
+func BenchmarkFairQueue(b *testing.B) {
+	const (
+		batchSize   = 100
+		largeSender = 10 * batchSize
+		smallSender = batchSize
+	)
+	largeEnvelope := &pb_common.Envelope{Payload: []byte("large")}
+	smallEnvelope := &pb_common.Envelope{Payload: []byte("small")}
+
+	var smallInFirstBatch, transactions int
+	for i := 0; i < b.N; i++ {
+		q := NewFairQueue()
+		var wg sync.WaitGroup
+		submit := func(sender string, envelope *pb_common.Envelope, count int) {
+			defer wg.Done()
+			for j := 0; j < count; j++ {
+				q.Enqueue("mychannel", []byte(sender), envelope)
+			}
+		}
+		wg.Add(2)
+		go submit("large", largeEnvelope, largeSender)
+		go submit("small", smallEnvelope, smallSender)
+		wg.Wait()
+
+		for batch := 0; q.Len("mychannel") > 0; batch++ {
+			envelopes := q.Dequeue("mychannel", batchSize)
+			transactions += len(envelopes)
+			if batch != 0 {
+				continue
+			}
+			for _, envelope := range envelopes {
+				if envelope == smallEnvelope {
+					smallInFirstBatch++
+				}
+			}
+		}
+	}
+	b.ReportMetric(float64(smallInFirstBatch)/float64(b.N*batchSize), "small-share")
+	b.ReportMetric(float64(transactions)/b.Elapsed().Seconds(), "tx/s")
+}
@@ -0,0 +1,185 @@
+package channel
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/ddr4869/minifab/common/blockutil"
+	"github.com/ddr4869/minifab/common/configtx"
+	"github.com/ddr4869/minifab/common/msp"
+	"github.com/ddr4869/minifab/common/msp/msptest"
+	"github.com/ddr4869/minifab/config"
+	pb_common "github.com/ddr4869/minifab/proto/common"
+)
+
+// testNetwork orderer 조직 하나와 consortium 조직 하나를 가진 ChainSupport
+type testNetwork struct {
+	cs         *ChainSupport
+	ordererOrg *msptest.Org
+	peerOrg    *msptest.Org
+}
+
+// newTestNetwork 임시 디렉터리를 블록 저장소로 쓰는 ChainSupport 생성
+// orderer 설정의 BatchTimeout은 테스트 도중 ticker로 블록이 잘리지 않도록 1시간이다.
+func newTestNetwork(t *testing.T) *testNetwork {
+	t.Helper()
+
+	ordererOrg := msptest.NewOrg(t, "OrdererMSP")
+	peerOrg := msptest.NewOrg(t, "Org1MSP")
+	filesystemPath := t.TempDir()
+
+	scc := &configtx.SystemChannelInfo{
+		Orderer: configtx.SystemChannelConfig{
+			BatchTimeout: "1h",
+			BatchSize:    configtx.BatchSize{MaxMessageCount: 10, AbsoluteMaxBytes: "10 MB", PreferredMaxBytes: "2 MB"},
+			Organization: configtx.Organization{Name: "OrdererOrg", ID: "OrdererMSP", MSPCaCert: ordererOrg.CACert.Raw},
+		},
+		Consortiums: []configtx.Organization{
+			{Name: "Org1", ID: "Org1MSP", MSPCaCert: peerOrg.CACert.Raw},
+		},
+	}
+	cs := &ChainSupport{
+		SystemChannelInfo: scc,
+		MSPManager:        consortiumMSPManager(scc),
+		Channels:          NewChannelRegistry(),
+		OrdererConfig: &config.OrdererCfg{
+			MSPID:          "OrdererMSP",
+			MSP:            ordererOrg.MSP,
+			FilesystemPath: filesystemPath,
+			DrainTimeout:   config.DefaultDrainTimeout,
+		},
+		PendingQueue: NewFairQueue(),
+		BlockAcks:    NewBlockAckStore(),
+		Sequences:    NewSequenceStore(filesystemPath),
+		Broadcaster:  NewBlockBroadcaster(),
+	}
+	cs.Cutter = NewBlockCutter(cs)
+	return &testNetwork{cs: cs, ordererOrg: ordererOrg, peerOrg: peerOrg}
+}
+
+// createChannel peer 조직만 가진 application 채널의 설정 블록(블록 0)을 저장하고 채널 등록
+func (n *testNetwork) createChannel(t *testing.T, channelID string) *configtx.ChannelConfig {
+	t.Helper()
+
+	channelConfig := &configtx.ChannelConfig{
+		CC: &configtx.AppChannelConfig{
+			Organizations: []configtx.Organization{{Name: "Org1", ID: "Org1MSP", MSPCaCert: n.peerOrg.CACert.Raw}},
+		},
+		SCC: n.cs.SystemChannelInfo,
+	}
+	configBytes, err := json.Marshal(channelConfig)
+	if err != nil {
+		t.Fatalf("failed to marshal channel config: %v", err)
+	}
+	signer := n.ordererOrg.SigningIdentity()
+	block, err := blockutil.GenerateConfigBlock(configBytes, channelID, signer)
+	if err != nil {
+		t.Fatalf("GenerateConfigBlock: %v", err)
+	}
+	if err := blockutil.SignBlock(block, signer); err != nil {
+		t.Fatalf("SignBlock: %v", err)
+	}
+	if err := blockutil.SaveBlockFile(block, channelID, n.cs.OrdererConfig.FilesystemPath); err != nil {
+		t.Fatalf("SaveBlockFile: %v", err)
+	}
+	if err := n.cs.commitSequence(channelID, 0); err != nil {
+		t.Fatalf("commitSequence: %v", err)
+	}
+	if !n.cs.Channels.Add(channelID, channelConfig) {
+		t.Fatalf("channel %s already exists", channelID)
+	}
+	return channelConfig
+}
+
+// newTestTransaction signer가 만든 payload "tx-<i>"의 TRANSACTION 트랜잭션
+func newTestTransaction(t *testing.T, signer msp.SigningIdentity, i int) *pb_common.Transaction {
+	t.Helper()
+
+	tx := &pb_common.Transaction{
+		Payload: []byte(fmt.Sprintf("tx-%d", i)),
+		Identity: &pb_common.Identity{
+			Creator: signer.GetCertificate().Raw,
+			MspId:   signer.GetIdentifier().Mspid,
+		},
+		Signature: []byte("signature"),
+		Timestamp: time.Now().Unix(),
+		Type:      pb_common.MessageType_MESSAGE_TYPE_TRANSACTION,
+	}
+	txID, err := blockutil.CalculateTxHash(tx)
+	if err != nil {
+		t.Fatalf("CalculateTxHash: %v", err)
+	}
+	tx.TxId = txID
+	return tx
+}
+
+// newTestEnvelope signer가 서명한 트랜잭션 envelope
+func newTestEnvelope(t *testing.T, signer msp.SigningIdentity, channelID string, tx *pb_common.Transaction) *pb_common.Envelope {
+	t.Helper()
+
+	txBytes, err := blockutil.MarshalTransactionToProto(tx)
+	if err != nil {
+		t.Fatalf("MarshalTransactionToProto: %v", err)
+	}
+	payload := &pb_common.Payload{
+		Header: &pb_common.Header{
+			Type:      pb_common.MessageType_MESSAGE_TYPE_TRANSACTION,
+			ChannelId: channelID,
+			Identity:  tx.Identity,
+		},
+		Data: txBytes,
+	}
+	payloadBytes, err := blockutil.MarshalPayloadToProto(payload)
+	if err != nil {
+		t.Fatalf("MarshalPayloadToProto: %v", err)
+	}
+	digest := sha256.Sum256(payloadBytes)
+	signature, err := signer.Sign(rand.Reader, digest[:], nil)
+	if err != nil {
+		t.Fatalf("Sign: %v", err)
+	}
+	return &pb_common.Envelope{Payload: payloadBytes, Signature: signature}
+}
+
+// enqueue signer의 트랜잭션 n개를 채널 PendingQueue에 추가하고 트랜잭션 ID 목록 반환
+func (n *testNetwork) enqueue(t *testing.T, signer msp.SigningIdentity, channelID string, count int) []string {
+	t.Helper()
+
+	txIDs := make([]string, 0, count)
+	for i := 0; i < count; i++ {
+		tx := newTestTransaction(t, signer, i)
+		n.cs.PendingQueue.Enqueue(channelID, tx.Identity.Creator, newTestEnvelope(t, signer, channelID, tx))
+		txIDs = append(txIDs, tx.TxId)
+	}
+	return txIDs
+}
+
+// loadBlock 채널의 blockNumber 블록을 디스크에서 읽음
+func (n *testNetwork) loadBlock(t *testing.T, channelID string, blockNumber uint64) *pb_common.Block {
+	t.Helper()
+
+	block, err := blockutil.LoadBlock(n.cs.blockPath(channelID, blockNumber))
+	if err != nil {
+		t.Fatalf("failed to load block %d of channel %s: %v", blockNumber, channelID, err)
+	}
+	return block
+}
+
+// blockTxIDs 블록에 담긴 트랜잭션 ID 목록
+func blockTxIDs(t *testing.T, block *pb_common.Block) []string {
+	t.Helper()
+
+	var txIDs []string
+	for _, txBytes := range block.GetData().GetTransactions() {
+		tx, err := blockutil.UnmarshalTransactionFromProto(txBytes)
+		if err != nil {
+			t.Fatalf("UnmarshalTransactionFromProto: %v", err)
+		}
+		txIDs = append(txIDs, tx.TxId)
+	}
+	return txIDs
+}
@@ -0,0 +1,75 @@
+package channel
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/ddr4869/minifab/common/configtx"
+)
+
+// ChannelRegistry는 orderer가 관리하는 application 채널 설정을 채널 ID별로 보관한다.
+// Orderer와 ChainSupport가 같은 registry를 공유한다.
+type ChannelRegistry struct {
+	mutex    sync.RWMutex
+	channels map[string]*configtx.ChannelConfig
+}
+
+func NewChannelRegistry() *ChannelRegistry {
+	return &ChannelRegistry{
+		channels: make(map[string]*configtx.ChannelConfig),
+	}
+}
+
+// Add 채널 설정 등록 (이미 있으면 교체하지 않고 false 반환)
+func (r *ChannelRegistry) Add(channelID string, channelConfig *configtx.ChannelConfig) bool {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if _, exists := r.channels[channelID]; exists {
+		return false
+	}
+	r.channels[channelID] = channelConfig
+	return true
+}
+
+// Set 채널 설정 등록 (이미 있으면 교체)
+func (r *ChannelRegistry) Set(channelID string, channelConfig *configtx.ChannelConfig) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.channels[channelID] = channelConfig
+}
+
+// Remove 채널 설정 삭제 (없으면 false 반환)
+func (r *ChannelRegistry) Remove(channelID string) bool {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if _, exists := r.channels[channelID]; !exists {
+		return false
+	}
+	delete(r.channels, channelID)
+	return true
+}
+
+// Get 채널 설정 조회
+func (r *ChannelRegistry) Get(channelID string) (*configtx.ChannelConfig, bool) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	channelConfig, exists := r.channels[channelID]
+	return channelConfig, exists
+}
+
+// List 등록된 채널 ID를 오름차순으로 정렬해 반환
+func (r *ChannelRegistry) List() []string {
+	r.mutex.RLock()
+	channels := make([]string, 0, len(r.channels))
+	for channelID := range r.channels {
+		channels = append(channels, channelID)
+	}
+	r.mutex.RUnlock()
+
+	sort.Strings(channels)
+	return channels
+}
@@ -0,0 +1,69 @@
+package channel
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"sync"
+	"testing"
+
+	"github.com/ddr4869/minifab/common/configtx"
+)
+
+func TestChannelRegistryConcurrentAddAndList(t *testing.T) {
+	r := NewChannelRegistry()
+
+	var want []string
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		channelID := fmt.Sprintf("channel%02d", i)
+		want = append(want, channelID)
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			r.Add(channelID, &configtx.ChannelConfig{})
+		}()
+		go func() {
+			defer wg.Done()
+			if channels := r.List(); !sort.StringsAreSorted(channels) {
+				t.Errorf("List() = %v, want ascending order", channels)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if got := r.List(); !reflect.DeepEqual(got, want) {
+		t.Fatalf("List() = %v, want %v", got, want)
+	}
+}
+
+func TestChannelRegistry(t *testing.T) {
+	r := NewChannelRegistry()
+	first := &configtx.ChannelConfig{CC: &configtx.AppChannelConfig{}}
+	second := &configtx.ChannelConfig{CC: &configtx.AppChannelConfig{}}
+
+	if !r.Add("mychannel", first) {
+		t.Fatal("Add of a new channel returned false")
+	}
+	if r.Add("mychannel", second) {
+		t.Error("Add of an existing channel returned true")
+	}
+	if got, exists := r.Get("mychannel"); !exists || got != first {
+		t.Error("Add replaced an existing channel config")
+	}
+
+	r.Set("mychannel", second)
+	if got, _ := r.Get("mychannel"); got != second {
+		t.Error("Set did not replace the channel config")
+	}
+
+	if !r.Remove("mychannel") {
+		t.Fatal("Remove of an existing channel returned false")
+	}
+	if _, exists := r.Get("mychannel"); exists {
+		t.Error("channel still registered after Remove")
+	}
+	if r.Remove("mychannel") {
+		t.Error("Remove of an unknown channel returned true")
+	}
+}
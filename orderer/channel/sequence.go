@@ -0,0 +1,110 @@
+package channel
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/ddr4869/minifab/common/blockutil"
+	"github.com/pkg/errors"
+)
+
+const sequenceFileName = "sequence"
+
+// SequenceStore 채널별 마지막 커밋 블록 번호를 <filesystemPath>/<channelID>/sequence 파일에 저장
+// orderer 재시작 후 다음 블록 번호를 이어가기 위해 사용한다.
+type SequenceStore struct {
+	filesystemPath string
+	mutex          sync.RWMutex
+	heights        map[string]uint64
+}
+
+func NewSequenceStore(filesystemPath string) *SequenceStore {
+	return &SequenceStore{
+		filesystemPath: filesystemPath,
+		heights:        make(map[string]uint64),
+	}
+}
+
+// Load sequence 파일에서 채널 높이(마지막 커밋 블록 번호 + 1)를 읽어 캐시
+// sequence 파일이 없거나 블록 파일보다 뒤처져 있으면(커밋 직후 기록 전 종료) 블록 파일 기준으로 다시 기록한다.
+func (s *SequenceStore) Load(channelID string) (uint64, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	fileHeight := blockutil.GetBlockHeight(channelID, s.filesystemPath)
+
+	data, err := os.ReadFile(s.sequencePath(channelID))
+	if os.IsNotExist(err) {
+		if fileHeight > 0 {
+			if err := s.write(channelID, fileHeight-1); err != nil {
+				return 0, err
+			}
+		}
+		s.heights[channelID] = fileHeight
+		return fileHeight, nil
+	}
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to read sequence of channel %s", channelID)
+	}
+
+	lastBlock, err := strconv.ParseUint(strings.TrimSpace(string(data)), 10, 64)
+	if err != nil {
+		return 0, errors.Wrapf(err, "invalid sequence of channel %s", channelID)
+	}
+	height := lastBlock + 1
+	if fileHeight < height {
+		return 0, errors.Errorf("channel %s has %d block files but sequence %d was committed", channelID, fileHeight, lastBlock)
+	}
+	if fileHeight > height {
+		if err := s.write(channelID, fileHeight-1); err != nil {
+			return 0, err
+		}
+		height = fileHeight
+	}
+
+	s.heights[channelID] = height
+	return height, nil
+}
+
+// Commit 블록 커밋 후 마지막 블록 번호를 원자적으로 기록
+func (s *SequenceStore) Commit(channelID string, blockNumber uint64) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if err := s.write(channelID, blockNumber); err != nil {
+		return err
+	}
+	s.heights[channelID] = blockNumber + 1
+	return nil
+}
+
+// Height 채널의 다음 블록 번호 (로드되지 않은 채널은 블록 파일 개수로 계산)
+func (s *SequenceStore) Height(channelID string) uint64 {
+	s.mutex.RLock()
+	height, exists := s.heights[channelID]
+	s.mutex.RUnlock()
+	if exists {
+		return height
+	}
+	return blockutil.GetBlockHeight(channelID, s.filesystemPath)
+}
+
+// write 임시 파일에 기록한 뒤 rename하여 sequence 파일을 원자적으로 교체 (lock을 잡은 상태에서 호출)
+func (s *SequenceStore) write(channelID string, blockNumber uint64) error {
+	path := s.sequencePath(channelID)
+	tmpPath := path + ".tmp"
+	if err := os.WriteFile(tmpPath, []byte(strconv.FormatUint(blockNumber, 10)), 0644); err != nil {
+		return errors.Wrapf(err, "failed to write sequence of channel %s", channelID)
+	}
+	if err := os.Rename(tmpPath, path); err != nil {
+		return errors.Wrapf(err, "failed to commit sequence of channel %s", channelID)
+	}
+	return nil
+}
+
+func (s *SequenceStore) sequencePath(channelID string) string {
+	return filepath.Join(s.filesystemPath, channelID, sequenceFileName)
+}
@@ -0,0 +1,118 @@
+package channel
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// restart 같은 블록 저장소와 조직을 사용하는 새 ChainSupport로 채널을 다시 로드
+func (n *testNetwork) restart(t *testing.T) *testNetwork {
+	t.Helper()
+
+	restarted := newTestNetwork(t)
+	restarted.ordererOrg, restarted.peerOrg = n.ordererOrg, n.peerOrg
+	restarted.cs.SystemChannelInfo = n.cs.SystemChannelInfo
+	restarted.cs.MSPManager = n.cs.MSPManager
+	restarted.cs.OrdererConfig = n.cs.OrdererConfig
+	restarted.cs.Sequences = NewSequenceStore(n.cs.OrdererConfig.FilesystemPath)
+	restarted.cs.Cutter = NewBlockCutter(restarted.cs)
+	restarted.cs.LoadExistingChannels(n.cs.OrdererConfig.FilesystemPath, RetryOptions{})
+	return restarted
+}
+
+// cutBlocks 트랜잭션 하나씩을 담은 블록 count개를 생성
+func (n *testNetwork) cutBlocks(t *testing.T, channelID string, count int) {
+	t.Helper()
+
+	for i := 0; i < count; i++ {
+		n.enqueue(t, n.peerOrg.SigningIdentity(), channelID, 1)
+		if err := n.cs.Cutter.CutBlock(channelID); err != nil {
+			t.Fatalf("CutBlock: %v", err)
+		}
+	}
+}
+
+// readSequence 채널 sequence 파일 내용
+func readSequence(t *testing.T, n *testNetwork, channelID string) string {
+	t.Helper()
+
+	data, err := os.ReadFile(filepath.Join(n.cs.OrdererConfig.FilesystemPath, channelID, sequenceFileName))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(data)
+}
+
+func TestSequenceSurvivesRestart(t *testing.T) {
+	n := newTestNetwork(t)
+	n.createChannel(t, "mychannel")
+	n.cutBlocks(t, "mychannel", 4)
+	if got := readSequence(t, n, "mychannel"); got != "4" {
+		t.Fatalf("sequence = %q, want 4", got)
+	}
+
+	restarted := n.restart(t)
+	if height := restarted.cs.channelHeight("mychannel"); height != 5 {
+		t.Fatalf("height after restart = %d, want 5", height)
+	}
+
+	restarted.cutBlocks(t, "mychannel", 1)
+	block := restarted.loadBlock(t, "mychannel", 5)
+	if block.Header.Number != 5 {
+		t.Errorf("next block number = %d, want 5", block.Header.Number)
+	}
+	if got := readSequence(t, restarted, "mychannel"); got != "5" {
+		t.Errorf("sequence = %q, want 5", got)
+	}
+}
+
+func TestSequenceStoreLoad(t *testing.T) {
+	n := newTestNetwork(t)
+	n.createChannel(t, "mychannel")
+	n.cutBlocks(t, "mychannel", 2)
+	sequencePath := filepath.Join(n.cs.OrdererConfig.FilesystemPath, "mychannel", sequenceFileName)
+
+	// sequence 파일이 없으면 블록 파일 기준으로 다시 기록
+	if err := os.Remove(sequencePath); err != nil {
+		t.Fatal(err)
+	}
+	store := NewSequenceStore(n.cs.OrdererConfig.FilesystemPath)
+	if height, err := store.Load("mychannel"); err != nil || height != 3 {
+		t.Fatalf("Load without sequence file = %d, %v, want 3", height, err)
+	}
+	if got := readSequence(t, n, "mychannel"); got != "2" {
+		t.Errorf("rewritten sequence = %q, want 2", got)
+	}
+
+	// 블록 파일보다 뒤처진 sequence는 블록 파일 기준으로 갱신
+	if err := os.WriteFile(sequencePath, []byte("0"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if height, err := NewSequenceStore(n.cs.OrdererConfig.FilesystemPath).Load("mychannel"); err != nil || height != 3 {
+		t.Fatalf("Load with stale sequence = %d, %v, want 3", height, err)
+	}
+	if got := readSequence(t, n, "mychannel"); got != "2" {
+		t.Errorf("updated sequence = %q, want 2", got)
+	}
+
+	// 블록 파일보다 앞선 sequence는 손상으로 처리
+	if err := os.WriteFile(sequencePath, []byte("7"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := NewSequenceStore(n.cs.OrdererConfig.FilesystemPath).Load("mychannel"); err == nil || !strings.Contains(err.Error(), "sequence 7 was committed") {
+		t.Errorf("Load with sequence ahead of blocks error = %v", err)
+	}
+	restarted := n.restart(t)
+	if _, exists := restarted.cs.Channels.Get("mychannel"); exists {
+		t.Error("channel with a sequence ahead of its blocks was loaded")
+	}
+
+	if err := os.WriteFile(sequencePath, []byte("abc"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := NewSequenceStore(n.cs.OrdererConfig.FilesystemPath).Load("mychannel"); err == nil || !strings.Contains(err.Error(), "invalid sequence") {
+		t.Errorf("Load with malformed sequence error = %v", err)
+	}
+}
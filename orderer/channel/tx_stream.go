@@ -0,0 +1,69 @@
+package channel
+
+import (
+	"github.com/ddr4869/minifab/common/blockutil"
+	"github.com/ddr4869/minifab/common/logger"
+	pb_common "github.com/ddr4869/minifab/proto/common"
+	pb_orderer "github.com/ddr4869/minifab/proto/orderer"
+)
+
+// StreamTransactions 구독 이후 커밋되는 블록의 트랜잭션을 하나씩 TransactionEvent로 전송
+// channel_id, chaincode_id가 비어 있으면 해당 조건으로 거르지 않으며, CONFIG 트랜잭션은 보내지 않는다.
+func (cs *ChainSupport) StreamTransactions(req *pb_orderer.TransactionStreamRequest, stream pb_orderer.OrdererService_StreamTransactionsServer) error {
+	if req.ChannelId != "" {
+		if _, exists := cs.GetChannelInfo(req.ChannelId); !exists {
+			return stream.Send(&pb_orderer.TransactionEvent{Status: pb_common.Status_CHANNEL_NOT_FOUND})
+		}
+	}
+
+	blocks, unsubscribe := cs.Broadcaster.SubscribeAll()
+	defer unsubscribe()
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case channelBlock, ok := <-blocks:
+			if !ok {
+				return nil
+			}
+			if req.ChannelId != "" && channelBlock.ChannelID != req.ChannelId {
+				continue
+			}
+			if err := sendBlockTransactions(stream, channelBlock, req.ChaincodeId); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// sendBlockTransactions 블록의 TRANSACTION 트랜잭션 중 chaincodeID(비어 있으면 전부)에 해당하는 것을 전송
+func sendBlockTransactions(stream pb_orderer.OrdererService_StreamTransactionsServer, channelBlock ChannelBlock, chaincodeID string) error {
+	block := channelBlock.Block
+	for i, txBytes := range block.GetData().GetTransactions() {
+		tx, err := blockutil.UnmarshalTransactionFromProto(txBytes)
+		if err != nil {
+			logger.Warnf("[Orderer] Skipping transaction %d of block %d in channel %s: %v", i, block.Header.Number, channelBlock.ChannelID, err)
+			continue
+		}
+		if tx.Type != pb_common.MessageType_MESSAGE_TYPE_TRANSACTION {
+			continue
+		}
+		if chaincodeID != "" && tx.ChaincodeId != chaincodeID {
+			continue
+		}
+		event := &pb_orderer.TransactionEvent{
+			Status:      pb_common.Status_OK,
+			TxId:        tx.TxId,
+			ChannelId:   channelBlock.ChannelID,
+			ChaincodeId: tx.ChaincodeId,
+			Payload:     tx.Payload,
+			BlockNumber: block.Header.Number,
+			Timestamp:   tx.Timestamp,
+		}
+		if err := stream.Send(event); err != nil {
+			return err
+		}
+	}
+	return nil
+}
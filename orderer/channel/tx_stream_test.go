@@ -0,0 +1,152 @@
+package channel
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ddr4869/minifab/common/blockutil"
+	pb_common "github.com/ddr4869/minifab/proto/common"
+	pb_orderer "github.com/ddr4869/minifab/proto/orderer"
+	"google.golang.org/grpc"
+)
+
+// fakeTransactionStream StreamTransactions가 보낸 이벤트를 채널로 전달하는 서버 stream
+type fakeTransactionStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	events chan *pb_orderer.TransactionEvent
+}
+
+func newFakeTransactionStream(ctx context.Context) *fakeTransactionStream {
+	return &fakeTransactionStream{ctx: ctx, events: make(chan *pb_orderer.TransactionEvent, 100)}
+}
+
+func (s *fakeTransactionStream) Context() context.Context { return s.ctx }
+
+func (s *fakeTransactionStream) Send(event *pb_orderer.TransactionEvent) error {
+	s.events <- event
+	return nil
+}
+
+// receive stream에서 이벤트 count개를 받음
+func (s *fakeTransactionStream) receive(t *testing.T, count int) []*pb_orderer.TransactionEvent {
+	t.Helper()
+
+	events := make([]*pb_orderer.TransactionEvent, 0, count)
+	for len(events) < count {
+		select {
+		case event := <-s.events:
+			events = append(events, event)
+		case <-time.After(5 * time.Second):
+			t.Fatalf("received %d of %d transaction events", len(events), count)
+		}
+	}
+	return events
+}
+
+// startTransactionStream req로 StreamTransactions를 시작하고 구독이 등록될 때까지 대기
+func (n *testNetwork) startTransactionStream(t *testing.T, req *pb_orderer.TransactionStreamRequest) *fakeTransactionStream {
+	t.Helper()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stream := newFakeTransactionStream(ctx)
+	done := make(chan error, 1)
+	go func() { done <- n.cs.StreamTransactions(req, stream) }()
+	t.Cleanup(func() {
+		cancel()
+		if err := <-done; err != nil {
+			t.Errorf("StreamTransactions: %v", err)
+		}
+	})
+
+	subscribers := n.subscriberCount()
+	deadline := time.Now().Add(5 * time.Second)
+	for n.subscriberCount() == subscribers {
+		if time.Now().After(deadline) {
+			t.Fatal("StreamTransactions did not subscribe")
+		}
+		time.Sleep(time.Millisecond)
+	}
+	return stream
+}
+
+func (n *testNetwork) subscriberCount() int {
+	n.cs.Broadcaster.mutex.RLock()
+	defer n.cs.Broadcaster.mutex.RUnlock()
+	return len(n.cs.Broadcaster.allSubscribers)
+}
+
+func TestStreamTransactionsDeliversSubmittedTransactions(t *testing.T) {
+	n := newTestNetwork(t)
+	n.createChannel(t, "mychannel")
+	n.createChannel(t, "otherchannel")
+
+	all := n.startTransactionStream(t, &pb_orderer.TransactionStreamRequest{ChannelId: "mychannel"})
+	asset := n.startTransactionStream(t, &pb_orderer.TransactionStreamRequest{ChannelId: "mychannel", ChaincodeId: "asset"})
+
+	signer := n.peerOrg.SigningIdentity()
+	var txIDs, assetTxIDs []string
+	for i := 0; i < 10; i++ {
+		tx := newTestTransaction(t, signer, i)
+		if i%3 == 0 {
+			tx.ChaincodeId = "asset"
+		}
+		txID, err := blockutil.CalculateTxHash(tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		tx.TxId = txID
+		response, err := n.cs.SubmitTransaction(context.Background(), newTestEnvelope(t, signer, "mychannel", tx))
+		if err != nil || response.Status != pb_common.Status_OK {
+			t.Fatalf("SubmitTransaction %d: %v, %v", i, response.GetStatus(), err)
+		}
+		txIDs = append(txIDs, tx.TxId)
+		if tx.ChaincodeId == "asset" {
+			assetTxIDs = append(assetTxIDs, tx.TxId)
+		}
+	}
+	// 다른 채널의 트랜잭션은 channel_id 필터에 걸러진다
+	n.enqueue(t, signer, "otherchannel", 3)
+	if err := n.cs.Cutter.CutBlock("otherchannel"); err != nil {
+		t.Fatalf("CutBlock: %v", err)
+	}
+	if err := n.cs.Cutter.CutBlock("mychannel"); err != nil {
+		t.Fatalf("CutBlock: %v", err)
+	}
+
+	events := all.receive(t, 10)
+	for i, event := range events {
+		if event.Status != pb_common.Status_OK || event.TxId != txIDs[i] {
+			t.Fatalf("event %d = %s %s, want OK %s", i, event.Status, event.TxId, txIDs[i])
+		}
+		if event.ChannelId != "mychannel" || event.BlockNumber != 1 || len(event.Payload) == 0 {
+			t.Fatalf("event %d has channel %s, block %d, payload %q", i, event.ChannelId, event.BlockNumber, event.Payload)
+		}
+	}
+	for i, event := range asset.receive(t, len(assetTxIDs)) {
+		if event.TxId != assetTxIDs[i] || event.ChaincodeId != "asset" {
+			t.Fatalf("asset event %d = %s (%s), want %s", i, event.TxId, event.ChaincodeId, assetTxIDs[i])
+		}
+	}
+
+	select {
+	case event := <-all.events:
+		t.Fatalf("unexpected extra event %s from channel %s", event.TxId, event.ChannelId)
+	case event := <-asset.events:
+		t.Fatalf("unexpected extra asset event %s with chaincode %q", event.TxId, event.ChaincodeId)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+func TestStreamTransactionsUnknownChannel(t *testing.T) {
+	n := newTestNetwork(t)
+	stream := newFakeTransactionStream(context.Background())
+
+	if err := n.cs.StreamTransactions(&pb_orderer.TransactionStreamRequest{ChannelId: "missing"}, stream); err != nil {
+		t.Fatalf("StreamTransactions: %v", err)
+	}
+	if event := stream.receive(t, 1)[0]; event.Status != pb_common.Status_CHANNEL_NOT_FOUND {
+		t.Fatalf("status = %s, want CHANNEL_NOT_FOUND", event.Status)
+	}
+}
@@ -0,0 +1,224 @@
+// Package orderertest peer 쪽 테스트에서 사용할 in-process orderer gRPC 서버를 제공한다.
+// 블록은 orderer 조직이 서명하고 이전 블록 해시로 연결되므로 peer의 블록 검증을 그대로 통과한다.
+package orderertest
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"sync"
+	"testing"
+
+	"github.com/ddr4869/minifab/common/blockutil"
+	"github.com/ddr4869/minifab/common/configtx"
+	"github.com/ddr4869/minifab/common/msp/msptest"
+	pb_common "github.com/ddr4869/minifab/proto/common"
+	pb_orderer "github.com/ddr4869/minifab/proto/orderer"
+	"google.golang.org/grpc"
+)
+
+// Server 채널별 블록 목록을 GetBlock, GetBlocks, GetChannelHeight, DeliverBlocks로 제공하는 orderer
+type Server struct {
+	pb_orderer.UnimplementedOrdererServiceServer
+
+	// Org 블록에 서명하는 orderer 조직
+	Org *msptest.Org
+	// Address 서버가 listen 중인 "host:port"
+	Address string
+
+	mutex    sync.Mutex
+	blocks   map[string][]*pb_common.Block
+	receipts map[string][]uint64
+	// appended 블록이 추가될 때마다 닫히고 새로 만들어져 DeliverBlocks를 깨움
+	appended chan struct{}
+}
+
+// NewServer 임의 포트에서 Server를 시작 (테스트 종료 시 중지)
+func NewServer(t testing.TB) *Server {
+	t.Helper()
+
+	s := &Server{
+		Org:      msptest.NewOrg(t, "OrdererMSP"),
+		blocks:   make(map[string][]*pb_common.Block),
+		receipts: make(map[string][]uint64),
+		appended: make(chan struct{}),
+	}
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	server := grpc.NewServer()
+	pb_orderer.RegisterOrdererServiceServer(server, s)
+	go server.Serve(lis)
+	t.Cleanup(server.Stop)
+
+	s.Address = lis.Addr().String()
+	return s
+}
+
+// NewChannel orgs를 application 조직으로 가진 채널의 설정 블록(블록 0)을 만들어 추가
+// 반환된 블록은 peer가 JoinChannelByBlock으로 채널에 참여할 때 사용할 수 있다.
+func (s *Server) NewChannel(t testing.TB, channelID string, orgs ...*msptest.Org) *pb_common.Block {
+	t.Helper()
+
+	channelConfig := &configtx.ChannelConfig{
+		CC: &configtx.AppChannelConfig{},
+		SCC: &configtx.SystemChannelInfo{
+			Orderer: configtx.SystemChannelConfig{
+				Organization: configtx.Organization{
+					Name:             "OrdererOrg",
+					ID:               s.Org.MSPID,
+					MSPCaCert:        s.Org.CACert.Raw,
+					OrdererEndpoints: []string{s.Address},
+				},
+			},
+		},
+	}
+	for _, org := range orgs {
+		channelConfig.CC.Organizations = append(channelConfig.CC.Organizations,
+			configtx.Organization{Name: org.MSPID, ID: org.MSPID, MSPCaCert: org.CACert.Raw})
+	}
+	configBytes, err := json.Marshal(channelConfig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	genesis, err := blockutil.GenerateConfigBlock(configBytes, channelID, s.Org.SigningIdentity())
+	if err != nil {
+		t.Fatalf("GenerateConfigBlock: %v", err)
+	}
+	if err := blockutil.SignBlock(genesis, s.Org.SigningIdentity()); err != nil {
+		t.Fatalf("SignBlock: %v", err)
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if len(s.blocks[channelID]) > 0 {
+		t.Fatalf("channel %s already exists", channelID)
+	}
+	s.appendLocked(channelID, genesis)
+	return genesis
+}
+
+// AppendBlocks 채널 끝에 트랜잭션 하나씩을 가진 서명된 데이터 블록 n개를 추가
+func (s *Server) AppendBlocks(t testing.TB, channelID string, n int) []*pb_common.Block {
+	t.Helper()
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	chain := s.blocks[channelID]
+	if len(chain) == 0 {
+		t.Fatalf("channel %s does not exist", channelID)
+	}
+	previous := chain[len(chain)-1]
+	blocks := make([]*pb_common.Block, 0, n)
+	for i := 0; i < n; i++ {
+		number := previous.Header.Number + 1
+		tx := &pb_common.Transaction{Payload: []byte(fmt.Sprintf("%s-tx-%d", channelID, number))}
+		block, err := blockutil.GenerateDataBlock([]*pb_common.Transaction{tx}, channelID, s.Org.SigningIdentity())
+		if err != nil {
+			t.Fatalf("GenerateDataBlock: %v", err)
+		}
+		blockutil.SetBlockPosition(block, number, blockutil.CalculateBlockHash(previous))
+		if err := blockutil.SignBlock(block, s.Org.SigningIdentity()); err != nil {
+			t.Fatalf("SignBlock: %v", err)
+		}
+		blocks = append(blocks, block)
+		previous = block
+	}
+	s.appendLocked(channelID, blocks...)
+	return blocks
+}
+
+// Blocks 채널에 추가된 블록 목록
+func (s *Server) Blocks(channelID string) []*pb_common.Block {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return append([]*pb_common.Block(nil), s.blocks[channelID]...)
+}
+
+// Receipts NotifyBlockReceived로 전달받은 채널의 블록 번호 (수신 순서)
+func (s *Server) Receipts(channelID string) []uint64 {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return append([]uint64(nil), s.receipts[channelID]...)
+}
+
+func (s *Server) appendLocked(channelID string, blocks ...*pb_common.Block) {
+	s.blocks[channelID] = append(s.blocks[channelID], blocks...)
+	close(s.appended)
+	s.appended = make(chan struct{})
+}
+
+func (s *Server) GetBlock(ctx context.Context, req *pb_orderer.BlockRequest) (*pb_orderer.BlockResponse, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	blocks := s.blocks[req.ChannelId]
+	if req.BlockNumber >= uint64(len(blocks)) {
+		return &pb_orderer.BlockResponse{Status: pb_common.Status_NOT_FOUND}, nil
+	}
+	return &pb_orderer.BlockResponse{Status: pb_common.Status_OK, Block: blocks[req.BlockNumber]}, nil
+}
+
+func (s *Server) GetBlocks(ctx context.Context, req *pb_orderer.BlockRangeRequest) (*pb_orderer.BlockRangeResponse, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	blocks := s.blocks[req.ChannelId]
+	if req.StartBlock > req.EndBlock {
+		return &pb_orderer.BlockRangeResponse{Status: pb_common.Status_INVALID_ARGUMENT}, nil
+	}
+	if req.EndBlock > uint64(len(blocks)) {
+		return &pb_orderer.BlockRangeResponse{Status: pb_common.Status_NOT_FOUND}, nil
+	}
+	return &pb_orderer.BlockRangeResponse{Status: pb_common.Status_OK, Blocks: blocks[req.StartBlock:req.EndBlock]}, nil
+}
+
+func (s *Server) GetChannelHeight(ctx context.Context, req *pb_orderer.ChannelHeightRequest) (*pb_orderer.ChannelHeightResponse, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	blocks, ok := s.blocks[req.ChannelId]
+	if !ok {
+		return &pb_orderer.ChannelHeightResponse{Status: pb_common.Status_NOT_FOUND}, nil
+	}
+	return &pb_orderer.ChannelHeightResponse{Status: pb_common.Status_OK, Height: uint64(len(blocks))}, nil
+}
+
+func (s *Server) NotifyBlockReceived(ctx context.Context, req *pb_orderer.BlockReceiptNotification) (*pb_orderer.BlockReceiptAck, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.receipts[req.ChannelId] = append(s.receipts[req.ChannelId], req.BlockNumber)
+	return &pb_orderer.BlockReceiptAck{Status: pb_common.Status_OK}, nil
+}
+
+// DeliverBlocks start_block부터 저장된 블록을 보낸 뒤 AppendBlocks로 추가되는 블록을 stream이 끝날 때까지 전달
+func (s *Server) DeliverBlocks(req *pb_orderer.DeliverRequest, stream pb_orderer.OrdererService_DeliverBlocksServer) error {
+	next := req.StartBlock
+	for {
+		s.mutex.Lock()
+		blocks := s.blocks[req.ChannelId]
+		var pending []*pb_common.Block
+		if next < uint64(len(blocks)) {
+			pending = blocks[next:]
+		}
+		appended := s.appended
+		s.mutex.Unlock()
+
+		for _, block := range pending {
+			if err := stream.Send(&pb_orderer.BlockResponse{Status: pb_common.Status_OK, Block: block}); err != nil {
+				return err
+			}
+			next++
+		}
+
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case <-appended:
+		}
+	}
+}
@@ -0,0 +1,171 @@
+package server
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ddr4869/minifab/common/admin"
+	"github.com/ddr4869/minifab/common/msp/msptest"
+	"github.com/ddr4869/minifab/orderer/auth"
+	pb_common "github.com/ddr4869/minifab/proto/common"
+	pb_orderer "github.com/ddr4869/minifab/proto/orderer"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/connectivity"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/keepalive"
+	"google.golang.org/grpc/status"
+)
+
+// serveWithOptions opts로 만든 gRPC 서버 옵션으로 ChainSupport를 임의 포트에서 서비스하고 주소 반환
+func serveWithOptions(t *testing.T, opts ...OrdererServerOption) string {
+	t.Helper()
+
+	o := newTestOrderer(t, "mychannel")
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	grpcServer := grpc.NewServer(NewOrdererServer(o, opts...).serverOptions()...)
+	pb_orderer.RegisterOrdererServiceServer(grpcServer, o.ChainSupport)
+	go grpcServer.Serve(lis)
+	t.Cleanup(grpcServer.Stop)
+	return lis.Addr().String()
+}
+
+// dialOrderer address의 orderer에 creds로 연결
+func dialOrderer(t *testing.T, address string, creds credentials.TransportCredentials) (*grpc.ClientConn, pb_orderer.OrdererServiceClient) {
+	t.Helper()
+
+	conn, err := grpc.NewClient(address, grpc.WithTransportCredentials(creds))
+	if err != nil {
+		t.Fatalf("grpc.NewClient: %v", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return conn, pb_orderer.NewOrdererServiceClient(conn)
+}
+
+// echo client로 Echo RPC를 호출하고 OK가 아니면 에러 반환
+func echo(client pb_orderer.OrdererServiceClient) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	response, err := client.Echo(ctx, &pb_orderer.EchoRequest{Payload: []byte("ping")})
+	if err == nil && response.Status != pb_common.Status_OK {
+		return status.Errorf(codes.Unknown, "echo status %s", response.Status)
+	}
+	return err
+}
+
+// rpcRecord RPCMetricsRecorder가 받은 RPC 하나
+type rpcRecord struct {
+	method string
+	code   codes.Code
+}
+
+// fakeMetricsRecorder 받은 RPC를 순서대로 기록하는 RPCMetricsRecorder
+type fakeMetricsRecorder struct {
+	mutex   sync.Mutex
+	records []rpcRecord
+}
+
+func (r *fakeMetricsRecorder) ObserveRPC(method string, code codes.Code, duration time.Duration) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.records = append(r.records, rpcRecord{method: method, code: code})
+}
+
+func TestWithMetrics(t *testing.T) {
+	recorder := &fakeMetricsRecorder{}
+	_, client := dialOrderer(t, serveWithOptions(t, WithMetrics(recorder)), insecure.NewCredentials())
+
+	if err := echo(client); err != nil {
+		t.Fatalf("Echo: %v", err)
+	}
+
+	recorder.mutex.Lock()
+	defer recorder.mutex.Unlock()
+	want := rpcRecord{method: pb_orderer.OrdererService_Echo_FullMethodName, code: codes.OK}
+	if len(recorder.records) != 1 || recorder.records[0] != want {
+		t.Fatalf("recorded RPCs = %+v, want [%+v]", recorder.records, want)
+	}
+}
+
+func TestWithMetricsPrometheusAdapter(t *testing.T) {
+	metrics := auth.NewPrometheusRPCMetrics()
+	srv := NewOrdererServer(newTestOrderer(t, "mychannel"), WithMetrics(metrics))
+	_, client := dialOrderer(t, serveWithOptions(t, WithMetrics(metrics)), insecure.NewCredentials())
+	if err := echo(client); err != nil {
+		t.Fatalf("Echo: %v", err)
+	}
+
+	recorder := httptest.NewRecorder()
+	srv.metricsHandler().ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, admin.MetricsPath, nil))
+	body := recorder.Body.String()
+	for _, want := range []string{
+		"# TYPE minifab_orderer_block_ack_count gauge",
+		fmt.Sprintf("minifab_orderer_grpc_requests_total{method=%q,code=\"OK\"} 1", pb_orderer.OrdererService_Echo_FullMethodName),
+	} {
+		if !strings.Contains(body, want) {
+			t.Errorf("/metrics is missing %q:\n%s", want, body)
+		}
+	}
+}
+
+func TestWithRateLimit(t *testing.T) {
+	_, client := dialOrderer(t, serveWithOptions(t, WithRateLimit(0.001, 2)), insecure.NewCredentials())
+
+	for i := 0; i < 2; i++ {
+		if err := echo(client); err != nil {
+			t.Fatalf("Echo %d within burst: %v", i, err)
+		}
+	}
+	if err := echo(client); status.Code(err) != codes.ResourceExhausted {
+		t.Fatalf("Echo over the limit = %v, want ResourceExhausted", err)
+	}
+}
+
+func TestWithTLS(t *testing.T) {
+	org := msptest.NewOrg(t, "OrdererMSP")
+	cert, key := org.IssueTLS(t, "orderer0", "127.0.0.1")
+	address := serveWithOptions(t, WithTLS(&tls.Config{
+		Certificates: []tls.Certificate{{Certificate: [][]byte{cert.Raw}, PrivateKey: key}},
+	}))
+
+	roots := x509.NewCertPool()
+	roots.AddCert(org.CACert)
+	_, client := dialOrderer(t, address, credentials.NewTLS(&tls.Config{RootCAs: roots}))
+	if err := echo(client); err != nil {
+		t.Fatalf("Echo over TLS: %v", err)
+	}
+
+	_, plaintext := dialOrderer(t, address, insecure.NewCredentials())
+	if err := echo(plaintext); err == nil {
+		t.Fatal("plaintext Echo succeeded against a TLS server")
+	}
+}
+
+func TestWithKeepalive(t *testing.T) {
+	params := keepalive.ServerParameters{MaxConnectionAge: 200 * time.Millisecond, MaxConnectionAgeGrace: 100 * time.Millisecond}
+	conn, client := dialOrderer(t, serveWithOptions(t, WithKeepalive(params)), insecure.NewCredentials())
+	if err := echo(client); err != nil {
+		t.Fatalf("Echo: %v", err)
+	}
+
+	// MaxConnectionAge가 지나면 서버가 연결을 닫으므로 Ready 상태를 벗어난다
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+	if !conn.WaitForStateChange(ctx, connectivity.Ready) {
+		t.Fatal("connection stayed ready past MaxConnectionAge")
+	}
+}
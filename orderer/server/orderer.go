@@ -2,19 +2,17 @@ package server
 
 import (
 	"context"
-	"net"
 	"os"
 	"os/signal"
 	"sync"
 	"syscall"
 
-	"github.com/ddr4869/minifab/common/configtx"
 	"github.com/ddr4869/minifab/common/logger"
 	"github.com/ddr4869/minifab/common/msp"
 	"github.com/ddr4869/minifab/config"
+	"github.com/ddr4869/minifab/orderer/auth"
 	"github.com/ddr4869/minifab/orderer/channel"
 	pb_orderer "github.com/ddr4869/minifab/proto/orderer"
-	"github.com/pkg/errors"
 	"google.golang.org/grpc"
 )
 
@@ -22,6 +20,8 @@ type Orderer struct {
 	Mutex         sync.RWMutex
 	OrdererConfig *config.OrdererCfg
 	ChainSupport  *channel.ChainSupport
+	// Channels ChainSupport와 공유하는 application 채널 registry
+	Channels *channel.ChannelRegistry
 	pb_orderer.UnimplementedOrdererServiceServer
 	Server *grpc.Server
 }
@@ -44,22 +44,55 @@ func NewOrderer(ordererId, mspID, mspPath, ordererAddress, genesisPath string) (
 		logger.Errorf("Failed to create MSP from files: %v", err)
 		return nil, err
 	}
+
+	return NewOrdererWithConfig(ordererConfig, fabricMSP), nil
+}
+
+// NewOrdererWithMSP 미리 생성된 MSP와 기본 설정으로 Orderer 생성 (MSP 파일과 설정 파일 불필요)
+// 기본 설정에는 GenesisPath와 FilesystemPath가 없으므로 시스템 채널/기존 채널을 로드하지 않는다.
+func NewOrdererWithMSP(mspID string, fabricMSP msp.MSP) *Orderer {
+	return NewOrdererWithConfig(&config.OrdererCfg{
+		MSPID:        mspID,
+		DrainTimeout: config.DefaultDrainTimeout,
+	}, fabricMSP)
+}
+
+// NewOrdererWithConfig 미리 생성된 MSP와 주어진 설정으로 Orderer 생성
+// GenesisPath, FilesystemPath가 비어있으면 시스템 채널/기존 채널 로드를 생략한다.
+func NewOrdererWithConfig(ordererConfig *config.OrdererCfg, fabricMSP msp.MSP) *Orderer {
 	ordererConfig.MSP = fabricMSP
 
+	channels := channel.NewChannelRegistry()
 	cs := &channel.ChainSupport{
-		OrdererConfig:     ordererConfig,
-		AppChannelConfigs: make(map[string]*configtx.ChannelConfig),
+		OrdererConfig: ordererConfig,
+		Channels:      channels,
+		PendingQueue:  channel.NewFairQueue(),
+		BlockAcks:     channel.NewBlockAckStore(),
+		Sequences:     channel.NewSequenceStore(ordererConfig.FilesystemPath),
+		Broadcaster:   channel.NewBlockBroadcaster(),
+	}
+	if ordererConfig.GenesisPath != "" {
+		cs.LoadSystemChannelConfig(ordererConfig.GenesisPath)
+	}
+	if ordererConfig.FilesystemPath != "" {
+		cs.LoadExistingChannels(ordererConfig.FilesystemPath)
 	}
-	cs.LoadSystemChannelConfig(genesisPath)
-	cs.LoadExistingChannels(ordererConfig.FilesystemPath)
+	cs.Cutter = channel.NewBlockCutter(cs)
 
 	return &Orderer{
 		OrdererConfig: ordererConfig,
 		ChainSupport:  cs,
+		Channels:      channels,
 		Server:        grpc.NewServer(),
-	}, nil
+	}
+}
+
+// GetChannels orderer가 관리하는 application 채널 ID를 오름차순으로 반환
+func (s *Orderer) GetChannels() []string {
+	return s.Channels.List()
 }
 
+// Start 기본 설정의 OrdererServer를 시작하고 SIGINT/SIGTERM을 받으면 종료
 func (s *Orderer) Start(address string) error {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
@@ -73,24 +106,21 @@ func (s *Orderer) Start(address string) error {
 		cancel()
 	}()
 
-	lis, err := net.Listen("tcp", address)
-	if err != nil {
-		return errors.Wrap(err, "failed to listen")
+	var opts []OrdererServerOption
+	if s.OrdererConfig.AdminAddress != "" {
+		// admin 서버의 /metrics에서 RPC metric도 수집할 수 있도록 기록
+		opts = append(opts, WithMetrics(auth.NewPrometheusRPCMetrics()))
 	}
+	return NewOrdererServer(s, opts...).StartWithContext(ctx, address)
+}
 
-	logger.Infof("Orderer server listening on %s", address)
-
-	s.Server = grpc.NewServer()
-	pb_orderer.RegisterOrdererServiceServer(s.Server, s.ChainSupport)
-
-	go func() {
-		if err := s.Server.Serve(lis); err != nil {
-			logger.Errorf("Server error: %v", err)
-		}
-	}()
-
-	<-ctx.Done()
-	s.Server.GracefulStop()
-	logger.Info("Orderer server stopped gracefully")
-	return nil
+// drain 종료 전 대기 중인 트랜잭션을 부분 블록으로 저장 (최대 DrainTimeout 대기)
+func (s *Orderer) drain() {
+	timeout := s.OrdererConfig.DrainTimeout
+	if timeout <= 0 {
+		timeout = config.DefaultDrainTimeout
+	}
+	if err := s.ChainSupport.Cutter.Drain(timeout); err != nil {
+		logger.Errorf("Failed to drain pending transactions: %v", err)
+	}
 }
@@ -0,0 +1,126 @@
+package server
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ddr4869/minifab/common/blockutil"
+	"github.com/ddr4869/minifab/common/configtx"
+	"github.com/ddr4869/minifab/common/msp/msptest"
+	"github.com/ddr4869/minifab/config"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestNewOrdererWithMSPWithoutFiles(t *testing.T) {
+	org := msptest.NewOrg(t, "OrdererMSP")
+	o := NewOrdererWithMSP("OrdererMSP", org.MSP)
+
+	if o.OrdererConfig.MSP != org.MSP || o.OrdererConfig.MSPID != "OrdererMSP" {
+		t.Errorf("OrdererConfig MSP ID = %s, want OrdererMSP with the given MSP", o.OrdererConfig.MSPID)
+	}
+	if o.OrdererConfig.DrainTimeout != config.DefaultDrainTimeout {
+		t.Errorf("DrainTimeout = %s, want %s", o.OrdererConfig.DrainTimeout, config.DefaultDrainTimeout)
+	}
+	if o.ChainSupport.SystemChannelInfo != nil {
+		t.Errorf("SystemChannelInfo = %+v, want nil without GenesisPath", o.ChainSupport.SystemChannelInfo)
+	}
+	if o.ChainSupport.Cutter == nil || o.ChainSupport.PendingQueue == nil {
+		t.Error("ChainSupport is not fully initialized")
+	}
+	if o.Channels != o.ChainSupport.Channels {
+		t.Error("Orderer and ChainSupport do not share the channel registry")
+	}
+	if channels := o.GetChannels(); len(channels) != 0 {
+		t.Errorf("GetChannels() = %v, want none", channels)
+	}
+
+	// ChainSupport에 등록된 채널이 Orderer에서 정렬되어 보인다
+	o.ChainSupport.Channels.Add("channel2", &configtx.ChannelConfig{})
+	o.ChainSupport.Channels.Add("channel1", &configtx.ChannelConfig{})
+	if channels := o.GetChannels(); len(channels) != 2 || channels[0] != "channel1" || channels[1] != "channel2" {
+		t.Errorf("GetChannels() = %v, want [channel1 channel2]", channels)
+	}
+}
+
+func TestNewOrdererWithConfigBootstrapsFromDisk(t *testing.T) {
+	ordererOrg := msptest.NewOrg(t, "OrdererMSP")
+	peerOrg := msptest.NewOrg(t, "Org1MSP")
+	scc := &configtx.SystemChannelInfo{
+		Orderer: configtx.SystemChannelConfig{
+			BatchTimeout: "2s",
+			BatchSize:    configtx.BatchSize{MaxMessageCount: 10},
+			Organization: configtx.Organization{Name: "OrdererOrg", ID: "OrdererMSP", MSPCaCert: ordererOrg.CACert.Raw},
+		},
+		Consortiums: []configtx.Organization{{Name: "Org1", ID: "Org1MSP", MSPCaCert: peerOrg.CACert.Raw}},
+	}
+
+	// 시스템 채널 제네시스 블록
+	sccBytes, err := json.Marshal(scc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	systemGenesis, err := blockutil.GenerateConfigBlock(sccBytes, "system-channel", ordererOrg.SigningIdentity())
+	if err != nil {
+		t.Fatalf("GenerateConfigBlock: %v", err)
+	}
+	genesisBytes, err := proto.Marshal(systemGenesis)
+	if err != nil {
+		t.Fatal(err)
+	}
+	genesisPath := filepath.Join(t.TempDir(), "genesis.block")
+	if err := os.WriteFile(genesisPath, genesisBytes, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	// 이전 실행에서 만들어진 application 채널
+	filesystemPath := t.TempDir()
+	channelConfig := &configtx.ChannelConfig{
+		CC:  &configtx.AppChannelConfig{Organizations: scc.Consortiums},
+		SCC: scc,
+	}
+	configBytes, err := json.Marshal(channelConfig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	channelGenesis, err := blockutil.GenerateConfigBlock(configBytes, "mychannel", ordererOrg.SigningIdentity())
+	if err != nil {
+		t.Fatalf("GenerateConfigBlock: %v", err)
+	}
+	if err := blockutil.SaveBlockFile(channelGenesis, "mychannel", filesystemPath); err != nil {
+		t.Fatalf("SaveBlockFile: %v", err)
+	}
+
+	o := NewOrdererWithConfig(&config.OrdererCfg{
+		MSPID:          "OrdererMSP",
+		GenesisPath:    genesisPath,
+		FilesystemPath: filesystemPath,
+	}, ordererOrg.MSP)
+
+	cs := o.ChainSupport
+	if cs.SystemChannelInfo == nil {
+		t.Fatal("SystemChannelInfo was not loaded from the genesis block")
+	}
+	if cs.SystemChannelInfo.Orderer.BatchTimeout != "2s" || len(cs.SystemChannelInfo.Consortiums) != 1 {
+		t.Errorf("SystemChannelInfo = %+v", cs.SystemChannelInfo)
+	}
+	if cs.MSPManager == nil {
+		t.Fatal("MSPManager was not built from the consortium")
+	}
+	if _, err := cs.MSPManager.GetMSP("Org1MSP"); err != nil {
+		t.Errorf("GetMSP(Org1MSP): %v", err)
+	}
+
+	if channels := o.GetChannels(); len(channels) != 1 || channels[0] != "mychannel" {
+		t.Fatalf("GetChannels() = %v, want [mychannel]", channels)
+	}
+	stored, err := blockutil.LoadBlock(filepath.Join(filesystemPath, "mychannel", "blockfile0"))
+	if err != nil {
+		t.Fatalf("LoadBlock: %v", err)
+	}
+	if !bytes.Equal(blockutil.CalculateBlockHash(stored), blockutil.CalculateBlockHash(channelGenesis)) {
+		t.Error("stored channel genesis hash differs from the generated block")
+	}
+}
@@ -0,0 +1,162 @@
+package server
+
+import (
+	"context"
+	"crypto/tls"
+	"io"
+	"net"
+	"net/http"
+
+	"github.com/ddr4869/minifab/common/admin"
+	"github.com/ddr4869/minifab/common/logger"
+	"github.com/ddr4869/minifab/orderer/auth"
+	"github.com/ddr4869/minifab/orderer/channel"
+	pb_orderer "github.com/ddr4869/minifab/proto/orderer"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/keepalive"
+)
+
+// ordererServerConfig OrdererServerOption으로 채워지는 gRPC 서버 설정
+type ordererServerConfig struct {
+	metrics   auth.RPCMetricsRecorder
+	tlsConfig *tls.Config
+	rateLimit *auth.RateLimiter
+	keepalive *keepalive.ServerParameters
+}
+
+// OrdererServerOption NewOrdererServer의 gRPC 서버 설정 옵션
+type OrdererServerOption func(*ordererServerConfig)
+
+// WithMetrics 모든 RPC의 결과 코드와 처리 시간을 recorder에 기록
+func WithMetrics(recorder auth.RPCMetricsRecorder) OrdererServerOption {
+	return func(config *ordererServerConfig) {
+		config.metrics = recorder
+	}
+}
+
+// WithTLS TLS로 gRPC 연결을 받음
+func WithTLS(tlsConfig *tls.Config) OrdererServerOption {
+	return func(config *ordererServerConfig) {
+		config.tlsConfig = tlsConfig
+	}
+}
+
+// WithRateLimit 서버 전체 RPC를 초당 rps개, 최대 burst개까지 허용 (초과 시 ResourceExhausted)
+func WithRateLimit(rps float64, burst int) OrdererServerOption {
+	return func(config *ordererServerConfig) {
+		config.rateLimit = auth.NewRateLimiter(rps, burst)
+	}
+}
+
+// WithKeepalive 서버 keepalive 설정
+func WithKeepalive(params keepalive.ServerParameters) OrdererServerOption {
+	return func(config *ordererServerConfig) {
+		config.keepalive = &params
+	}
+}
+
+// OrdererServer Orderer를 gRPC로 노출하는 서버
+type OrdererServer struct {
+	orderer *Orderer
+	config  ordererServerConfig
+}
+
+func NewOrdererServer(orderer *Orderer, opts ...OrdererServerOption) *OrdererServer {
+	server := &OrdererServer{orderer: orderer}
+	for _, opt := range opts {
+		opt(&server.config)
+	}
+	return server
+}
+
+// serverOptions 설정으로부터 grpc.ServerOption 목록 생성
+// interceptor는 logging, metrics, rate limit 순서로 실행된다.
+func (srv *OrdererServer) serverOptions() []grpc.ServerOption {
+	unary := []grpc.UnaryServerInterceptor{auth.UnaryLoggingInterceptor()}
+	stream := []grpc.StreamServerInterceptor{auth.StreamLoggingInterceptor()}
+	if srv.config.metrics != nil {
+		unary = append(unary, auth.UnaryMetricsInterceptor(srv.config.metrics))
+		stream = append(stream, auth.StreamMetricsInterceptor(srv.config.metrics))
+	}
+	if srv.config.rateLimit != nil {
+		unary = append(unary, auth.UnaryRateLimitInterceptor(srv.config.rateLimit))
+		stream = append(stream, auth.StreamRateLimitInterceptor(srv.config.rateLimit))
+	}
+
+	options := []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(unary...),
+		grpc.ChainStreamInterceptor(stream...),
+	}
+	if srv.config.tlsConfig != nil {
+		options = append(options, grpc.Creds(credentials.NewTLS(srv.config.tlsConfig)))
+	}
+	if srv.config.keepalive != nil {
+		options = append(options, grpc.KeepaliveParams(*srv.config.keepalive))
+	}
+	return options
+}
+
+// metricsWriter Prometheus text 형식으로 metric을 기록하는 수집기 (auth.PrometheusRPCMetrics 등)
+type metricsWriter interface {
+	WriteMetrics(w io.Writer)
+}
+
+// metricsHandler admin 서버의 /metrics endpoint 핸들러
+// block ack gauge를 기록하고, WithMetrics의 recorder가 metricsWriter이면 RPC metric도 함께 기록한다.
+func (srv *OrdererServer) metricsHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/plain; version=0.0.4")
+		srv.orderer.ChainSupport.BlockAcks.WriteMetrics(w)
+		if writer, ok := srv.config.metrics.(metricsWriter); ok {
+			writer.WriteMetrics(w)
+		}
+	})
+}
+
+// StartWithContext address에서 gRPC(와 설정된 경우 admin HTTP) 서버를 시작하고 ctx가 끝나면 정리 후 반환
+func (srv *OrdererServer) StartWithContext(ctx context.Context, address string) error {
+	s := srv.orderer
+
+	lis, err := net.Listen("tcp", address)
+	if err != nil {
+		return errors.Wrap(err, "failed to listen")
+	}
+
+	logger.Infof("Orderer server listening on %s", address)
+
+	s.Server = grpc.NewServer(srv.serverOptions()...)
+	pb_orderer.RegisterOrdererServiceServer(s.Server, s.ChainSupport)
+	healthServer := health.NewServer()
+	healthpb.RegisterHealthServer(s.Server, healthServer)
+
+	go func() {
+		if err := s.Server.Serve(lis); err != nil {
+			logger.Errorf("Server error: %v", err)
+		}
+	}()
+
+	if s.OrdererConfig.AdminAddress != "" {
+		adminServer := admin.NewServer(s.OrdererConfig.AdminAddress)
+		adminServer.Handle(admin.MetricsPath, srv.metricsHandler())
+		adminServer.Handle(channel.ChannelConfigPath, s.ChainSupport.ChannelConfigHandler())
+		adminServer.Handle(channel.SystemConfigPath, s.ChainSupport.SystemConfigHandler())
+		if err := adminServer.Start(); err != nil {
+			s.Server.Stop()
+			return err
+		}
+		defer adminServer.Stop()
+	}
+
+	s.ChainSupport.Cutter.Start()
+
+	<-ctx.Done()
+	healthServer.Shutdown()
+	s.drain()
+	s.Server.GracefulStop()
+	logger.Info("Orderer server stopped gracefully")
+	return nil
+}
@@ -0,0 +1,110 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/ddr4869/minifab/common/blockutil"
+	"github.com/ddr4869/minifab/common/configtx"
+	"github.com/ddr4869/minifab/common/msp/msptest"
+	"github.com/ddr4869/minifab/config"
+	"github.com/ddr4869/minifab/orderer/channel"
+	pb_common "github.com/ddr4869/minifab/proto/common"
+)
+
+// newTestOrderer 임시 디렉터리에 채널 하나(MaxMessageCount 100)를 가진 Orderer
+func newTestOrderer(t *testing.T, channelID string) *Orderer {
+	t.Helper()
+
+	org := msptest.NewOrg(t, "OrdererMSP")
+	o := NewOrdererWithConfig(&config.OrdererCfg{MSPID: "OrdererMSP", FilesystemPath: t.TempDir()}, org.MSP)
+
+	cs := o.ChainSupport
+	cs.SystemChannelInfo = &configtx.SystemChannelInfo{
+		Orderer: configtx.SystemChannelConfig{
+			BatchTimeout: "1h",
+			BatchSize:    configtx.BatchSize{MaxMessageCount: 100},
+		},
+	}
+	cs.Cutter = channel.NewBlockCutter(cs)
+
+	channelConfig := &configtx.ChannelConfig{CC: &configtx.AppChannelConfig{}, SCC: cs.SystemChannelInfo}
+	configBytes, err := json.Marshal(channelConfig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	genesis, err := blockutil.GenerateConfigBlock(configBytes, channelID, org.SigningIdentity())
+	if err != nil {
+		t.Fatalf("GenerateConfigBlock: %v", err)
+	}
+	if err := blockutil.SaveBlockFile(genesis, channelID, o.OrdererConfig.FilesystemPath); err != nil {
+		t.Fatalf("SaveBlockFile: %v", err)
+	}
+	if err := cs.Sequences.Commit(channelID, 0); err != nil {
+		t.Fatalf("Commit: %v", err)
+	}
+	o.Channels.Add(channelID, channelConfig)
+	return o
+}
+
+// enqueueTransactions 채널 큐에 트랜잭션 count개를 직접 추가 (서명 검증을 거치지 않음)
+func enqueueTransactions(t *testing.T, o *Orderer, channelID string, count int) {
+	t.Helper()
+
+	for i := 0; i < count; i++ {
+		tx := &pb_common.Transaction{
+			TxId:      fmt.Sprintf("tx-%d", i),
+			Payload:   []byte(fmt.Sprintf("payload-%d", i)),
+			Timestamp: time.Now().Unix(),
+			Type:      pb_common.MessageType_MESSAGE_TYPE_TRANSACTION,
+		}
+		txBytes, err := blockutil.MarshalTransactionToProto(tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		payloadBytes, err := blockutil.MarshalPayloadToProto(&pb_common.Payload{
+			Header: &pb_common.Header{Type: pb_common.MessageType_MESSAGE_TYPE_TRANSACTION, ChannelId: channelID},
+			Data:   txBytes,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		o.ChainSupport.PendingQueue.Enqueue(channelID, []byte("client"), &pb_common.Envelope{Payload: payloadBytes})
+	}
+}
+
+func TestStartWithContextDrainsPartialBlockOnShutdown(t *testing.T) {
+	o := newTestOrderer(t, "mychannel")
+	enqueueTransactions(t, o, "mychannel", 10)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() {
+		done <- NewOrdererServer(o).StartWithContext(ctx, "127.0.0.1:0")
+	}()
+	time.Sleep(100 * time.Millisecond)
+	if height := blockutil.GetBlockHeight("mychannel", o.OrdererConfig.FilesystemPath); height != 1 {
+		t.Fatalf("block cut before shutdown: height = %d", height)
+	}
+
+	cancel()
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatalf("StartWithContext: %v", err)
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatal("server did not stop")
+	}
+
+	block, err := blockutil.LoadBlock(fmt.Sprintf("%s/mychannel/blockfile1", o.OrdererConfig.FilesystemPath))
+	if err != nil {
+		t.Fatalf("partial block was not written: %v", err)
+	}
+	if count := blockutil.GetBlockTransactionCount(block); count != 10 {
+		t.Fatalf("partial block has %d transactions, want 10", count)
+	}
+}
@@ -2,20 +2,24 @@ package server
 
 import (
 	"os"
+	"time"
 
 	"github.com/ddr4869/minifab/common/logger"
 	"github.com/ddr4869/minifab/orderer/bootstrap"
+	"github.com/ddr4869/minifab/orderer/channel"
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 )
 
 var (
-	ordererId   string
-	address     string
-	mspID       string
-	mspPath     string
-	genesisFile string
-	profile     string
+	ordererId    string
+	address      string
+	mspID        string
+	mspPath      string
+	genesisFile  string
+	profile      string
+	adminAddr    string
+	drainTimeout time.Duration
 )
 
 // rootCmd는 orderer의 루트 명령어를 나타냅니다
@@ -35,8 +39,11 @@ func init() {
 	RootCmd.Flags().StringVar(&mspPath, "mspdir", "/Users/mac/go/src/github.com/ddr4869/minifab/ca/OrdererOrg/ca-client/orderer0", "Path to MSP directory with certificates")
 	RootCmd.Flags().StringVar(&genesisFile, "genesisFile", "/Users/mac/go/src/github.com/ddr4869/minifab/nodedata/orderer0/genesis.block", "Path to genesis block file")
 	RootCmd.Flags().StringVar(&profile, "profile", "SystemChannel", "Profile name to use for genesis block")
+	RootCmd.Flags().StringVar(&adminAddr, "adminAddress", "", "Admin HTTP server address (disabled if empty, overrides ORDERER_ADMIN_ADDRESS)")
+	RootCmd.Flags().DurationVar(&drainTimeout, "drain-timeout", 0, "Time to wait for pending transactions to be written on shutdown (default 5s, overrides ORDERER_DRAIN_TIMEOUT)")
 
 	RootCmd.AddCommand(bootstrap.Cmd())
+	RootCmd.AddCommand(channel.Cmd())
 }
 
 func runOrderer(cmd *cobra.Command, args []string) {
@@ -52,6 +59,12 @@ func runOrderer(cmd *cobra.Command, args []string) {
 	if err != nil {
 		logger.Fatalf("Failed to create orderer: %v", err)
 	}
+	if adminAddr != "" {
+		node.OrdererConfig.AdminAddress = adminAddr
+	}
+	if drainTimeout > 0 {
+		node.OrdererConfig.DrainTimeout = drainTimeout
+	}
 
 	logger.Infof("Starting orderer server on %s with MSP ID: %s", address, mspID)
 	if err := node.Start(address); err != nil {
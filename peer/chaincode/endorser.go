@@ -0,0 +1,27 @@
+package chaincode
+
+import (
+	"github.com/ddr4869/minifab/common/logger"
+	"github.com/pkg/errors"
+)
+
+// Endorser는 클라이언트의 제안을 체인코드로 실행한다.
+type Endorser struct {
+	Registry *ChaincodeRegistry
+}
+
+func NewEndorser(registry *ChaincodeRegistry) *Endorser {
+	return &Endorser{
+		Registry: registry,
+	}
+}
+
+// SimulateTransaction 채널의 world state에 대해 체인코드를 실행하고 결과 반환
+func (e *Endorser) SimulateTransaction(channelID, chaincodeName string, args [][]byte) ([]byte, error) {
+	result, err := e.Registry.Invoke(channelID, chaincodeName, args)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to simulate transaction on channel %s", channelID)
+	}
+	logger.Debugf("[Peer] Simulated chaincode %s on channel %s", chaincodeName, channelID)
+	return result, nil
+}
@@ -0,0 +1,47 @@
+package chaincode
+
+import (
+	"encoding/json"
+	"sort"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// LifecycleName 체인코드 설치/승인을 관리하는 시스템 체인코드 이름
+	LifecycleName = "_lifecycle"
+
+	approvalKeyPrefix = "_lifecycle/approval/"
+)
+
+// lifecycle _lifecycle 시스템 체인코드 stub
+//   - queryInstalledChaincode: 설치된 체인코드 이름 목록(JSON 배열) 반환
+//   - approveForMyOrg <name> <version>: 설치된 체인코드의 버전 승인을 world state에 기록
+func (r *ChaincodeRegistry) lifecycle(args [][]byte, state WorldStateInterface) ([]byte, error) {
+	if len(args) == 0 {
+		return nil, errors.New("lifecycle function name is required")
+	}
+
+	switch function := string(args[0]); function {
+	case "queryInstalledChaincode":
+		names := r.installedChaincodes()
+		sort.Strings(names)
+		return json.Marshal(names)
+
+	case "approveForMyOrg":
+		if len(args) != 3 {
+			return nil, errors.New("approveForMyOrg requires chaincode name and version")
+		}
+		name, version := string(args[1]), args[2]
+		if !r.isInstalled(name) {
+			return nil, errors.Errorf("chaincode not installed: %s", name)
+		}
+		if err := state.PutState(approvalKeyPrefix+name, version); err != nil {
+			return nil, errors.Wrap(err, "failed to store approval")
+		}
+		return version, nil
+
+	default:
+		return nil, errors.Errorf("unknown lifecycle function: %s", function)
+	}
+}
@@ -0,0 +1,107 @@
+package chaincode
+
+import (
+	"path/filepath"
+	"sync"
+
+	"github.com/ddr4869/minifab/common/logger"
+	"github.com/pkg/errors"
+)
+
+// ChaincodeFunc 체인코드 로직 (args[0]은 보통 호출할 함수 이름)
+type ChaincodeFunc func(args [][]byte, state WorldStateInterface) ([]byte, error)
+
+// ChaincodeRegistry는 peer에 설치된 체인코드와 채널별 world state를 관리한다.
+// world state는 <stateDir>/<channelID>.json 파일에 저장된다.
+type ChaincodeRegistry struct {
+	mutex       sync.RWMutex
+	stateDir    string
+	chaincodes  map[string]ChaincodeFunc
+	worldStates map[string]*WorldState
+}
+
+// NewChaincodeRegistry 기본 체인코드(_lifecycle)가 등록된 레지스트리 생성
+func NewChaincodeRegistry(stateDir string) *ChaincodeRegistry {
+	r := &ChaincodeRegistry{
+		stateDir:    stateDir,
+		chaincodes:  make(map[string]ChaincodeFunc),
+		worldStates: make(map[string]*WorldState),
+	}
+	r.Register(LifecycleName, r.lifecycle)
+	return r
+}
+
+// Register 체인코드 등록 (같은 이름이 있으면 교체)
+func (r *ChaincodeRegistry) Register(name string, fn ChaincodeFunc) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if _, exists := r.chaincodes[name]; exists {
+		logger.Warnf("[Peer] Replacing registered chaincode: %s", name)
+	}
+	r.chaincodes[name] = fn
+}
+
+// Invoke 채널의 world state에 대해 체인코드 실행
+func (r *ChaincodeRegistry) Invoke(channelID, name string, args [][]byte) ([]byte, error) {
+	if channelID == "" {
+		return nil, errors.New("channel ID cannot be empty")
+	}
+
+	r.mutex.RLock()
+	fn, exists := r.chaincodes[name]
+	r.mutex.RUnlock()
+	if !exists {
+		return nil, errors.Errorf("chaincode not found: %s", name)
+	}
+
+	state, err := r.GetWorldState(channelID)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := fn(args, state)
+	if err != nil {
+		return nil, errors.Wrapf(err, "chaincode %s failed", name)
+	}
+	return result, nil
+}
+
+// GetWorldState 채널의 world state 반환 (처음 접근 시 파일에서 로드)
+func (r *ChaincodeRegistry) GetWorldState(channelID string) (*WorldState, error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if state, exists := r.worldStates[channelID]; exists {
+		return state, nil
+	}
+
+	state, err := NewWorldState(filepath.Join(r.stateDir, channelID+".json"))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to load world state of channel %s", channelID)
+	}
+	r.worldStates[channelID] = state
+	return state, nil
+}
+
+// installedChaincodes _lifecycle을 제외한 등록된 체인코드 이름 목록
+func (r *ChaincodeRegistry) installedChaincodes() []string {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	names := make([]string, 0, len(r.chaincodes))
+	for name := range r.chaincodes {
+		if name != LifecycleName {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+func (r *ChaincodeRegistry) isInstalled(name string) bool {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	_, exists := r.chaincodes[name]
+	return exists && name != LifecycleName
+}
@@ -0,0 +1,114 @@
+package chaincode
+
+import (
+	"encoding/json"
+	"strconv"
+	"testing"
+)
+
+// counterChaincode "increment <key>"로 key의 정수 값을 1 증가시키고 새 값을 반환
+func counterChaincode(args [][]byte, state WorldStateInterface) ([]byte, error) {
+	key := string(args[1])
+	current, err := state.GetState(key)
+	if err != nil {
+		return nil, err
+	}
+	count := 0
+	if current != nil {
+		if count, err = strconv.Atoi(string(current)); err != nil {
+			return nil, err
+		}
+	}
+	next := []byte(strconv.Itoa(count + 1))
+	if err := state.PutState(key, next); err != nil {
+		return nil, err
+	}
+	return next, nil
+}
+
+func TestEndorserInvokesCounterChaincode(t *testing.T) {
+	stateDir := t.TempDir()
+	registry := NewChaincodeRegistry(stateDir)
+	registry.Register("counter", counterChaincode)
+	endorser := NewEndorser(registry)
+
+	args := [][]byte{[]byte("increment"), []byte("visits")}
+	for want := 1; want <= 2; want++ {
+		result, err := endorser.SimulateTransaction("mychannel", "counter", args)
+		if err != nil {
+			t.Fatalf("SimulateTransaction %d: %v", want, err)
+		}
+		if string(result) != strconv.Itoa(want) {
+			t.Errorf("invocation %d returned %s, want %d", want, result, want)
+		}
+	}
+
+	state, err := registry.GetWorldState("mychannel")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if value, _ := state.GetState("visits"); string(value) != "2" {
+		t.Errorf("visits = %q, want 2", value)
+	}
+
+	// 다른 채널의 world state는 분리되어 있고, 새 레지스트리도 파일에서 같은 값을 읽는다
+	other, err := registry.GetWorldState("otherchannel")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if value, _ := other.GetState("visits"); value != nil {
+		t.Errorf("otherchannel visits = %q, want none", value)
+	}
+	reloaded, err := NewChaincodeRegistry(stateDir).GetWorldState("mychannel")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if value, _ := reloaded.GetState("visits"); string(value) != "2" {
+		t.Errorf("reloaded visits = %q, want 2", value)
+	}
+}
+
+func TestInvokeErrors(t *testing.T) {
+	registry := NewChaincodeRegistry(t.TempDir())
+	if _, err := registry.Invoke("mychannel", "missing", nil); err == nil {
+		t.Error("Invoke succeeded for an unregistered chaincode")
+	}
+	if _, err := registry.Invoke("", LifecycleName, [][]byte{[]byte("queryInstalledChaincode")}); err == nil {
+		t.Error("Invoke succeeded without a channel ID")
+	}
+}
+
+func TestLifecycleChaincode(t *testing.T) {
+	registry := NewChaincodeRegistry(t.TempDir())
+	registry.Register("counter", counterChaincode)
+	registry.Register("asset", counterChaincode)
+
+	result, err := registry.Invoke("mychannel", LifecycleName, [][]byte{[]byte("queryInstalledChaincode")})
+	if err != nil {
+		t.Fatalf("queryInstalledChaincode: %v", err)
+	}
+	var names []string
+	if err := json.Unmarshal(result, &names); err != nil {
+		t.Fatal(err)
+	}
+	if len(names) != 2 || names[0] != "asset" || names[1] != "counter" {
+		t.Errorf("installed chaincodes = %v, want [asset counter]", names)
+	}
+
+	approve := [][]byte{[]byte("approveForMyOrg"), []byte("counter"), []byte("1.0")}
+	if _, err := registry.Invoke("mychannel", LifecycleName, approve); err != nil {
+		t.Fatalf("approveForMyOrg: %v", err)
+	}
+	state, _ := registry.GetWorldState("mychannel")
+	if value, _ := state.GetState(approvalKeyPrefix + "counter"); string(value) != "1.0" {
+		t.Errorf("approval = %q, want 1.0", value)
+	}
+
+	notInstalled := [][]byte{[]byte("approveForMyOrg"), []byte("missing"), []byte("1.0")}
+	if _, err := registry.Invoke("mychannel", LifecycleName, notInstalled); err == nil {
+		t.Error("approveForMyOrg succeeded for a chaincode that is not installed")
+	}
+	if _, err := registry.Invoke("mychannel", LifecycleName, [][]byte{[]byte("unknown")}); err == nil {
+		t.Error("unknown lifecycle function succeeded")
+	}
+}
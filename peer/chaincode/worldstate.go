@@ -0,0 +1,103 @@
+package chaincode
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// WorldStateInterface 체인코드가 접근하는 world state 인터페이스
+type WorldStateInterface interface {
+	GetState(key string) ([]byte, error)
+	PutState(key string, value []byte) error
+	DelState(key string) error
+}
+
+// WorldState 채널 하나의 key-value world state
+// 변경될 때마다 JSON 파일로 저장되어 peer 재시작 후에도 유지된다.
+type WorldState struct {
+	mutex sync.RWMutex
+	path  string
+	data  map[string][]byte
+}
+
+// NewWorldState path의 state 파일을 로드하여 WorldState 생성 (파일이 없으면 빈 상태)
+func NewWorldState(path string) (*WorldState, error) {
+	ws := &WorldState{
+		path: path,
+		data: make(map[string][]byte),
+	}
+
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return ws, nil
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read state file: %s", path)
+	}
+	if err := json.Unmarshal(data, &ws.data); err != nil {
+		return nil, errors.Wrapf(err, "failed to unmarshal state file: %s", path)
+	}
+	return ws, nil
+}
+
+// GetState key의 값 반환 (없으면 nil)
+func (ws *WorldState) GetState(key string) ([]byte, error) {
+	if key == "" {
+		return nil, errors.New("key cannot be empty")
+	}
+
+	ws.mutex.RLock()
+	defer ws.mutex.RUnlock()
+
+	return ws.data[key], nil
+}
+
+func (ws *WorldState) PutState(key string, value []byte) error {
+	if key == "" {
+		return errors.New("key cannot be empty")
+	}
+
+	ws.mutex.Lock()
+	defer ws.mutex.Unlock()
+
+	ws.data[key] = value
+	return ws.save()
+}
+
+func (ws *WorldState) DelState(key string) error {
+	if key == "" {
+		return errors.New("key cannot be empty")
+	}
+
+	ws.mutex.Lock()
+	defer ws.mutex.Unlock()
+
+	delete(ws.data, key)
+	return ws.save()
+}
+
+// save 임시 파일에 쓴 뒤 rename하여 state 파일을 교체 (lock을 잡은 상태에서 호출)
+func (ws *WorldState) save() error {
+	if err := os.MkdirAll(filepath.Dir(ws.path), 0755); err != nil {
+		return errors.Wrapf(err, "failed to create state directory: %s", filepath.Dir(ws.path))
+	}
+
+	data, err := json.Marshal(ws.data)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal world state")
+	}
+
+	tempPath := ws.path + ".tmp"
+	if err := os.WriteFile(tempPath, data, 0644); err != nil {
+		return errors.Wrap(err, "failed to write temporary state file")
+	}
+	if err := os.Rename(tempPath, ws.path); err != nil {
+		os.Remove(tempPath)
+		return errors.Wrap(err, "failed to rename temporary state file")
+	}
+	return nil
+}
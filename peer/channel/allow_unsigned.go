@@ -0,0 +1,37 @@
+package channel
+
+import (
+	"log"
+
+	"github.com/ddr4869/minifab/common/logger"
+	"github.com/ddr4869/minifab/peer/core"
+	"github.com/spf13/cobra"
+)
+
+// getChannelAllowUnsignedCmd는 orderer 서명 없이 받아들일 블록 높이를 기록합니다
+func getChannelAllowUnsignedCmd(peer *core.Peer) *cobra.Command {
+
+	var channelName string
+	var height uint64
+
+	cmd := &cobra.Command{
+		Use:   "allow-unsigned",
+		Short: "서명 없이 받아들일 블록 높이를 기록합니다",
+		Long: `orderer가 블록에 서명하기 전에 만들어진 채널을 동기화할 수 있도록, --height 미만 번호의 서명 없는 블록을 받아들이게 합니다.
+--height 이상의 블록과 서명이 있는 블록은 계속 orderer MSP로 검증합니다. --height 0은 기록을 지웁니다.
+이미 원장을 가진 peer는 시작할 때 마지막 블록에 서명이 없으면 현재 높이를 자동으로 기록합니다.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := peer.ChannelManager.AllowUnsignedBlocks(channelName, height); err != nil {
+				log.Fatalf("Failed to allow unsigned blocks: %v", err)
+			}
+			logger.Infof("✅ Channel %s accepts unsigned blocks below %d", channelName, height)
+		},
+	}
+
+	cmd.Flags().StringVarP(&channelName, "channelID", "c", "", "Channel name (required)")
+	cmd.Flags().Uint64Var(&height, "height", 0, "Accept unsigned blocks with a number below this height (required)")
+	cmd.MarkFlagRequired("channelID")
+	cmd.MarkFlagRequired("height")
+
+	return cmd
+}
@@ -13,6 +13,7 @@ var (
 	ChaincodePath  string
 	MspID          string
 	MspPath        string
+	LedgerPath     string
 )
 
 // GetChannelCommand returns the channel command with all subcommands
@@ -30,15 +31,29 @@ func Cmd() *cobra.Command {
 	flags.StringVar(&ChaincodePath, "chaincode", "./chaincode", "Chaincode path")
 	flags.StringVar(&MspID, "mspid", "Org1MSP", "MSP ID for peer")
 	flags.StringVar(&MspPath, "mspdir", "/Users/mac/go/src/github.com/ddr4869/minifab/ca/Org1/ca-client/admin", "Path to MSP directory with certificates")
+	flags.StringVar(&LedgerPath, "ledger-path", "", "Block storage path (default: <FILESYSTEM_PATH>/blocks)")
 
 	peer, err := core.NewPeer(PeerID, MspID, MspPath, OrdererAddress)
 	if err != nil {
 		log.Fatalf("Failed to create peer: %v", err)
 	}
 
-	channelCmd.AddCommand(ChannelCreateCmd(peer))
-	channelCmd.AddCommand(getChannelJoinCmd(peer))
-	channelCmd.AddCommand(getChannelListCmd(peer))
+	// 플래그는 명령 실행 시점에 파싱되므로 저장 경로는 실행 직전에 적용
+	channelCmd.PersistentPreRun = func(cmd *cobra.Command, args []string) {
+		if LedgerPath != "" {
+			peer.SetLedgerPath(LedgerPath)
+		}
+	}
+
+	operator := NewChannelOperator(peer)
+	channelCmd.AddCommand(ChannelCreateCmd(operator))
+	channelCmd.AddCommand(getChannelJoinCmd(operator))
+	channelCmd.AddCommand(getChannelListCmd(operator))
+	channelCmd.AddCommand(getChannelQueryCmd(operator))
+	channelCmd.AddCommand(getChannelInfoCmd(operator))
+	channelCmd.AddCommand(getChannelSyncStatusCmd(peer))
+	channelCmd.AddCommand(getChannelResetAllCmd(peer))
+	channelCmd.AddCommand(getChannelAllowUnsignedCmd(peer))
 
 	return channelCmd
 }
@@ -1,10 +1,12 @@
 package channel
 
 import (
+	"bytes"
 	"crypto/rand"
 	"crypto/sha256"
 	"encoding/json"
 	"log"
+	"time"
 
 	"github.com/ddr4869/minifab/common/blockutil"
 	"github.com/ddr4869/minifab/common/configtx"
@@ -20,10 +22,27 @@ import (
 
 const cfgFile = "config/configtx.yaml"
 
+// defaultChannelProfile 채널 생성 시 profile을 지정하지 않으면 사용하는 profile
+const defaultChannelProfile = "testchannel0"
+
+// ChannelCreationOptions CreateChannelWithOptions의 채널 생성 설정
+type ChannelCreationOptions struct {
+	// ProfileName configtx.yaml의 application 채널 profile (기본: testchannel0)
+	ProfileName string
+	// ConfigTxPath configtx.yaml 경로 (기본: config/configtx.yaml)
+	ConfigTxPath string
+	// Consortium 지정하면 Application 조직이 모두 이 profile의 Consortiums 멤버인지 확인
+	Consortium string
+	// Timeout orderer 응답 대기 시간 (0이면 common.DefaultSendTimeout)
+	Timeout time.Duration
+	// DryRun 설정 블록 생성과 검증만 하고 orderer에 제출하지 않음
+	DryRun bool
+}
+
 // getChannelCreateCmd는 새로운 채널을 생성합니다
-func ChannelCreateCmd(peer *core.Peer) *cobra.Command {
+func ChannelCreateCmd(operator *ChannelOperator) *cobra.Command {
 	var channelName string
-	var profileName string
+	var opts ChannelCreationOptions
 
 	cmd := &cobra.Command{
 		Use:   "create",
@@ -34,63 +53,153 @@ func ChannelCreateCmd(peer *core.Peer) *cobra.Command {
 				log.Fatalf("Channel name is required. Use -c or --channelID flag")
 			}
 
-			if profileName == "" {
-				profileName = "testchannel0"
-			}
-
-			if err := CreateChannel(peer, channelName, profileName); err != nil {
+			if err := operator.CreateChannelWithOptions(channelName, opts); err != nil {
 				log.Fatalf("Failed to create channel: %v", err)
 			}
 		},
 	}
 
 	cmd.Flags().StringVarP(&channelName, "channelID", "c", "", "Channel name (required)")
-	cmd.Flags().StringVarP(&profileName, "profile", "p", "testchannel0", "Profile name for channel creation")
+	cmd.Flags().StringVarP(&opts.ProfileName, "profile", "p", defaultChannelProfile, "Profile name for channel creation")
+	cmd.Flags().StringVar(&opts.ConfigTxPath, "configtx", cfgFile, "Path to configtx.yaml file")
+	cmd.Flags().StringVar(&opts.Consortium, "consortium", "", "Profile whose Consortiums must include every application organization")
+	cmd.Flags().DurationVar(&opts.Timeout, "timeout", 0, "Timeout waiting for the orderer (default 5s)")
+	cmd.Flags().BoolVar(&opts.DryRun, "dry-run", false, "Generate and validate the config block without submitting it")
 	cmd.MarkFlagRequired("channelID")
 
 	return cmd
 }
 
 func CreateChannel(peer *core.Peer, channelName, profileName string) error {
+	return CreateChannelWithOptions(peer, channelName, ChannelCreationOptions{ProfileName: profileName})
+}
+
+// CreateChannelWithOptions peer에 대해 ChannelOperator.CreateChannelWithOptions 실행
+func CreateChannelWithOptions(peer *core.Peer, channelName string, opts ChannelCreationOptions) error {
+	return NewChannelOperator(peer).CreateChannelWithOptions(channelName, opts)
+}
+
+// CreateChannel 기본 profile(testchannel0)로 채널을 생성하고 orderer에 제출
+func (o *ChannelOperator) CreateChannel(channelName string) error {
+	return o.CreateChannelWithOptions(channelName, ChannelCreationOptions{})
+}
+
+// CreateChannelWithOptions 옵션에 따라 채널 설정 블록을 생성·검증하고 orderer에 제출
+func (o *ChannelOperator) CreateChannelWithOptions(channelName string, opts ChannelCreationOptions) error {
+	if opts.ProfileName == "" {
+		opts.ProfileName = defaultChannelProfile
+	}
+	if opts.ConfigTxPath == "" {
+		opts.ConfigTxPath = cfgFile
+	}
 
 	// #TODO :phase 0 - check peer's identity
-	if peer.OrdererClient == nil {
+	ordererClient := o.peer.GetOrdererClient()
+	if !opts.DryRun && ordererClient == nil {
 		return errors.New("orderer client is required for channel creation")
 	}
 
 	// #phase 1 - create config block
-	appConfigBlock, err := generateAppConfigBlock(peer, channelName, profileName)
+	ccfg, err := configtx.ConvertConfigtx(opts.ConfigTxPath)
+	if err != nil {
+		return errors.Wrap(err, "failed to convert configtx")
+	}
+	appProfile, err := ccfg.GetAppChannelProfile(opts.ProfileName)
+	if err != nil {
+		return errors.Wrap(err, "failed to create app config")
+	}
+	appConfigBlock, err := generateAppConfigBlock(o.peer.GetPeerSigningIdentity(), channelName, &appProfile.Application)
 	if err != nil {
 		return errors.Wrap(err, "failed to generate app config block")
 	}
+	if err := validateAppConfigBlock(ccfg, channelName, appConfigBlock, opts.Consortium); err != nil {
+		return errors.Wrap(err, "invalid app config block")
+	}
+	if opts.DryRun {
+		logger.Infof("✅ Config block for channel %s is valid (dry run, not submitted)", channelName)
+		return nil
+	}
+
 	appCfgBytes, err := blockutil.MarshalBlockToProto(appConfigBlock)
 	if err != nil {
 		return errors.Wrap(err, "failed to marshal genesis block")
 	}
 
 	// #phase 2 - send config block to orderer
-	envelope, err := ProcessConfigBlock(peer.Peer.MSP.GetSigningIdentity(), channelName, appCfgBytes)
+	envelope, err := ProcessConfigBlock(o.peer.GetPeerSigningIdentity(), channelName, appCfgBytes)
 	if err != nil {
 		return errors.Wrap(err, "failed to create payload")
 	}
-	block, err := peer.OrdererClient.Send(envelope)
+	block, err := ordererClient.SendWithTimeout(envelope, opts.Timeout)
 	if err != nil {
 		return errors.Wrapf(err, "failed to send envelope")
 	}
 
 	// #phase 3 - save config block
 	// TODO : Committer 작업 적용 후 저장
-	if err := blockutil.SaveBlockFile(block.Block, channelName, peer.Peer.FilesystemPath); err != nil {
+	if err := o.peer.GetBlockStorage().StoreBlock(channelName, block.Block); err != nil {
 		return errors.Wrap(err, "failed to save config block")
 	}
+	channelConfig, err := blockutil.ExtractChannelConfigFromBlock(block.Block)
+	if err != nil {
+		return errors.Wrap(err, "failed to extract channel config")
+	}
+	o.peer.GetChannelManager().AddChannel(channelName, channelConfig)
 	logger.Info("✅ Broadcast Success")
 
 	return nil
 }
 
+// validateAppConfigBlock orderer에 제출하기 전에 채널 이름, 블록 형식, Application 조직 구성을 검증
+func validateAppConfigBlock(ccfg *configtx.ConfigTx, channelName string, block *pb_common.Block, consortium string) error {
+	if err := blockutil.ValidateChannelName(channelName); err != nil {
+		return err
+	}
+	if block.Header.HeaderType != pb_common.BlockType_BLOCK_TYPE_CONFIG {
+		return errors.New("block is not a config block")
+	}
+	if !bytes.Equal(block.Header.DataHash, blockutil.CalculateDataHash(block.Data.Transactions)) {
+		return errors.New("data hash does not match transactions")
+	}
+	appConfig, err := blockutil.ExtractAppChannelConfigFromBlock(block)
+	if err != nil {
+		return err
+	}
+	if len(appConfig.Organizations) == 0 {
+		return errors.New("application has no organizations")
+	}
+	if consortium == "" {
+		return nil
+	}
+
+	resolver, err := configtx.NewProfileResolver(ccfg)
+	if err != nil {
+		return err
+	}
+	resolved, err := resolver.ResolveProfile(consortium)
+	if err != nil {
+		return errors.Wrapf(err, "failed to resolve consortium %s", consortium)
+	}
+	members := make(map[string]bool, len(resolved.ConsortiumOrganizations))
+	for _, org := range resolved.ConsortiumOrganizations {
+		members[org.ID] = true
+	}
+	for _, org := range appConfig.Organizations {
+		if !members[org.ID] {
+			return errors.Errorf("organization %s is not a member of consortium %s", org.ID, consortium)
+		}
+	}
+	return nil
+}
+
 func ProcessConfigBlock(signer msp.SigningIdentity, channelName string, data []byte) (*pb_common.Envelope, error) {
+	return createSignedEnvelope(signer, pb_common.MessageType_MESSAGE_TYPE_CONFIG, channelName, data)
+}
+
+// createSignedEnvelope header/payload를 구성하고 payload 해시에 서명한 envelope 생성
+func createSignedEnvelope(signer msp.SigningIdentity, messageType pb_common.MessageType, channelName string, data []byte) (*pb_common.Envelope, error) {
 
-	header, err := blockutil.CreateHeader(signer, pb_common.MessageType_MESSAGE_TYPE_CONFIG, channelName)
+	header, err := blockutil.CreateHeader(signer, messageType, channelName)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to create header")
 	}
@@ -118,18 +227,13 @@ func ProcessConfigBlock(signer msp.SigningIdentity, channelName string, data []b
 	return envelope, nil
 }
 
-func generateAppConfigBlock(peer *core.Peer, channelName, profileName string) (*pb_common.Block, error) {
-	appConfig, err := CreateAppConfigFromConfigTx(cfgFile, profileName)
-	if err != nil {
-		return nil, errors.Wrap(err, "failed to create app config")
-	}
-
+func generateAppConfigBlock(signer msp.SigningIdentity, channelName string, appConfig *configtx.AppChannelConfig) (*pb_common.Block, error) {
 	appConfigBytes, err := json.Marshal(appConfig)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to marshal app config")
 	}
 
-	return blockutil.GenerateConfigBlock(appConfigBytes, channelName, peer.Peer.MSP.GetSigningIdentity())
+	return blockutil.GenerateConfigBlock(appConfigBytes, channelName, signer)
 }
 
 func CreateAppConfigFromConfigTx(configTxPath string, profile string) (*configtx.AppChannelConfig, error) {
@@ -0,0 +1,212 @@
+package channel
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ddr4869/minifab/common/msp/msptest"
+	"github.com/ddr4869/minifab/peer/core"
+	pb_orderer "github.com/ddr4869/minifab/proto/orderer"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestCreateChannelConcurrentPeers(t *testing.T) {
+	org1 := msptest.NewOrg(t, "Org1MSP")
+	org2 := msptest.NewOrg(t, "Org2MSP")
+	orderer := startTestOrderer(t, org1, org2)
+	configTxPath := writeTestConfigTx(t, "TwoOrgsChannel", org1, org2)
+
+	peers := []*core.Peer{newTestPeer(t, org1, orderer.address), newTestPeer(t, org2, orderer.address)}
+	operators := []*ChannelOperator{NewChannelOperator(peers[0]), NewChannelOperator(peers[1])}
+	const channelsPerPeer = 3
+	channelName := func(p, i int) string { return fmt.Sprintf("peer%dchannel%d", p, i) }
+
+	var wg sync.WaitGroup
+	errs := make(chan error, len(operators)*channelsPerPeer)
+	for p, operator := range operators {
+		for i := 0; i < channelsPerPeer; i++ {
+			wg.Add(1)
+			go func(operator *ChannelOperator, name string) {
+				defer wg.Done()
+				opts := ChannelCreationOptions{ProfileName: "TwoOrgsChannel", ConfigTxPath: configTxPath}
+				if err := operator.CreateChannelWithOptions(name, opts); err != nil {
+					errs <- fmt.Errorf("%s: %v", name, err)
+				}
+			}(operator, channelName(p, i))
+		}
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		t.Error(err)
+	}
+	if t.Failed() {
+		t.FailNow()
+	}
+
+	// 각 peer는 자신의 operator가 만든 채널만 원장과 ChannelManager에 가진다
+	for p, peer := range peers {
+		var want []string
+		for i := 0; i < channelsPerPeer; i++ {
+			want = append(want, channelName(p, i))
+		}
+		got := peer.ChannelManager.GetChannelNames()
+		sort.Strings(got)
+		if fmt.Sprint(got) != fmt.Sprint(want) {
+			t.Errorf("peer%d channels = %v, want %v", p, got, want)
+		}
+		stored, err := peer.BlockStorage.ListChannels()
+		if err != nil {
+			t.Fatalf("ListChannels: %v", err)
+		}
+		sort.Strings(stored)
+		if fmt.Sprint(stored) != fmt.Sprint(want) {
+			t.Errorf("peer%d stored channels = %v, want %v", p, stored, want)
+		}
+		for _, name := range want {
+			if height := peer.BlockStorage.GetChannelHeight(name); height != 1 {
+				t.Errorf("peer%d channel %s height = %d, want 1", p, name, height)
+			}
+		}
+	}
+}
+
+// blockingOrderer CreateChannel 요청에 응답하지 않는 orderer
+type blockingOrderer struct {
+	pb_orderer.UnimplementedOrdererServiceServer
+}
+
+func (blockingOrderer) CreateChannel(stream pb_orderer.OrdererService_CreateChannelServer) error {
+	<-stream.Context().Done()
+	return nil
+}
+
+// startBlockingOrderer 임의 포트에서 blockingOrderer를 시작하고 주소 반환
+func startBlockingOrderer(t *testing.T) string {
+	t.Helper()
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	server := grpc.NewServer()
+	pb_orderer.RegisterOrdererServiceServer(server, blockingOrderer{})
+	go server.Serve(lis)
+	t.Cleanup(server.Stop)
+	return lis.Addr().String()
+}
+
+// appendConsortiumProfile configtx.yaml 끝에 orgs를 Consortiums로 가진 profile 추가
+func appendConsortiumProfile(t *testing.T, path, profileName string, orgs ...*msptest.Org) {
+	t.Helper()
+
+	content := fmt.Sprintf("  %s:\n    Consortiums:\n      SampleConsortium:\n        Organizations:\n", profileName)
+	for _, org := range orgs {
+		content += fmt.Sprintf("          - *%s\n", org.MSPID)
+	}
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	if _, err := f.WriteString(content); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestCreateChannelWithOptions(t *testing.T) {
+	org1 := msptest.NewOrg(t, "Org1MSP")
+	org2 := msptest.NewOrg(t, "Org2MSP")
+	orderer := startTestOrderer(t, org1, org2)
+	configTxPath := writeTestConfigTx(t, "TwoOrgsChannel", org1, org2)
+	appendConsortiumProfile(t, configTxPath, "BothOrgs", org1, org2)
+	appendConsortiumProfile(t, configTxPath, "Org1Only", org1)
+	peer := newTestPeer(t, org1, orderer.address)
+
+	tests := []struct {
+		name    string
+		channel string
+		opts    ChannelCreationOptions
+		wantErr string
+	}{
+		{"unknown profile", "profilechannel", ChannelCreationOptions{ProfileName: "Missing", ConfigTxPath: configTxPath}, "failed to create app config"},
+		{"missing configtx", "configtxchannel", ChannelCreationOptions{ProfileName: "TwoOrgsChannel", ConfigTxPath: filepath.Join(t.TempDir(), "configtx.yaml")}, "failed to convert configtx"},
+		{"consortium member", "memberchannel", ChannelCreationOptions{ProfileName: "TwoOrgsChannel", ConfigTxPath: configTxPath, Consortium: "BothOrgs"}, ""},
+		{"consortium non-member", "nonmemberchannel", ChannelCreationOptions{ProfileName: "TwoOrgsChannel", ConfigTxPath: configTxPath, Consortium: "Org1Only"}, "organization Org2MSP is not a member of consortium Org1Only"},
+		{"invalid channel name", "1channel", ChannelCreationOptions{ProfileName: "TwoOrgsChannel", ConfigTxPath: configTxPath}, "invalid channel name"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := CreateChannelWithOptions(peer, tt.channel, tt.opts)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("CreateChannelWithOptions: %v", err)
+				}
+				if _, exists := orderer.Channels.Get(tt.channel); !exists {
+					t.Errorf("channel %s was not created on the orderer", tt.channel)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("CreateChannelWithOptions error = %v, want it to contain %q", err, tt.wantErr)
+			}
+			if _, exists := orderer.Channels.Get(tt.channel); exists {
+				t.Errorf("channel %s was created despite the error", tt.channel)
+			}
+		})
+	}
+}
+
+func TestCreateChannelWithOptionsDryRun(t *testing.T) {
+	org1 := msptest.NewOrg(t, "Org1MSP")
+	orderer := startTestOrderer(t, org1)
+	configTxPath := writeTestConfigTx(t, "OneOrgChannel", org1)
+	peer := newTestPeer(t, org1, orderer.address)
+
+	opts := ChannelCreationOptions{ProfileName: "OneOrgChannel", ConfigTxPath: configTxPath, DryRun: true}
+	if err := CreateChannelWithOptions(peer, "drychannel", opts); err != nil {
+		t.Fatalf("dry run: %v", err)
+	}
+	if _, exists := orderer.Channels.Get("drychannel"); exists {
+		t.Error("dry run created the channel on the orderer")
+	}
+	if peer.BlockStorage.HasChannel("drychannel") || len(peer.ChannelManager.GetChannelNames()) != 0 {
+		t.Error("dry run stored the channel on the peer")
+	}
+
+	// 검증 에러는 dry run에서도 보고되며, orderer 연결 없이도 실행된다
+	peer.OrdererClient = nil
+	if err := CreateChannelWithOptions(peer, "bad channel", opts); err == nil || !strings.Contains(err.Error(), "invalid channel name") {
+		t.Errorf("dry run with invalid name error = %v", err)
+	}
+	opts.DryRun = false
+	if err := CreateChannelWithOptions(peer, "drychannel", opts); err == nil || !strings.Contains(err.Error(), "orderer client is required") {
+		t.Errorf("create without orderer client error = %v", err)
+	}
+}
+
+func TestCreateChannelWithOptionsTimeout(t *testing.T) {
+	org1 := msptest.NewOrg(t, "Org1MSP")
+	configTxPath := writeTestConfigTx(t, "OneOrgChannel", org1)
+	peer := newTestPeer(t, org1, startBlockingOrderer(t))
+
+	start := time.Now()
+	opts := ChannelCreationOptions{ProfileName: "OneOrgChannel", ConfigTxPath: configTxPath, Timeout: 200 * time.Millisecond}
+	err := CreateChannelWithOptions(peer, "mychannel", opts)
+	if status.Code(errors.Cause(err)) != codes.DeadlineExceeded {
+		t.Fatalf("CreateChannelWithOptions error = %v, want DeadlineExceeded", err)
+	}
+	if elapsed := time.Since(start); elapsed > 2*time.Second {
+		t.Errorf("CreateChannelWithOptions returned after %s, want about 200ms", elapsed)
+	}
+}
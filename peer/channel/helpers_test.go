@@ -0,0 +1,162 @@
+package channel
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ddr4869/minifab/common/blockutil"
+	"github.com/ddr4869/minifab/common/configtx"
+	"github.com/ddr4869/minifab/common/msp/msptest"
+	"github.com/ddr4869/minifab/config"
+	"github.com/ddr4869/minifab/orderer/channel"
+	"github.com/ddr4869/minifab/orderer/server"
+	"github.com/ddr4869/minifab/peer/common"
+	"github.com/ddr4869/minifab/peer/core"
+	"github.com/ddr4869/minifab/peer/storage"
+)
+
+// testOrderer 임의 포트에서 gRPC 서버로 동작하는 in-process orderer
+type testOrderer struct {
+	*server.Orderer
+	org     *msptest.Org
+	address string
+}
+
+// startTestOrderer orgs를 consortium으로 가진 orderer를 임의 포트에서 시작
+// BatchTimeout이 1시간이므로 제출된 트랜잭션은 테스트 도중 PendingQueue에 남는다.
+func startTestOrderer(t *testing.T, orgs ...*msptest.Org) *testOrderer {
+	t.Helper()
+
+	ordererOrg := msptest.NewOrg(t, "OrdererMSP")
+	o := server.NewOrdererWithConfig(&config.OrdererCfg{MSPID: "OrdererMSP", FilesystemPath: t.TempDir()}, ordererOrg.MSP)
+	scc := &configtx.SystemChannelInfo{
+		Orderer: configtx.SystemChannelConfig{
+			BatchTimeout: "1h",
+			BatchSize:    configtx.BatchSize{MaxMessageCount: 10},
+			Organization: configtx.Organization{Name: "OrdererOrg", ID: "OrdererMSP", MSPCaCert: ordererOrg.CACert.Raw},
+		},
+	}
+	for _, org := range orgs {
+		scc.Consortiums = append(scc.Consortiums, configtx.Organization{Name: org.MSPID, ID: org.MSPID, MSPCaCert: org.CACert.Raw})
+	}
+	o.ChainSupport.SystemChannelInfo = scc
+	o.ChainSupport.Cutter = channel.NewBlockCutter(o.ChainSupport)
+
+	// 사용 가능한 포트를 얻은 뒤 닫고 그 주소로 서버 시작
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	address := lis.Addr().String()
+	lis.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() { done <- server.NewOrdererServer(o).StartWithContext(ctx, address) }()
+	t.Cleanup(func() {
+		cancel()
+		if err := <-done; err != nil {
+			t.Errorf("StartWithContext: %v", err)
+		}
+	})
+
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		conn, err := net.Dial("tcp", address)
+		if err == nil {
+			conn.Close()
+			return &testOrderer{Orderer: o, org: ordererOrg, address: address}
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("orderer did not start on %s: %v", address, err)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+// addChannel 채널 설정 블록(블록 0)을 저장하고 orderer에 채널 등록
+func (o *testOrderer) addChannel(t *testing.T, channelID string, channelConfig *configtx.ChannelConfig) {
+	t.Helper()
+
+	configBytes, err := json.Marshal(channelConfig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	genesis, err := blockutil.GenerateConfigBlock(configBytes, channelID, o.org.SigningIdentity())
+	if err != nil {
+		t.Fatalf("GenerateConfigBlock: %v", err)
+	}
+	if err := blockutil.SaveBlockFile(genesis, channelID, o.OrdererConfig.FilesystemPath); err != nil {
+		t.Fatalf("SaveBlockFile: %v", err)
+	}
+	if err := o.ChainSupport.Sequences.Commit(channelID, 0); err != nil {
+		t.Fatalf("Commit: %v", err)
+	}
+	o.Channels.Add(channelID, channelConfig)
+}
+
+// testChannelConfig orgs를 application 조직으로, endpoints를 orderer endpoint로 가진 채널 설정
+func testChannelConfig(endpoints []string, orgs ...*msptest.Org) *configtx.ChannelConfig {
+	channelConfig := &configtx.ChannelConfig{
+		CC: &configtx.AppChannelConfig{},
+		SCC: &configtx.SystemChannelInfo{
+			Orderer: configtx.SystemChannelConfig{
+				Organization: configtx.Organization{Name: "OrdererOrg", ID: "OrdererMSP", OrdererEndpoints: endpoints},
+			},
+		},
+	}
+	for _, org := range orgs {
+		channelConfig.CC.Organizations = append(channelConfig.CC.Organizations,
+			configtx.Organization{Name: org.MSPID, ID: org.MSPID, MSPCaCert: org.CACert.Raw})
+	}
+	return channelConfig
+}
+
+// writeTestConfigTx 조직들의 MSP 디렉터리와 모든 조직을 Application에 포함하는 profile을 가진 configtx.yaml 작성
+func writeTestConfigTx(t *testing.T, profileName string, orgs ...*msptest.Org) string {
+	t.Helper()
+
+	dir := t.TempDir()
+	content := "Organizations:\n"
+	for _, org := range orgs {
+		mspDir := org.WriteMSPDir(t, filepath.Join(dir, org.MSPID))
+		content += fmt.Sprintf("  - &%s\n    Name: %s\n    ID: %s\n    MSPDir: %s\n", org.MSPID, org.MSPID, org.MSPID, mspDir)
+	}
+	content += fmt.Sprintf("Profiles:\n  %s:\n    Application:\n      Organizations:\n", profileName)
+	for _, org := range orgs {
+		content += fmt.Sprintf("        - *%s\n", org.MSPID)
+	}
+
+	path := filepath.Join(dir, "configtx.yaml")
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+// newTestPeer 자체 임시 원장과 ChannelManager를 가지고 ordererAddress의 orderer에 연결된 peer
+func newTestPeer(t *testing.T, org *msptest.Org, ordererAddress string) *core.Peer {
+	t.Helper()
+
+	client, err := common.NewOrdererClient(ordererAddress)
+	if err != nil {
+		t.Fatalf("NewOrdererClient: %v", err)
+	}
+	t.Cleanup(func() { client.Close() })
+
+	blockStorage := storage.NewBlockStorageWithPath(t.TempDir())
+	return &core.Peer{
+		Peer:           &config.PeerCfg{ID: org.MSPID + "-peer0", MSPID: org.MSPID, MSP: org.MSP},
+		Orderer:        &config.OrdererCfg{Address: ordererAddress},
+		Client:         &config.ClientCfg{MSPID: org.MSPID, MSP: org.MSP},
+		OrdererClient:  client,
+		BlockStorage:   blockStorage,
+		ChannelManager: core.NewChannelManager(blockStorage),
+	}
+}
@@ -0,0 +1,111 @@
+package channel
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/ddr4869/minifab/common/blockutil"
+	"github.com/ddr4869/minifab/peer/core"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+// ChannelInfo peer channel info 명령의 출력 내용
+type ChannelInfo struct {
+	Name             string   `json:"name"`
+	OrdererEndpoints []string `json:"orderer_endpoints"`
+	MemberMSPIDs     []string `json:"member_msp_ids"`
+	BlockHeight      uint64   `json:"block_height"`
+	LastBlockHash    string   `json:"last_block_hash"`
+	// peer가 이 채널로 제출에 성공한 트랜잭션 수 (peer는 대기 중인 트랜잭션을 따로 보관하지 않음)
+	TransactionCount uint64 `json:"transaction_count"`
+}
+
+// getChannelInfoCmd는 채널 설정과 원장 상태를 조회합니다
+func getChannelInfoCmd(operator *ChannelOperator) *cobra.Command {
+
+	var channelName, output string
+
+	cmd := &cobra.Command{
+		Use:   "info",
+		Short: "채널 설정과 블록 높이를 조회합니다",
+		Long:  `지정된 채널의 orderer endpoint, 멤버 조직 MSP ID, 블록 높이, 마지막 블록 해시를 표시합니다.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			info, err := operator.GetChannelInfo(channelName)
+			if err != nil {
+				log.Fatalf("Failed to get channel info: %v", err)
+			}
+			if err := PrintChannelInfo(info, output); err != nil {
+				log.Fatalf("Failed to print channel info: %v", err)
+			}
+		},
+	}
+
+	cmd.Flags().StringVarP(&channelName, "channelID", "c", "", "Channel name (required)")
+	cmd.Flags().StringVarP(&output, "output", "o", "text", "Output format (text|json)")
+	cmd.MarkFlagRequired("channelID")
+
+	return cmd
+}
+
+// GetChannelInfo peer에 대해 ChannelOperator.GetChannelInfo 실행
+func GetChannelInfo(peer *core.Peer, channelName string) (*ChannelInfo, error) {
+	return NewChannelOperator(peer).GetChannelInfo(channelName)
+}
+
+// GetChannelInfo ChannelManager와 BlockStorage에서 채널 정보를 모아 반환
+func (o *ChannelOperator) GetChannelInfo(channelName string) (*ChannelInfo, error) {
+	channelManager, blockStorage := o.peer.GetChannelManager(), o.peer.GetBlockStorage()
+	summary, err := channelManager.GetChannelSummary(channelName)
+	if err != nil {
+		return nil, err
+	}
+
+	info := &ChannelInfo{
+		Name:             summary.Name,
+		OrdererEndpoints: []string{},
+		MemberMSPIDs:     []string{},
+		BlockHeight:      summary.BlockHeight,
+		TransactionCount: summary.TransactionCount,
+	}
+	if endpoints, err := channelManager.GetOrdererEndpoints(channelName); err == nil {
+		info.OrdererEndpoints = endpoints
+	}
+	if channelConfig, err := channelManager.GetChannelConfig(channelName); err == nil && channelConfig.CC != nil {
+		for _, org := range channelConfig.CC.Organizations {
+			info.MemberMSPIDs = append(info.MemberMSPIDs, org.ID)
+		}
+	}
+	if info.BlockHeight > 0 {
+		lastBlock, err := blockStorage.GetLastBlock(channelName)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get last block of channel %s", channelName)
+		}
+		info.LastBlockHash = blockutil.HashBlock(lastBlock)
+	}
+	return info, nil
+}
+
+// PrintChannelInfo 채널 정보를 text 또는 json 형식으로 출력
+func PrintChannelInfo(info *ChannelInfo, output string) error {
+	switch output {
+	case "json":
+		data, err := json.MarshalIndent(info, "", "  ")
+		if err != nil {
+			return errors.Wrap(err, "failed to marshal channel info")
+		}
+		fmt.Println(string(data))
+	case "text":
+		fmt.Printf("Channel: %s\n", info.Name)
+		fmt.Printf("Orderer endpoints: %s\n", strings.Join(info.OrdererEndpoints, ", "))
+		fmt.Printf("Member MSP IDs: %s\n", strings.Join(info.MemberMSPIDs, ", "))
+		fmt.Printf("Block height: %d\n", info.BlockHeight)
+		fmt.Printf("Last block hash: %s\n", info.LastBlockHash)
+		fmt.Printf("Submitted transactions: %d\n", info.TransactionCount)
+	default:
+		return errors.Errorf("unsupported output format: %s", output)
+	}
+	return nil
+}
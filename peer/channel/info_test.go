@@ -0,0 +1,74 @@
+package channel
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/ddr4869/minifab/common/blockutil"
+	"github.com/ddr4869/minifab/common/msp/msptest"
+	"github.com/ddr4869/minifab/orderer/orderertest"
+)
+
+func TestChannelInfoJSON(t *testing.T) {
+	orderer := orderertest.NewServer(t)
+	org := msptest.NewOrg(t, "Org1MSP")
+	peer := newTestPeer(t, org, orderer.Address)
+
+	genesis := orderer.NewChannel(t, "mychannel", org)
+	if err := peer.ChannelManager.JoinChannelByBlock("mychannel", genesis); err != nil {
+		t.Fatalf("JoinChannelByBlock: %v", err)
+	}
+	blocks := orderer.AppendBlocks(t, "mychannel", 4)
+	for _, block := range blocks {
+		if err := peer.BlockStorage.StoreBlock("mychannel", block); err != nil {
+			t.Fatalf("StoreBlock: %v", err)
+		}
+	}
+
+	info, err := GetChannelInfo(peer, "mychannel")
+	if err != nil {
+		t.Fatalf("GetChannelInfo: %v", err)
+	}
+	out := captureStdout(t, func() {
+		if err := PrintChannelInfo(info, "json"); err != nil {
+			t.Fatalf("PrintChannelInfo: %v", err)
+		}
+	})
+
+	var fields map[string]interface{}
+	if err := json.Unmarshal([]byte(out), &fields); err != nil {
+		t.Fatalf("output is not JSON: %v\n%s", err, out)
+	}
+	if fields["block_height"] != float64(5) {
+		t.Errorf("block_height = %v, want 5", fields["block_height"])
+	}
+	if fields["name"] != "mychannel" {
+		t.Errorf("name = %v, want mychannel", fields["name"])
+	}
+	if fields["last_block_hash"] != blockutil.HashBlock(blocks[len(blocks)-1]) {
+		t.Errorf("last_block_hash = %v, want the hash of block 4", fields["last_block_hash"])
+	}
+	if endpoints, _ := fields["orderer_endpoints"].([]interface{}); len(endpoints) != 1 || endpoints[0] != orderer.Address {
+		t.Errorf("orderer_endpoints = %v, want [%s]", fields["orderer_endpoints"], orderer.Address)
+	}
+	if members, _ := fields["member_msp_ids"].([]interface{}); len(members) != 1 || members[0] != "Org1MSP" {
+		t.Errorf("member_msp_ids = %v, want [Org1MSP]", fields["member_msp_ids"])
+	}
+
+	text := captureStdout(t, func() {
+		if err := PrintChannelInfo(info, "text"); err != nil {
+			t.Fatalf("PrintChannelInfo: %v", err)
+		}
+	})
+	if !strings.Contains(text, "Block height: 5\n") {
+		t.Errorf("text output does not contain the block height:\n%s", text)
+	}
+	if err := PrintChannelInfo(info, "yaml"); err == nil {
+		t.Error("PrintChannelInfo accepted an unsupported output format")
+	}
+
+	if _, err := GetChannelInfo(peer, "nochannel"); err == nil {
+		t.Error("GetChannelInfo succeeded for an unknown channel")
+	}
+}
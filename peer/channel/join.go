@@ -2,41 +2,115 @@ package channel
 
 import (
 	"log"
+	"os"
+	"time"
 
+	"github.com/ddr4869/minifab/common/blockutil"
 	"github.com/ddr4869/minifab/common/logger"
 	"github.com/ddr4869/minifab/peer/core"
+	pb_common "github.com/ddr4869/minifab/proto/common"
+	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 )
 
 // getChannelJoinCmd는 기존 채널에 참여합니다
-func getChannelJoinCmd(peer *core.Peer) *cobra.Command {
+func getChannelJoinCmd(operator *ChannelOperator) *cobra.Command {
 
 	var channelName string
+	var blockPath string
 
 	cmd := &cobra.Command{
 		Use:   "join",
 		Short: "기존 채널에 참여합니다",
-		Long:  `지정된 이름으로 기존 채널에 참여합니다.`,
+		Long: `지정된 이름으로 기존 채널에 참여합니다.
+--blockpath를 지정하면 orderer에 요청하지 않고 파일의 설정 블록(블록 0)으로 참여합니다.`,
 		Run: func(cmd *cobra.Command, args []string) {
 			if channelName == "" {
 				log.Fatalf("Channel name is required. Use -c or --channelID flag")
 			}
 
-			if err := JoinChannel(peer, channelName); err != nil {
-				log.Fatalf("Failed to create channel: %v", err)
+			if blockPath == "" {
+				if err := operator.JoinChannel(channelName); err != nil {
+					log.Fatalf("Failed to join channel: %v", err)
+				}
+				return
+			}
+			blockBytes, err := os.ReadFile(blockPath)
+			if err != nil {
+				log.Fatalf("Failed to read config block file %s: %v", blockPath, err)
+			}
+			if err := operator.JoinChannelWithBlock(channelName, blockBytes); err != nil {
+				log.Fatalf("Failed to join channel: %v", err)
 			}
 		},
 	}
 
 	// Fabric CLI 스타일 플래그 추가
 	cmd.Flags().StringVarP(&channelName, "channelID", "c", "", "Channel name (required)")
+	cmd.Flags().StringVarP(&blockPath, "blockpath", "b", "", "Path to the channel config block (block 0) to join with instead of fetching it from the orderer")
 	cmd.MarkFlagRequired("channelID")
 
 	return cmd
 }
 
+// JoinChannel peer에 대해 ChannelOperator.JoinChannel 실행
 func JoinChannel(peer *core.Peer, channelName string) error {
+	return NewChannelOperator(peer).JoinChannel(channelName)
+}
+
+// JoinChannel orderer에서 채널 설정 블록(블록 0)을 받아 채널에 참여
+// 이미 참여한 채널이면 orderer에 요청하지 않고 nil을 반환하므로 여러 번 호출해도 안전하다.
+func (o *ChannelOperator) JoinChannel(channelName string) error {
+	channelManager, ordererClient := o.peer.GetChannelManager(), o.peer.GetOrdererClient()
+	if summary, err := channelManager.GetChannelSummary(channelName); err == nil && !summary.JoinedAt.IsZero() {
+		logger.Infof("[Peer] Channel %s already joined at %s", channelName, summary.JoinedAt.Format(time.RFC3339))
+		return nil
+	}
+	if ordererClient == nil {
+		return errors.New("orderer client is not configured")
+	}
+
 	logger.Infof("[Peer] Joining channel: %s", channelName)
+	configBlock, err := ordererClient.GetBlock(channelName, 0)
+	if err != nil {
+		return errors.Wrapf(err, "failed to fetch config block of channel %s", channelName)
+	}
+	return o.joinChannelByBlock(channelName, configBlock)
+}
+
+// JoinChannelWithBlock peer에 대해 ChannelOperator.JoinChannelWithBlock 실행
+func JoinChannelWithBlock(peer *core.Peer, channelName string, blockBytes []byte) error {
+	return NewChannelOperator(peer).JoinChannelWithBlock(channelName, blockBytes)
+}
+
+// JoinChannelWithBlock proto로 직렬화된 채널 설정 블록(블록 0)으로 채널에 참여
+// 블록은 블록 저장소에 0번 블록으로 저장되므로 peer를 재시작해도 채널이 복원된다.
+func (o *ChannelOperator) JoinChannelWithBlock(channelName string, blockBytes []byte) error {
+	if summary, err := o.peer.GetChannelManager().GetChannelSummary(channelName); err == nil && !summary.JoinedAt.IsZero() {
+		logger.Infof("[Peer] Channel %s already joined at %s", channelName, summary.JoinedAt.Format(time.RFC3339))
+		return nil
+	}
+
+	configBlock, err := blockutil.UnmarshalBlockFromProto(blockBytes)
+	if err != nil {
+		return errors.Wrapf(err, "failed to parse config block of channel %s", channelName)
+	}
+	if configBlock.GetHeader() == nil {
+		return errors.Errorf("config block of channel %s has no header", channelName)
+	}
+	if configBlock.Header.Number != 0 {
+		return errors.Errorf("block %d is not the config block (block 0) of channel %s", configBlock.Header.Number, channelName)
+	}
+
+	logger.Infof("[Peer] Joining channel %s with config block", channelName)
+	return o.joinChannelByBlock(channelName, configBlock)
+}
+
+func (o *ChannelOperator) joinChannelByBlock(channelName string, configBlock *pb_common.Block) error {
+	if err := o.peer.GetChannelManager().JoinChannelByBlock(channelName, configBlock); err != nil {
+		return errors.Wrapf(err, "failed to join channel %s", channelName)
+	}
 
+	logger.Infof("✅ Joined channel: %s", channelName)
 	return nil
 }
@@ -0,0 +1,165 @@
+package channel
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/ddr4869/minifab/common/blockutil"
+	"github.com/ddr4869/minifab/common/configtx"
+	"github.com/ddr4869/minifab/common/msp/msptest"
+	"github.com/ddr4869/minifab/orderer/orderertest"
+	"github.com/ddr4869/minifab/peer/core"
+	pb_common "github.com/ddr4869/minifab/proto/common"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestJoinChannelTwiceKeepsJoinedAt(t *testing.T) {
+	orderer := orderertest.NewServer(t)
+	org := msptest.NewOrg(t, "Org1MSP")
+	orderer.NewChannel(t, "mychannel", org)
+	peer := newTestPeer(t, org, orderer.Address)
+
+	if err := JoinChannel(peer, "mychannel"); err != nil {
+		t.Fatalf("JoinChannel: %v", err)
+	}
+	first, err := peer.ChannelManager.GetChannelSummary("mychannel")
+	if err != nil {
+		t.Fatalf("GetChannelSummary: %v", err)
+	}
+	if first.JoinedAt.IsZero() {
+		t.Fatal("JoinedAt is not set after JoinChannel")
+	}
+
+	if err := JoinChannel(peer, "mychannel"); err != nil {
+		t.Fatalf("second JoinChannel: %v", err)
+	}
+	second, err := peer.ChannelManager.GetChannelSummary("mychannel")
+	if err != nil {
+		t.Fatalf("GetChannelSummary: %v", err)
+	}
+	if !second.JoinedAt.Equal(first.JoinedAt) {
+		t.Errorf("JoinedAt changed from %s to %s on the second join", first.JoinedAt, second.JoinedAt)
+	}
+	if height := peer.BlockStorage.GetChannelHeight("mychannel"); height != 1 {
+		t.Errorf("height after joining twice = %d, want 1", height)
+	}
+
+	// 재시작한 peer는 저장된 참여 시각을 복원한다
+	restarted := core.NewChannelManager(peer.BlockStorage)
+	restored, err := restarted.GetChannelSummary("mychannel")
+	if err != nil {
+		t.Fatalf("GetChannelSummary after restart: %v", err)
+	}
+	if !restored.JoinedAt.Equal(first.JoinedAt) {
+		t.Errorf("JoinedAt after restart = %s, want %s", restored.JoinedAt, first.JoinedAt)
+	}
+}
+
+func TestJoinChannelUnknownChannel(t *testing.T) {
+	orderer := orderertest.NewServer(t)
+	org := msptest.NewOrg(t, "Org1MSP")
+	peer := newTestPeer(t, org, orderer.Address)
+
+	if err := JoinChannel(peer, "nochannel"); err == nil {
+		t.Fatal("JoinChannel of a channel the orderer does not have succeeded")
+	}
+	if peer.ChannelManager.HasChannel("nochannel") {
+		t.Error("failed join registered the channel")
+	}
+}
+
+func TestJoinChannelWithBlockStoresGenesisBlock(t *testing.T) {
+	orderer := orderertest.NewServer(t)
+	org := msptest.NewOrg(t, "Org1MSP")
+	genesis := orderer.NewChannel(t, "mychannel", org)
+	peer := newTestPeer(t, org, orderer.Address)
+
+	blockBytes, err := blockutil.MarshalBlockToProto(genesis)
+	if err != nil {
+		t.Fatalf("MarshalBlockToProto: %v", err)
+	}
+	if err := JoinChannelWithBlock(peer, "mychannel", blockBytes); err != nil {
+		t.Fatalf("JoinChannelWithBlock: %v", err)
+	}
+
+	stored, err := peer.BlockStorage.GetBlock("mychannel", 0)
+	if err != nil {
+		t.Fatalf("GetBlock(0) after join: %v", err)
+	}
+	if stored.Header.HeaderType != pb_common.BlockType_BLOCK_TYPE_CONFIG || !proto.Equal(stored, genesis) {
+		t.Errorf("stored block 0 is not the joined config block: %v", stored.Header)
+	}
+	if _, err := peer.ChannelManager.GetChannelMSP("mychannel", "Org1MSP"); err != nil {
+		t.Errorf("GetChannelMSP after join: %v", err)
+	}
+
+	// 이미 참여한 채널은 다시 저장하지 않는다
+	if err := JoinChannelWithBlock(peer, "mychannel", blockBytes); err != nil {
+		t.Fatalf("second JoinChannelWithBlock: %v", err)
+	}
+	if height := peer.BlockStorage.GetChannelHeight("mychannel"); height != 1 {
+		t.Errorf("height after joining twice = %d, want 1", height)
+	}
+
+	// 재시작한 peer는 저장된 제네시스 블록으로 채널과 MSP를 복원한다
+	restarted := core.NewChannelManager(peer.BlockStorage)
+	if !restarted.HasChannel("mychannel") {
+		t.Fatal("channel was not restored from the stored genesis block")
+	}
+	if _, err := restarted.GetChannelMSP("mychannel", "Org1MSP"); err != nil {
+		t.Errorf("GetChannelMSP after restart: %v", err)
+	}
+}
+
+func TestJoinChannelWithBlockRejectsInvalidBlock(t *testing.T) {
+	orderer := orderertest.NewServer(t)
+	org := msptest.NewOrg(t, "Org1MSP")
+	orderer.NewChannel(t, "mychannel", org)
+	dataBlock := orderer.AppendBlocks(t, "mychannel", 1)[0]
+
+	channelConfig := &configtx.ChannelConfig{
+		CC: &configtx.AppChannelConfig{Organizations: []configtx.Organization{{Name: "Org1", ID: "Org1MSP", MSPCaCert: []byte("not a certificate")}}},
+		SCC: &configtx.SystemChannelInfo{
+			Orderer: configtx.SystemChannelConfig{
+				Organization: configtx.Organization{Name: "OrdererOrg", ID: orderer.Org.MSPID, MSPCaCert: orderer.Org.CACert.Raw},
+			},
+		},
+	}
+	configBytes, err := json.Marshal(channelConfig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	badCA, err := blockutil.GenerateConfigBlock(configBytes, "badchannel", orderer.Org.SigningIdentity())
+	if err != nil {
+		t.Fatalf("GenerateConfigBlock: %v", err)
+	}
+
+	tests := []struct {
+		name      string
+		channelID string
+		block     *pb_common.Block
+	}{
+		{"data block", "mychannel", dataBlock},
+		{"malformed org CA cert", "badchannel", badCA},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			peer := newTestPeer(t, org, orderer.Address)
+			blockBytes, err := blockutil.MarshalBlockToProto(tt.block)
+			if err != nil {
+				t.Fatalf("MarshalBlockToProto: %v", err)
+			}
+			if err := JoinChannelWithBlock(peer, tt.channelID, blockBytes); err == nil {
+				t.Fatal("JoinChannelWithBlock succeeded, want error")
+			}
+			if height := peer.BlockStorage.GetChannelHeight(tt.channelID); height != 0 {
+				t.Errorf("height after rejected join = %d, want 0", height)
+			}
+		})
+	}
+
+	peer := newTestPeer(t, org, orderer.Address)
+	if err := JoinChannelWithBlock(peer, "mychannel", []byte("not a block")); err == nil {
+		t.Error("JoinChannelWithBlock accepted bytes that are not a block")
+	}
+}
@@ -1,42 +1,124 @@
 package channel
 
 import (
+	"encoding/json"
+	"fmt"
 	"log"
+	"os"
+	"text/tabwriter"
 
-	"github.com/ddr4869/minifab/common/logger"
+	"github.com/ddr4869/minifab/common/blockutil"
 	"github.com/ddr4869/minifab/peer/core"
+	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 )
 
+// ChannelListEntry peer channel list --verbose 명령의 채널별 출력 내용
+type ChannelListEntry struct {
+	ChannelID     string `json:"channel_id"`
+	Status        string `json:"status"`
+	BlockHeight   uint64 `json:"block_height"`
+	LastBlockHash string `json:"last_block_hash"`
+	// 채널 설정에 기록된 첫 번째 orderer endpoint
+	OrdererEndpoint string `json:"orderer_endpoint"`
+}
+
 // getChannelListCmd는 채널 목록을 조회합니다
-func getChannelListCmd(peer *core.Peer) *cobra.Command {
+func getChannelListCmd(operator *ChannelOperator) *cobra.Command {
 
-	var channelName string
+	var channelName, output string
+	var verbose bool
 
 	cmd := &cobra.Command{
 		Use:   "list",
 		Short: "사용 가능한 채널 목록을 조회합니다",
-		Long:  `현재 peer가 알고 있는 모든 채널의 목록을 표시합니다.`,
+		Long: `현재 peer가 알고 있는 모든 채널의 목록을 표시합니다.
+--verbose를 지정하면 채널별 상태, 블록 높이, 마지막 블록 해시, orderer endpoint를 표로 표시합니다.`,
 		Run: func(cmd *cobra.Command, args []string) {
-			if channelName == "" {
-				log.Fatalf("Channel name is required. Use -c or --channelID flag")
+			entries, err := operator.ListChannels(channelName)
+			if err != nil {
+				log.Fatalf("Failed to list channels: %v", err)
 			}
-
-			if err := ListChannels(peer, channelName); err != nil {
-				log.Fatalf("Failed to create channel: %v", err)
+			if err := PrintChannelList(entries, verbose, output); err != nil {
+				log.Fatalf("Failed to print channel list: %v", err)
 			}
 		},
 	}
 
-	// Fabric CLI 스타일 플래그 추가
-	cmd.Flags().StringVarP(&channelName, "channelID", "c", "", "Channel name (required)")
-	cmd.MarkFlagRequired("channelID")
+	cmd.Flags().StringVarP(&channelName, "channelID", "c", "", "Only show the given channel")
+	cmd.Flags().BoolVarP(&verbose, "verbose", "v", false, "Show status, block height, last block hash and orderer endpoint")
+	cmd.Flags().StringVarP(&output, "output", "o", "text", "Output format (text|json)")
 
 	return cmd
 }
 
-func ListChannels(peer *core.Peer, channelName string) error {
-	logger.Infof("[Peer] ListChannels channel: %s", channelName)
+// ListChannels peer에 대해 ChannelOperator.ListChannels 실행
+func ListChannels(peer *core.Peer, channelName string) ([]ChannelListEntry, error) {
+	return NewChannelOperator(peer).ListChannels(channelName)
+}
+
+// ListChannels peer가 참여한 채널 목록을 채널 이름 순으로 반환
+// channelName이 지정되면 해당 채널만 반환한다.
+func (o *ChannelOperator) ListChannels(channelName string) ([]ChannelListEntry, error) {
+	membership := o.peer.GetChannelMembership()
+	channelManager, blockStorage := o.peer.GetChannelManager(), o.peer.GetBlockStorage()
+
+	channelNames := channelManager.GetChannelNames()
+	if channelName != "" {
+		if _, exists := membership[channelName]; !exists {
+			return nil, errors.Errorf("channel not found: %s", channelName)
+		}
+		channelNames = []string{channelName}
+	}
 
+	entries := make([]ChannelListEntry, 0, len(channelNames))
+	for _, name := range channelNames {
+		entry := ChannelListEntry{
+			ChannelID:   name,
+			Status:      string(membership[name]),
+			BlockHeight: blockStorage.GetChannelHeight(name),
+		}
+		if entry.BlockHeight > 0 {
+			lastBlock, err := blockStorage.GetLastBlock(name)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to get last block of channel %s", name)
+			}
+			entry.LastBlockHash = blockutil.HashBlock(lastBlock)
+		}
+		if endpoints, err := channelManager.GetOrdererEndpoints(name); err == nil {
+			entry.OrdererEndpoint = endpoints[0]
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+// PrintChannelList 채널 목록을 text 또는 json 형식으로 출력
+// json 출력은 verbose 여부와 관계없이 모든 항목을 포함한다.
+func PrintChannelList(entries []ChannelListEntry, verbose bool, output string) error {
+	switch output {
+	case "json":
+		data, err := json.MarshalIndent(entries, "", "  ")
+		if err != nil {
+			return errors.Wrap(err, "failed to marshal channel list")
+		}
+		fmt.Println(string(data))
+	case "text":
+		if !verbose {
+			for _, entry := range entries {
+				fmt.Println(entry.ChannelID)
+			}
+			return nil
+		}
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "CHANNEL_ID\tSTATUS\tBLOCK_HEIGHT\tLAST_BLOCK_HASH\tORDERER_ENDPOINT")
+		for _, entry := range entries {
+			fmt.Fprintf(w, "%s\t%s\t%d\t%s\t%s\n",
+				entry.ChannelID, entry.Status, entry.BlockHeight, entry.LastBlockHash, entry.OrdererEndpoint)
+		}
+		return w.Flush()
+	default:
+		return errors.Errorf("unsupported output format: %s", output)
+	}
 	return nil
 }
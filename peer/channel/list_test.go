@@ -0,0 +1,75 @@
+package channel
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/ddr4869/minifab/common/blockutil"
+	"github.com/ddr4869/minifab/common/msp/msptest"
+	"github.com/ddr4869/minifab/orderer/orderertest"
+)
+
+func TestListChannelsVerboseJSON(t *testing.T) {
+	orderer := orderertest.NewServer(t)
+	org := msptest.NewOrg(t, "Org1MSP")
+	peer := newTestPeer(t, org, orderer.Address)
+
+	for _, channelID := range []string{"channel2", "channel1"} {
+		if err := peer.ChannelManager.JoinChannelByBlock(channelID, orderer.NewChannel(t, channelID, org)); err != nil {
+			t.Fatalf("JoinChannelByBlock(%s): %v", channelID, err)
+		}
+	}
+	blocks := orderer.AppendBlocks(t, "channel1", 3)
+	for _, block := range blocks {
+		if err := peer.BlockStorage.StoreBlock("channel1", block); err != nil {
+			t.Fatalf("StoreBlock: %v", err)
+		}
+	}
+
+	entries, err := ListChannels(peer, "")
+	if err != nil {
+		t.Fatalf("ListChannels: %v", err)
+	}
+	out := captureStdout(t, func() {
+		if err := PrintChannelList(entries, true, "json"); err != nil {
+			t.Fatalf("PrintChannelList: %v", err)
+		}
+	})
+
+	var got []ChannelListEntry
+	if err := json.Unmarshal([]byte(out), &got); err != nil {
+		t.Fatalf("output is not JSON: %v\n%s", err, out)
+	}
+	if len(got) != 2 || got[0].ChannelID != "channel1" || got[1].ChannelID != "channel2" {
+		t.Fatalf("channels = %+v, want channel1 and channel2 in order", got)
+	}
+	if got[0].BlockHeight != 4 || got[1].BlockHeight != 1 {
+		t.Errorf("block heights = %d, %d, want 4, 1", got[0].BlockHeight, got[1].BlockHeight)
+	}
+	if got[0].LastBlockHash != blockutil.HashBlock(blocks[len(blocks)-1]) {
+		t.Errorf("channel1 last_block_hash = %s, want the hash of block 3", got[0].LastBlockHash)
+	}
+	for _, entry := range got {
+		if entry.Status != "joined" {
+			t.Errorf("%s status = %q, want joined", entry.ChannelID, entry.Status)
+		}
+		if entry.OrdererEndpoint != orderer.Address {
+			t.Errorf("%s orderer_endpoint = %q, want %s", entry.ChannelID, entry.OrdererEndpoint, orderer.Address)
+		}
+	}
+
+	text := captureStdout(t, func() {
+		if err := PrintChannelList(entries, true, "text"); err != nil {
+			t.Fatalf("PrintChannelList: %v", err)
+		}
+	})
+	lines := strings.Split(strings.TrimSpace(text), "\n")
+	if len(lines) != 3 || !strings.HasPrefix(lines[0], "CHANNEL_ID") {
+		t.Errorf("verbose text output has %d lines, want a header and 2 rows:\n%s", len(lines), text)
+	}
+
+	if _, err := ListChannels(peer, "nochannel"); err == nil {
+		t.Error("ListChannels succeeded for an unknown channel")
+	}
+}
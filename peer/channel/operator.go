@@ -0,0 +1,34 @@
+package channel
+
+import (
+	"github.com/ddr4869/minifab/common/msp"
+	"github.com/ddr4869/minifab/peer/chaincode"
+	"github.com/ddr4869/minifab/peer/common"
+	"github.com/ddr4869/minifab/peer/core"
+	"github.com/ddr4869/minifab/peer/storage"
+	pb_common "github.com/ddr4869/minifab/proto/common"
+)
+
+// PeerInterface 채널 작업에 필요한 peer 기능 (*core.Peer가 구현)
+type PeerInterface interface {
+	GetPeerSigningIdentity() msp.SigningIdentity
+	GetClientSigningIdentity() msp.SigningIdentity
+	GetOrdererClient() *common.OrdererClient
+	GetOrdererAddress() string
+	GetBlockStorage() *storage.BlockStorage
+	GetChannelManager() core.ChannelManagerAPI
+	GetChaincodes() *chaincode.ChaincodeRegistry
+	GetChannelMembership() map[string]core.ChannelStatus
+	ValidateTransaction(channelID string, tx *pb_common.Transaction) error
+}
+
+// ChannelOperator 한 peer에 대한 채널 생성, 참여, 조회, 트랜잭션 제출 작업
+// 작업 대상 peer를 직접 가지므로 한 프로세스의 여러 peer가 서로 영향 없이 동시에 사용할 수 있다.
+type ChannelOperator struct {
+	peer PeerInterface
+}
+
+// NewChannelOperator peer에 대한 ChannelOperator 생성
+func NewChannelOperator(peer PeerInterface) *ChannelOperator {
+	return &ChannelOperator{peer: peer}
+}
@@ -0,0 +1,100 @@
+package channel
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"os"
+
+	"github.com/ddr4869/minifab/common/logger"
+	"github.com/ddr4869/minifab/peer/core"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+// query 명령의 종료 코드
+const (
+	ExitQueryFailed     = 1
+	ExitChannelNotFound = 2
+	ExitKeyNotFound     = 4
+)
+
+var (
+	ErrChannelNotFound = errors.New("channel not found")
+	ErrKeyNotFound     = errors.New("key not found")
+)
+
+// getChannelQueryCmd는 채널 world state의 key 값을 조회합니다
+func getChannelQueryCmd(operator *ChannelOperator) *cobra.Command {
+
+	var channelName, key, encoding string
+
+	cmd := &cobra.Command{
+		Use:   "query",
+		Short: "채널 world state의 key 값을 조회합니다",
+		Long: `지정된 채널의 world state에서 key 값을 조회하여 출력합니다.
+채널이 없으면 종료 코드 2, key가 없으면 (nil)을 출력하고 종료 코드 4로 종료합니다.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			value, err := operator.QueryState(channelName, key, encoding)
+			switch {
+			case errors.Is(err, ErrChannelNotFound):
+				logger.Errorf("Failed to query state: %v", err)
+				os.Exit(ExitChannelNotFound)
+			case errors.Is(err, ErrKeyNotFound):
+				fmt.Println("(nil)")
+				os.Exit(ExitKeyNotFound)
+			case err != nil:
+				logger.Errorf("Failed to query state: %v", err)
+				os.Exit(ExitQueryFailed)
+			}
+			fmt.Println(value)
+		},
+	}
+
+	cmd.Flags().StringVarP(&channelName, "channelID", "c", "", "Channel name (required)")
+	cmd.Flags().StringVarP(&key, "key", "k", "", "World state key (required)")
+	cmd.Flags().StringVar(&encoding, "encoding", "hex", "Output encoding (hex|base64|utf8)")
+	cmd.MarkFlagRequired("channelID")
+	cmd.MarkFlagRequired("key")
+
+	return cmd
+}
+
+// QueryState peer에 대해 ChannelOperator.QueryState 실행
+func QueryState(peer *core.Peer, channelName, key, encoding string) (string, error) {
+	return NewChannelOperator(peer).QueryState(channelName, key, encoding)
+}
+
+// QueryState 채널 world state에서 key 값을 읽어 encoding 형식의 문자열로 반환
+func (o *ChannelOperator) QueryState(channelName, key, encoding string) (string, error) {
+	if !o.peer.GetChannelManager().HasChannel(channelName) {
+		return "", errors.Wrap(ErrChannelNotFound, channelName)
+	}
+
+	worldState, err := o.peer.GetChaincodes().GetWorldState(channelName)
+	if err != nil {
+		return "", err
+	}
+	value, err := worldState.GetState(key)
+	if err != nil {
+		return "", err
+	}
+	if value == nil {
+		return "", errors.Wrap(ErrKeyNotFound, key)
+	}
+
+	return encodeValue(value, encoding)
+}
+
+func encodeValue(value []byte, encoding string) (string, error) {
+	switch encoding {
+	case "hex":
+		return hex.EncodeToString(value), nil
+	case "base64":
+		return base64.StdEncoding.EncodeToString(value), nil
+	case "utf8":
+		return string(value), nil
+	default:
+		return "", errors.Errorf("unsupported encoding: %s (hex|base64|utf8)", encoding)
+	}
+}
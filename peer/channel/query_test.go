@@ -0,0 +1,132 @@
+package channel
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"os/exec"
+	"strings"
+	"testing"
+
+	"github.com/ddr4869/minifab/peer/chaincode"
+	"github.com/ddr4869/minifab/peer/core"
+	"github.com/ddr4869/minifab/peer/storage"
+	"github.com/pkg/errors"
+)
+
+// newQueryTestPeer mychannel world state에 greeting=hello가 저장된 peer
+func newQueryTestPeer(t *testing.T) *core.Peer {
+	t.Helper()
+
+	blockStorage := storage.NewBlockStorageWithPath(t.TempDir())
+	peer := &core.Peer{
+		BlockStorage:   blockStorage,
+		ChannelManager: core.NewChannelManager(blockStorage),
+		Chaincodes:     chaincode.NewChaincodeRegistry(t.TempDir()),
+	}
+	peer.ChannelManager.AddChannel("mychannel", testChannelConfig(nil))
+	state, err := peer.Chaincodes.GetWorldState("mychannel")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := state.PutState("greeting", []byte("hello")); err != nil {
+		t.Fatal(err)
+	}
+	return peer
+}
+
+// captureStdout fn 실행 중 os.Stdout에 출력된 내용
+func captureStdout(t *testing.T, fn func()) string {
+	t.Helper()
+
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	stdout := os.Stdout
+	os.Stdout = w
+	defer func() { os.Stdout = stdout }()
+
+	fn()
+	w.Close()
+	var buf bytes.Buffer
+	if _, err := io.Copy(&buf, r); err != nil {
+		t.Fatal(err)
+	}
+	return buf.String()
+}
+
+func TestQueryCommandPrintsValue(t *testing.T) {
+	peer := newQueryTestPeer(t)
+
+	tests := []struct {
+		encoding string
+		want     string
+	}{
+		{"hex", "68656c6c6f"},
+		{"base64", "aGVsbG8="},
+		{"utf8", "hello"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.encoding, func(t *testing.T) {
+			cmd := getChannelQueryCmd(NewChannelOperator(peer))
+			cmd.SetArgs([]string{"-c", "mychannel", "-k", "greeting", "--encoding", tt.encoding})
+			out := captureStdout(t, func() {
+				if err := cmd.Execute(); err != nil {
+					t.Fatalf("Execute: %v", err)
+				}
+			})
+			if strings.TrimSpace(out) != tt.want {
+				t.Errorf("stdout = %q, want %q", out, tt.want)
+			}
+		})
+	}
+}
+
+func TestQueryState(t *testing.T) {
+	peer := newQueryTestPeer(t)
+
+	if _, err := QueryState(peer, "nochannel", "greeting", "hex"); !errors.Is(err, ErrChannelNotFound) {
+		t.Errorf("unknown channel: err = %v, want ErrChannelNotFound", err)
+	}
+	if _, err := QueryState(peer, "mychannel", "missing", "hex"); !errors.Is(err, ErrKeyNotFound) {
+		t.Errorf("missing key: err = %v, want ErrKeyNotFound", err)
+	}
+	if _, err := QueryState(peer, "mychannel", "greeting", "base32"); err == nil {
+		t.Error("unsupported encoding succeeded")
+	}
+}
+
+// TestQueryCommandExitCodes 종료 코드는 os.Exit로 설정되므로 테스트 바이너리를 하위 프로세스로 실행해 확인
+func TestQueryCommandExitCodes(t *testing.T) {
+	if args := os.Getenv("QUERY_TEST_ARGS"); args != "" {
+		cmd := getChannelQueryCmd(NewChannelOperator(newQueryTestPeer(t)))
+		cmd.SetArgs(strings.Fields(args))
+		cmd.Execute()
+		return
+	}
+
+	tests := []struct {
+		name     string
+		args     string
+		wantCode int
+		wantOut  string
+	}{
+		{"unknown channel", "-c nochannel -k greeting", ExitChannelNotFound, ""},
+		{"missing key", "-c mychannel -k missing", ExitKeyNotFound, "(nil)"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := exec.Command(os.Args[0], "-test.run=^TestQueryCommandExitCodes$")
+			cmd.Env = append(os.Environ(), "QUERY_TEST_ARGS="+tt.args)
+			out, err := cmd.Output()
+			var exitErr *exec.ExitError
+			if !errors.As(err, &exitErr) || exitErr.ExitCode() != tt.wantCode {
+				t.Fatalf("exit error = %v, want exit code %d", err, tt.wantCode)
+			}
+			if tt.wantOut != "" && !strings.Contains(string(out), tt.wantOut) {
+				t.Errorf("stdout = %q, want %q", out, tt.wantOut)
+			}
+		})
+	}
+}
@@ -0,0 +1,37 @@
+package channel
+
+import (
+	"log"
+
+	"github.com/ddr4869/minifab/common/logger"
+	"github.com/ddr4869/minifab/peer/core"
+	"github.com/spf13/cobra"
+)
+
+// getChannelResetAllCmd는 모든 채널의 로컬 원장을 삭제하고 orderer로부터 다시 동기화합니다
+func getChannelResetAllCmd(peer *core.Peer) *cobra.Command {
+
+	var confirm bool
+
+	cmd := &cobra.Command{
+		Use:   "reset-all",
+		Short: "모든 채널의 로컬 원장을 삭제하고 다시 동기화합니다",
+		Long: `peer가 참여한 모든 채널의 로컬 블록을 삭제한 뒤 orderer로부터 처음부터 다시 받아옵니다.
+로컬 원장 전체를 신뢰할 수 없을 때(저장소 손상 등) 사용하며, 실수로 실행하지 않도록 --confirm이 필요합니다.
+모든 채널의 설정 블록을 먼저 받아오므로 orderer에 접속할 수 없으면 아무 채널도 삭제하지 않습니다.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			if !confirm {
+				log.Fatalf("Refusing to reset all channels without --confirm")
+			}
+
+			if err := peer.ResetAll(); err != nil {
+				log.Fatalf("Failed to reset channels: %v", err)
+			}
+			logger.Info("✅ All channels reset and resynced")
+		},
+	}
+
+	cmd.Flags().BoolVar(&confirm, "confirm", false, "Confirm wiping the local ledger of every channel")
+
+	return cmd
+}
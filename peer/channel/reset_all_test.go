@@ -0,0 +1,85 @@
+package channel
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ddr4869/minifab/common/blockutil"
+	"github.com/ddr4869/minifab/common/msp/msptest"
+	"github.com/ddr4869/minifab/orderer/orderertest"
+	"github.com/ddr4869/minifab/peer/core"
+)
+
+// joinSyncedChannels orderer에 blockCount개 블록을 가진 채널 count개를 만들고 peer를 참여시켜 동기화
+func joinSyncedChannels(t *testing.T, orderer *orderertest.Server, org *msptest.Org, peer *core.Peer, count, blockCount int) []string {
+	t.Helper()
+
+	var channelIDs []string
+	for i := 0; i < count; i++ {
+		channelID := fmt.Sprintf("channel%d", i)
+		orderer.NewChannel(t, channelID, org)
+		orderer.AppendBlocks(t, channelID, blockCount-1)
+		if err := JoinChannel(peer, channelID); err != nil {
+			t.Fatalf("JoinChannel(%s): %v", channelID, err)
+		}
+		if err := peer.SyncChannel(channelID); err != nil {
+			t.Fatalf("SyncChannel(%s): %v", channelID, err)
+		}
+		channelIDs = append(channelIDs, channelID)
+	}
+	return channelIDs
+}
+
+func TestResetAllResyncsEveryChannel(t *testing.T) {
+	orderer := orderertest.NewServer(t)
+	org := msptest.NewOrg(t, "Org1MSP")
+	peer := newTestPeer(t, org, orderer.Address)
+	channelIDs := joinSyncedChannels(t, orderer, org, peer, 3, 20)
+
+	// channel1의 블록 파일 두 개를 지운다
+	channelDir := filepath.Join(peer.BlockStorage.StoragePath(), "channel1")
+	for _, number := range []uint64{5, 12} {
+		if err := os.Remove(filepath.Join(channelDir, blockutil.PaddedBlockFileName(number))); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := peer.ResetAll(); err != nil {
+		t.Fatalf("ResetAll: %v", err)
+	}
+	for _, channelID := range channelIDs {
+		want := uint64(len(orderer.Blocks(channelID)))
+		if height := peer.BlockStorage.GetChannelHeight(channelID); height != want {
+			t.Errorf("%s height after ResetAll = %d, want %d", channelID, height, want)
+		}
+		for number := uint64(0); number < want; number++ {
+			if _, err := peer.BlockStorage.GetBlock(channelID, number); err != nil {
+				t.Errorf("%s block %d after ResetAll: %v", channelID, number, err)
+			}
+		}
+	}
+}
+
+func TestResetAllKeepsLedgerWhenConfigBlockUnavailable(t *testing.T) {
+	orderer := orderertest.NewServer(t)
+	org := msptest.NewOrg(t, "Org1MSP")
+	peer := newTestPeer(t, org, orderer.Address)
+	channelIDs := joinSyncedChannels(t, orderer, org, peer, 2, 20)
+
+	// 이 orderer가 모르는 채널에 참여시켜 설정 블록 조회가 실패하게 한다
+	other := orderertest.NewServer(t)
+	if err := peer.ChannelManager.JoinChannelByBlock("unknown", other.NewChannel(t, "unknown", org)); err != nil {
+		t.Fatalf("JoinChannelByBlock: %v", err)
+	}
+
+	if err := peer.ResetAll(); err == nil {
+		t.Fatal("ResetAll succeeded although a config block is unavailable")
+	}
+	for _, channelID := range channelIDs {
+		if height := peer.BlockStorage.GetChannelHeight(channelID); height != 20 {
+			t.Errorf("%s height after failed ResetAll = %d, want 20", channelID, height)
+		}
+	}
+}
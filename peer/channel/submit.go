@@ -0,0 +1,73 @@
+package channel
+
+import (
+	"github.com/ddr4869/minifab/common/blockutil"
+	"github.com/ddr4869/minifab/common/logger"
+	"github.com/ddr4869/minifab/peer/common"
+	"github.com/ddr4869/minifab/peer/core"
+	pb_common "github.com/ddr4869/minifab/proto/common"
+	"github.com/pkg/errors"
+)
+
+// SubmitTransaction peer에 대해 ChannelOperator.SubmitTransaction 실행
+func SubmitTransaction(peer *core.Peer, channelName, chaincodeID string, data []byte) error {
+	return NewChannelOperator(peer).SubmitTransaction(channelName, chaincodeID, data)
+}
+
+// SubmitTransaction 채널 설정에 정의된 orderer endpoint들에 round-robin 순서로 트랜잭션을 제출
+// chaincodeID는 트랜잭션의 ChaincodeId로 기록되며, 체인코드 트랜잭션이 아니면 비워 둔다.
+// 모든 endpoint에서 실패한 경우에만 에러를 반환한다.
+func (o *ChannelOperator) SubmitTransaction(channelName, chaincodeID string, data []byte) error {
+	channelManager := o.peer.GetChannelManager()
+	endpoints, err := channelManager.NextOrdererEndpoints(channelName)
+	if err != nil {
+		return errors.Wrap(err, "failed to get orderer endpoints")
+	}
+
+	signer := o.peer.GetClientSigningIdentity()
+	tx, err := blockutil.CreateChaincodeTransaction(signer, chaincodeID, data)
+	if err != nil {
+		return errors.Wrap(err, "failed to create transaction")
+	}
+	if err := o.peer.ValidateTransaction(channelName, tx); err != nil {
+		return errors.Wrap(err, "invalid transaction")
+	}
+	txBytes, err := blockutil.MarshalTransactionToProto(tx)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal transaction")
+	}
+	envelope, err := createSignedEnvelope(signer, pb_common.MessageType_MESSAGE_TYPE_TRANSACTION, channelName, txBytes)
+	if err != nil {
+		return errors.Wrap(err, "failed to create envelope")
+	}
+
+	var lastErr error
+	for _, endpoint := range endpoints {
+		if err := o.submitToOrderer(endpoint, envelope); err != nil {
+			logger.Warnf("[Peer] Failed to submit transaction %s to orderer %s: %v", tx.TxId, endpoint, err)
+			lastErr = err
+			continue
+		}
+		logger.Infof("[Peer] Transaction %s submitted to orderer %s (channel: %s)", tx.TxId, endpoint, channelName)
+		if err := channelManager.IncrementTransactionCount(channelName); err != nil {
+			logger.Warnf("[Peer] Failed to update transaction count of channel %s: %v", channelName, err)
+		}
+		return nil
+	}
+
+	return errors.Wrapf(lastErr, "failed to submit transaction to any orderer of channel %s", channelName)
+}
+
+func (o *ChannelOperator) submitToOrderer(endpoint string, envelope *pb_common.Envelope) error {
+	if ordererClient := o.peer.GetOrdererClient(); ordererClient != nil && endpoint == o.peer.GetOrdererAddress() {
+		return ordererClient.SubmitTransaction(envelope)
+	}
+
+	ordererClient, err := common.NewOrdererClient(endpoint)
+	if err != nil {
+		return err
+	}
+	defer ordererClient.Close()
+
+	return ordererClient.SubmitTransaction(envelope)
+}
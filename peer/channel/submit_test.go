@@ -0,0 +1,87 @@
+package channel
+
+import (
+	"testing"
+
+	"github.com/ddr4869/minifab/common/msp/msptest"
+)
+
+func TestSubmitTransactionRoutesToChannelOrderer(t *testing.T) {
+	org := msptest.NewOrg(t, "Org1MSP")
+	orderer1 := startTestOrderer(t, org)
+	orderer2 := startTestOrderer(t, org)
+	orderer1.addChannel(t, "channela", testChannelConfig([]string{orderer1.address}, org))
+	orderer2.addChannel(t, "channelb", testChannelConfig([]string{orderer2.address}, org))
+
+	// peer의 기본 orderer는 orderer1이지만 channelb는 설정의 endpoint인 orderer2로 가야 한다
+	peer := newTestPeer(t, org, orderer1.address)
+	peer.ChannelManager.AddChannel("channela", testChannelConfig([]string{orderer1.address}, org))
+	peer.ChannelManager.AddChannel("channelb", testChannelConfig([]string{orderer2.address}, org))
+
+	if err := SubmitTransaction(peer, "channela", "", []byte("to-a")); err != nil {
+		t.Fatalf("SubmitTransaction(channela): %v", err)
+	}
+	if err := SubmitTransaction(peer, "channelb", "", []byte("to-b")); err != nil {
+		t.Fatalf("SubmitTransaction(channelb): %v", err)
+	}
+
+	if n := orderer1.ChainSupport.PendingQueue.Len("channela"); n != 1 {
+		t.Errorf("orderer1 channela pending = %d, want 1", n)
+	}
+	if n := orderer2.ChainSupport.PendingQueue.Len("channelb"); n != 1 {
+		t.Errorf("orderer2 channelb pending = %d, want 1", n)
+	}
+	if n := orderer1.ChainSupport.PendingQueue.Len("channelb"); n != 0 {
+		t.Errorf("orderer1 channelb pending = %d, want 0", n)
+	}
+	if n := orderer2.ChainSupport.PendingQueue.Len("channela"); n != 0 {
+		t.Errorf("orderer2 channela pending = %d, want 0", n)
+	}
+}
+
+func TestSubmitTransactionRoundRobin(t *testing.T) {
+	org := msptest.NewOrg(t, "Org1MSP")
+	orderer1 := startTestOrderer(t, org)
+	orderer2 := startTestOrderer(t, org)
+	endpoints := []string{orderer1.address, orderer2.address}
+	orderer1.addChannel(t, "mychannel", testChannelConfig(endpoints, org))
+	orderer2.addChannel(t, "mychannel", testChannelConfig(endpoints, org))
+
+	peer := newTestPeer(t, org, orderer1.address)
+	peer.ChannelManager.AddChannel("mychannel", testChannelConfig(endpoints, org))
+
+	for i := 0; i < 4; i++ {
+		if err := SubmitTransaction(peer, "mychannel", "", []byte{byte(i)}); err != nil {
+			t.Fatalf("SubmitTransaction %d: %v", i, err)
+		}
+	}
+	if n := orderer1.ChainSupport.PendingQueue.Len("mychannel"); n != 2 {
+		t.Errorf("orderer1 pending = %d, want 2", n)
+	}
+	if n := orderer2.ChainSupport.PendingQueue.Len("mychannel"); n != 2 {
+		t.Errorf("orderer2 pending = %d, want 2", n)
+	}
+}
+
+func TestSubmitTransactionFailsOverToNextEndpoint(t *testing.T) {
+	org := msptest.NewOrg(t, "Org1MSP")
+	orderer := startTestOrderer(t, org)
+	// 첫 endpoint에는 아무 서버도 없다
+	endpoints := []string{"127.0.0.1:1", orderer.address}
+	orderer.addChannel(t, "mychannel", testChannelConfig(endpoints, org))
+
+	peer := newTestPeer(t, org, orderer.address)
+	peer.ChannelManager.AddChannel("mychannel", testChannelConfig(endpoints, org))
+
+	if err := SubmitTransaction(peer, "mychannel", "", []byte("payload")); err != nil {
+		t.Fatalf("SubmitTransaction: %v", err)
+	}
+	if n := orderer.ChainSupport.PendingQueue.Len("mychannel"); n != 1 {
+		t.Errorf("pending = %d, want 1", n)
+	}
+
+	peer.ChannelManager.AddChannel("deadchannel", testChannelConfig([]string{"127.0.0.1:1"}, org))
+	if err := SubmitTransaction(peer, "deadchannel", "", []byte("payload")); err == nil {
+		t.Error("SubmitTransaction succeeded with no reachable orderer")
+	}
+}
@@ -0,0 +1,47 @@
+package channel
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/ddr4869/minifab/common/msp/msptest"
+	"github.com/ddr4869/minifab/orderer/orderertest"
+	peersync "github.com/ddr4869/minifab/peer/sync"
+)
+
+func TestSyncStatusReportsLag(t *testing.T) {
+	orderer := orderertest.NewServer(t)
+	org := msptest.NewOrg(t, "Org1MSP")
+	peer := newTestPeer(t, org, orderer.Address)
+
+	if err := peer.ChannelManager.JoinChannelByBlock("mychannel", orderer.NewChannel(t, "mychannel", org)); err != nil {
+		t.Fatalf("JoinChannelByBlock: %v", err)
+	}
+	orderer.AppendBlocks(t, "mychannel", 10)
+
+	synchronizer := peersync.NewBlockSynchronizer(peer, peer.OrdererClient, peer.BlockStorage)
+	statuses := synchronizer.GetSyncStatus("mychannel")
+	if len(statuses) != 1 {
+		t.Fatalf("GetSyncStatus returned %d channels, want 1", len(statuses))
+	}
+	status := statuses[0]
+	if status.LocalHeight != 1 || status.RemoteHeight != 11 || status.Lag != 10 {
+		t.Fatalf("sync status = %+v, want local 1, remote 11, lag 10", status)
+	}
+
+	var out bytes.Buffer
+	if err := PrintSyncStatus(&out, statuses); err != nil {
+		t.Fatalf("PrintSyncStatus: %v", err)
+	}
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("sync-status output has %d lines, want a header and 1 row:\n%s", len(lines), out.String())
+	}
+	if fields := strings.Fields(lines[0]); strings.Join(fields, " ") != "CHANNEL LOCAL_HEIGHT REMOTE_HEIGHT LAG" {
+		t.Errorf("header = %q", lines[0])
+	}
+	if fields := strings.Fields(lines[1]); strings.Join(fields, " ") != "mychannel 1 11 10" {
+		t.Errorf("row = %q, want mychannel 1 11 10", lines[1])
+	}
+}
@@ -2,18 +2,42 @@ package common
 
 import (
 	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/json"
 	"fmt"
 	"time"
 
+	"github.com/ddr4869/minifab/common/blockutil"
+	"github.com/ddr4869/minifab/common/configtx"
 	"github.com/ddr4869/minifab/common/logger"
+	"github.com/ddr4869/minifab/common/msp"
 	pb_common "github.com/ddr4869/minifab/proto/common"
 	pb_orderer "github.com/ddr4869/minifab/proto/orderer"
 	"github.com/pkg/errors"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/connectivity"
 	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 )
 
+const (
+	// DefaultSubmitTimeout SubmitTransaction의 기본 timeout
+	DefaultSubmitTimeout = 5 * time.Second
+	// DefaultSendTimeout 채널 생성 envelope 전송(Send)의 기본 timeout
+	DefaultSendTimeout = 5 * time.Second
+	// DefaultPingTimeout 연결 확인(Ping)의 기본 timeout
+	DefaultPingTimeout = 2 * time.Second
+	// DeadlineMetadataKey 트랜잭션 제출 기한을 전달하는 gRPC 메타데이터 키
+	DeadlineMetadataKey = "x-minifab-deadline"
+)
+
+// ErrBlockNotFound orderer에 요청한 번호의 블록이 아직 없음
+var ErrBlockNotFound = errors.New("block not found")
+
 type OrdererService interface {
 	CreateChannel(channelName string) error
 	Close() error
@@ -48,13 +72,26 @@ func NewOrdererClient(address string) (*OrdererClient, error) {
 	}, nil
 }
 
+// Close closes the underlying gRPC connection
+func (oc *OrdererClient) Close() error {
+	return oc.conn.Close()
+}
+
 // GetClient returns the internal proto client for direct gRPC calls
 func (oc *OrdererClient) GetClient() pb_orderer.OrdererServiceClient {
 	return oc.client
 }
 
 func (oc *OrdererClient) Send(envelope *pb_common.Envelope) (*pb_orderer.BroadcastResponse, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	return oc.SendWithTimeout(envelope, 0)
+}
+
+// SendWithTimeout 채널 생성 envelope을 timeout 안에 orderer에 전송 (0이면 DefaultSendTimeout)
+func (oc *OrdererClient) SendWithTimeout(envelope *pb_common.Envelope, timeout time.Duration) (*pb_orderer.BroadcastResponse, error) {
+	if timeout == 0 {
+		timeout = DefaultSendTimeout
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
 	defer cancel()
 	stream, err := oc.client.CreateChannel(ctx)
 	if err != nil {
@@ -81,3 +118,199 @@ func (oc *OrdererClient) Send(envelope *pb_common.Envelope) (*pb_orderer.Broadca
 	logger.Infof("✅ FINISH")
 	return block, nil
 }
+
+// SubmitTransaction 트랜잭션 envelope을 기본 timeout으로 orderer에 전달
+func (oc *OrdererClient) SubmitTransaction(envelope *pb_common.Envelope) error {
+	return oc.SubmitTransactionWithTimeout(context.Background(), envelope, 0)
+}
+
+// SubmitTransactionWithTimeout ctx에서 파생된 timeout 안에 트랜잭션 envelope을 orderer에 전달
+// timeout이 0이면 DefaultSubmitTimeout을 사용하며, 기한은 x-minifab-deadline 메타데이터로 함께 전달된다.
+func (oc *OrdererClient) SubmitTransactionWithTimeout(ctx context.Context, envelope *pb_common.Envelope, timeout time.Duration) error {
+	if timeout == 0 {
+		timeout = DefaultSubmitTimeout
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	deadline, _ := ctx.Deadline()
+	ctx = metadata.AppendToOutgoingContext(ctx, DeadlineMetadataKey, deadline.Format(time.RFC3339Nano))
+
+	response, err := oc.client.SubmitTransaction(ctx, envelope)
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		return errors.Wrap(ctxErr, "failed to submit transaction")
+	}
+	if err != nil {
+		return errors.Wrap(err, "failed to submit transaction")
+	}
+	if response.Status != pb_common.Status_OK {
+		return errors.Errorf("[%d]failed to submit transaction", response.Status)
+	}
+	return nil
+}
+
+// GetBlock orderer에 저장된 채널의 블록을 번호로 조회
+// 해당 번호의 블록이 없으면 ErrBlockNotFound를 반환한다.
+func (oc *OrdererClient) GetBlock(channelID string, blockNumber uint64) (*pb_common.Block, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	response, err := oc.client.GetBlock(ctx, &pb_orderer.BlockRequest{
+		ChannelId:   channelID,
+		BlockNumber: blockNumber,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get block")
+	}
+	switch response.Status {
+	case pb_common.Status_OK:
+		return response.Block, nil
+	case pb_common.Status_NOT_FOUND:
+		return nil, ErrBlockNotFound
+	default:
+		return nil, errors.Errorf("[%d]failed to get block %d of channel %s", response.Status, blockNumber, channelID)
+	}
+}
+
+// GetBlocks orderer에 저장된 채널의 [start, end) 범위 블록을 한 번에 조회
+// 범위의 끝 블록이 아직 없으면 ErrBlockNotFound를 반환한다.
+func (oc *OrdererClient) GetBlocks(channelID string, start, end uint64) ([]*pb_common.Block, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	response, err := oc.client.GetBlocks(ctx, &pb_orderer.BlockRangeRequest{
+		ChannelId:  channelID,
+		StartBlock: start,
+		EndBlock:   end,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get blocks")
+	}
+	switch response.Status {
+	case pb_common.Status_OK:
+		return response.Blocks, nil
+	case pb_common.Status_NOT_FOUND:
+		return nil, ErrBlockNotFound
+	default:
+		return nil, errors.Errorf("[%d]failed to get blocks [%d, %d) of channel %s", response.Status, start, end, channelID)
+	}
+}
+
+// DeliverBlocks startBlock부터 orderer가 전달하는 블록마다 handle을 호출
+// ctx가 취소되거나 stream/handle에서 에러가 발생할 때까지 반환하지 않는다.
+func (oc *OrdererClient) DeliverBlocks(ctx context.Context, channelID string, startBlock uint64, handle func(*pb_common.Block) error) error {
+	stream, err := oc.client.DeliverBlocks(ctx, &pb_orderer.DeliverRequest{
+		ChannelId:  channelID,
+		StartBlock: startBlock,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to open deliver stream")
+	}
+
+	for {
+		response, err := stream.Recv()
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			return errors.Wrap(err, "failed to receive block")
+		}
+		if response.Status != pb_common.Status_OK {
+			return errors.Errorf("[%d]failed to deliver blocks of channel %s", response.Status, channelID)
+		}
+		if err := handle(response.Block); err != nil {
+			return err
+		}
+	}
+}
+
+// Ping orderer에 접속할 수 있는지 확인
+// gRPC health check 서비스를 먼저 호출하고, orderer에 등록되어 있지 않으면 Echo RPC로 확인한다.
+func (oc *OrdererClient) Ping(ctx context.Context) error {
+	response, err := healthpb.NewHealthClient(oc.conn).Check(ctx, &healthpb.HealthCheckRequest{})
+	if status.Code(err) == codes.Unimplemented {
+		return oc.echo(ctx)
+	}
+	if err != nil {
+		return errors.Wrap(err, "failed to ping orderer")
+	}
+	if response.Status != healthpb.HealthCheckResponse_SERVING {
+		return errors.Errorf("orderer is not serving: %s", response.Status)
+	}
+	return nil
+}
+
+func (oc *OrdererClient) echo(ctx context.Context) error {
+	response, err := oc.client.Echo(ctx, &pb_orderer.EchoRequest{})
+	if err != nil {
+		return errors.Wrap(err, "failed to ping orderer")
+	}
+	if response.Status != pb_common.Status_OK {
+		return errors.Errorf("[%d]failed to ping orderer", response.Status)
+	}
+	return nil
+}
+
+// GetChannelHeight orderer에 저장된 채널의 블록 높이 조회
+func (oc *OrdererClient) GetChannelHeight(channelID string) (uint64, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	response, err := oc.client.GetChannelHeight(ctx, &pb_orderer.ChannelHeightRequest{
+		ChannelId: channelID,
+	})
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to get channel height")
+	}
+	if response.Status != pb_common.Status_OK {
+		return 0, errors.Errorf("[%d]failed to get height of channel %s", response.Status, channelID)
+	}
+	return response.Height, nil
+}
+
+// GetSystemChannelInfo orderer의 시스템 채널 설정(consortium, orderer endpoint 등) 조회
+func (oc *OrdererClient) GetSystemChannelInfo(ctx context.Context) (*configtx.SystemChannelInfo, error) {
+	response, err := oc.client.GetSystemChannelConfig(ctx, &pb_orderer.SystemChannelRequest{})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get system channel config")
+	}
+	if response.Status != pb_common.Status_OK {
+		return nil, errors.Errorf("[%d]failed to get system channel config", response.Status)
+	}
+	info := &configtx.SystemChannelInfo{}
+	if err := json.Unmarshal(response.Config, info); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal system channel config")
+	}
+	return info, nil
+}
+
+// NotifyBlockReceived 블록을 저장했음을 서명과 함께 orderer에 알림
+func (oc *OrdererClient) NotifyBlockReceived(channelID string, blockNumber uint64, peerID string, signer msp.SigningIdentity) error {
+	message := blockutil.BlockReceiptMessage(channelID, blockNumber, peerID)
+	messageHash := sha256.Sum256(message)
+	signature, err := signer.Sign(rand.Reader, messageHash[:], nil)
+	if err != nil {
+		return errors.Wrap(err, "failed to sign block receipt")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	response, err := oc.client.NotifyBlockReceived(ctx, &pb_orderer.BlockReceiptNotification{
+		ChannelId:   channelID,
+		BlockNumber: blockNumber,
+		PeerId:      peerID,
+		Signature:   signature,
+		Identity: &pb_common.Identity{
+			Creator: signer.GetCertificate().Raw,
+			MspId:   signer.GetIdentifier().Mspid,
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to notify block receipt")
+	}
+	if response.Status != pb_common.Status_OK {
+		return errors.Errorf("[%d]failed to notify receipt of block %d of channel %s", response.Status, blockNumber, channelID)
+	}
+	return nil
+}
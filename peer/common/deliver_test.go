@@ -0,0 +1,107 @@
+package common
+
+import (
+	"context"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	pb_common "github.com/ddr4869/minifab/proto/common"
+	pb_orderer "github.com/ddr4869/minifab/proto/orderer"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// fakeOrdererServer SubmitTransaction 요청의 메타데이터를 기록하고 delay만큼 지연 후 응답하는 orderer
+type fakeOrdererServer struct {
+	pb_orderer.UnimplementedOrdererServiceServer
+	delay     time.Duration
+	mutex     sync.Mutex
+	deadlines []string
+}
+
+func (s *fakeOrdererServer) SubmitTransaction(ctx context.Context, envelope *pb_common.Envelope) (*pb_orderer.BroadcastResponse, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	s.mutex.Lock()
+	s.deadlines = append(s.deadlines, md.Get(DeadlineMetadataKey)...)
+	s.mutex.Unlock()
+
+	select {
+	case <-time.After(s.delay):
+		return &pb_orderer.BroadcastResponse{Status: pb_common.Status_OK}, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// startFakeOrdererServer 임의 포트에서 server를 시작하고 연결된 OrdererClient 반환
+func startFakeOrdererServer(t *testing.T, server pb_orderer.OrdererServiceServer) *OrdererClient {
+	t.Helper()
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	grpcServer := grpc.NewServer()
+	pb_orderer.RegisterOrdererServiceServer(grpcServer, server)
+	go grpcServer.Serve(lis)
+	t.Cleanup(grpcServer.Stop)
+
+	client, err := NewOrdererClient(lis.Addr().String())
+	if err != nil {
+		t.Fatalf("NewOrdererClient: %v", err)
+	}
+	t.Cleanup(func() { client.Close() })
+	return client
+}
+
+func TestSubmitTransactionWithTimeoutDeadlineExceeded(t *testing.T) {
+	client := startFakeOrdererServer(t, &fakeOrdererServer{delay: time.Second})
+
+	start := time.Now()
+	err := client.SubmitTransactionWithTimeout(context.Background(), &pb_common.Envelope{}, time.Millisecond)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("err = %v, want context.DeadlineExceeded", err)
+	}
+	if elapsed := time.Since(start); elapsed > 500*time.Millisecond {
+		t.Errorf("call took %s, want it to return at the 1ms deadline", elapsed)
+	}
+}
+
+func TestSubmitTransactionWithTimeoutParentContext(t *testing.T) {
+	client := startFakeOrdererServer(t, &fakeOrdererServer{delay: time.Second})
+
+	// 부모 ctx의 취소가 timeout보다 먼저 적용된다
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err := client.SubmitTransactionWithTimeout(ctx, &pb_common.Envelope{}, time.Minute)
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("err = %v, want context.Canceled", err)
+	}
+}
+
+func TestSubmitTransactionSendsDeadlineMetadata(t *testing.T) {
+	server := &fakeOrdererServer{}
+	client := startFakeOrdererServer(t, server)
+
+	before := time.Now()
+	if err := client.SubmitTransaction(&pb_common.Envelope{}); err != nil {
+		t.Fatalf("SubmitTransaction: %v", err)
+	}
+
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+	if len(server.deadlines) != 1 {
+		t.Fatalf("%s metadata = %v, want one value", DeadlineMetadataKey, server.deadlines)
+	}
+	deadline, err := time.Parse(time.RFC3339Nano, server.deadlines[0])
+	if err != nil {
+		t.Fatalf("failed to parse deadline: %v", err)
+	}
+	// timeout 0은 DefaultSubmitTimeout을 사용한다
+	if d := deadline.Sub(before); d < DefaultSubmitTimeout-time.Second || d > DefaultSubmitTimeout+time.Second {
+		t.Errorf("deadline is %s after the call, want about %s", d, DefaultSubmitTimeout)
+	}
+}
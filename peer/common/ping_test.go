@@ -0,0 +1,88 @@
+package common
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	pb_common "github.com/ddr4869/minifab/proto/common"
+	pb_orderer "github.com/ddr4869/minifab/proto/orderer"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// echoOrdererServer health check 서비스 없이 Echo만 구현한 orderer
+type echoOrdererServer struct {
+	pb_orderer.UnimplementedOrdererServiceServer
+}
+
+func (s *echoOrdererServer) Echo(ctx context.Context, req *pb_orderer.EchoRequest) (*pb_orderer.EchoResponse, error) {
+	return &pb_orderer.EchoResponse{Status: pb_common.Status_OK, Payload: req.Payload}, nil
+}
+
+func TestPingFallsBackToEcho(t *testing.T) {
+	client := startFakeOrdererServer(t, &echoOrdererServer{})
+	if err := client.Ping(context.Background()); err != nil {
+		t.Fatalf("Ping: %v", err)
+	}
+
+	// Echo도 구현하지 않은 orderer는 연결 실패로 본다
+	client = startFakeOrdererServer(t, &fakeOrdererServer{})
+	if err := client.Ping(context.Background()); err == nil {
+		t.Fatal("Ping succeeded against an orderer without Echo")
+	}
+}
+
+func TestPingUsesHealthService(t *testing.T) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	grpcServer := grpc.NewServer()
+	healthServer := health.NewServer()
+	healthpb.RegisterHealthServer(grpcServer, healthServer)
+	pb_orderer.RegisterOrdererServiceServer(grpcServer, &fakeOrdererServer{})
+	go grpcServer.Serve(lis)
+	t.Cleanup(grpcServer.Stop)
+
+	client, err := NewOrdererClient(lis.Addr().String())
+	if err != nil {
+		t.Fatalf("NewOrdererClient: %v", err)
+	}
+	t.Cleanup(func() { client.Close() })
+
+	if err := client.Ping(context.Background()); err != nil {
+		t.Fatalf("Ping: %v", err)
+	}
+	healthServer.SetServingStatus("", healthpb.HealthCheckResponse_NOT_SERVING)
+	if err := client.Ping(context.Background()); err == nil {
+		t.Fatal("Ping succeeded against a NOT_SERVING orderer")
+	}
+}
+
+func TestPingStoppedOrderer(t *testing.T) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	address := lis.Addr().String()
+	lis.Close()
+
+	client, err := NewOrdererClient(address)
+	if err != nil {
+		t.Fatalf("NewOrdererClient: %v", err)
+	}
+	t.Cleanup(func() { client.Close() })
+
+	ctx, cancel := context.WithTimeout(context.Background(), DefaultPingTimeout)
+	defer cancel()
+	start := time.Now()
+	if err := client.Ping(ctx); err == nil {
+		t.Fatal("Ping succeeded against a stopped orderer")
+	}
+	if elapsed := time.Since(start); elapsed > 3*time.Second {
+		t.Errorf("Ping returned after %s, want within 3s", elapsed)
+	}
+}
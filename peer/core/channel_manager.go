@@ -0,0 +1,592 @@
+package core
+
+import (
+	"crypto/x509"
+	"net"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ddr4869/minifab/common/blockutil"
+	"github.com/ddr4869/minifab/common/configtx"
+	"github.com/ddr4869/minifab/common/logger"
+	"github.com/ddr4869/minifab/common/msp"
+	"github.com/ddr4869/minifab/peer/storage"
+	pb_common "github.com/ddr4869/minifab/proto/common"
+	"github.com/pkg/errors"
+)
+
+// ChannelStatus peer에서 채널이 등록된 경로와 동기화 상태
+type ChannelStatus string
+
+const (
+	// ChannelStatusCreated peer가 직접 채널을 생성해 등록함
+	ChannelStatusCreated ChannelStatus = "created"
+	// ChannelStatusJoined 설정 블록으로 참여했거나 저장소에서 복원됨
+	ChannelStatusJoined ChannelStatus = "joined"
+	// ChannelStatusSynced orderer의 블록을 끝까지 동기화함
+	ChannelStatusSynced ChannelStatus = "synced"
+)
+
+// Channel peer가 참여한 채널의 메모리 상 정보
+type Channel struct {
+	Name   string
+	Config *configtx.ChannelConfig
+	Status ChannelStatus
+	// 채널이 peer에 등록된 시각 (저장소에서 복원된 채널은 복원 시각)
+	JoinedAt time.Time
+	// peer가 이 채널로 제출에 성공한 트랜잭션 수
+	TransactionCount uint64
+
+	// SubmitTransaction의 round-robin 시작 위치
+	nextOrderer int
+}
+
+// ChannelManagerAPI peer가 사용하는 채널 관리 기능 (*ChannelManager가 구현)
+// NewPeerWithChannelManager로 미리 채널을 등록해 둔 구현을 peer에 주입할 수 있다.
+type ChannelManagerAPI interface {
+	// BlockStorage 채널 정보를 복원하고 블록을 저장하는 원장
+	BlockStorage() *storage.BlockStorage
+	RegisterChannelAddedHook(fn func(channelID string))
+	AddChannel(channelName string, channelConfig *configtx.ChannelConfig)
+	JoinChannelByBlock(channelName string, configBlock *pb_common.Block) error
+	ResetChannel(channelName string, configBlock *pb_common.Block) error
+	RemoveChannel(channelName string) error
+	UnsignedBlockHeight(channelName string) uint64
+	AllowUnsignedBlocks(channelName string, height uint64) error
+	HasChannel(channelName string) bool
+	GetChannelConfig(channelName string) (*configtx.ChannelConfig, error)
+	GetChannelSummary(channelName string) (ChannelSummary, error)
+	GetChannelNames() []string
+	ChannelCount() int
+	IncrementTransactionCount(channelName string) error
+	MarkSynced(channelName string) error
+	ChannelStatuses() map[string]ChannelStatus
+	Snapshot() map[string]ChannelSummary
+	GetOrdererEndpoints(channelID string) ([]string, error)
+	UpdateChannelOrdererEndpoints(channelID string, endpoints []string) error
+	NextOrdererEndpoints(channelID string) ([]string, error)
+	GetChannelMSP(channelID, mspID string) (msp.MSP, error)
+	GetOrdererMSP(channelID string) (msp.MSP, error)
+}
+
+// ChannelManager는 peer가 알고 있는 채널들을 관리한다.
+// 채널 정보는 블록 저장소에 저장된 설정 블록(블록 0)으로부터 복원된다.
+type ChannelManager struct {
+	mutex        sync.RWMutex
+	blockStorage *storage.BlockStorage
+	channels     map[string]*Channel
+	addedHooks   []func(channelID string)
+}
+
+func NewChannelManager(blockStorage *storage.BlockStorage) *ChannelManager {
+	cm := &ChannelManager{
+		blockStorage: blockStorage,
+		channels:     make(map[string]*Channel),
+	}
+
+	channelConfigs, err := blockStorage.LoadChannelConfigs()
+	if err != nil {
+		logger.Errorf("Failed to load existing channel configs: %v", err)
+		return cm
+	}
+	loadedAt := time.Now()
+	for channelName, channelConfig := range channelConfigs {
+		joinedAt := loadedAt
+		metadata, err := blockStorage.LoadChannelMetadata(channelName)
+		if err != nil {
+			logger.Warnf("Failed to load metadata of channel %s: %v", channelName, err)
+			metadata = &storage.ChannelMetadata{}
+		}
+		if !metadata.JoinedAt.IsZero() {
+			joinedAt = metadata.JoinedAt
+		}
+		cm.recordUnsignedLedger(channelName, metadata)
+		// UpdateChannelOrdererEndpoints로 저장된 endpoint가 있으면 설정 블록의 값 대신 사용
+		if len(metadata.OrdererEndpoints) > 0 {
+			if updated, err := withOrdererEndpoints(channelConfig, metadata.OrdererEndpoints); err == nil {
+				channelConfig = updated
+			} else {
+				logger.Warnf("Failed to apply orderer endpoints of channel %s: %v", channelName, err)
+			}
+		}
+		cm.channels[channelName] = &Channel{
+			Name:     channelName,
+			Config:   channelConfig,
+			Status:   ChannelStatusJoined,
+			JoinedAt: joinedAt,
+		}
+	}
+
+	return cm
+}
+
+// BlockStorage 채널 정보를 복원하고 블록을 저장하는 원장
+func (cm *ChannelManager) BlockStorage() *storage.BlockStorage {
+	return cm.blockStorage
+}
+
+// RegisterChannelAddedHook 새 채널이 등록될 때마다 호출될 hook 등록
+// hook은 lock 밖에서 호출되므로 ChannelManager 메서드를 사용해도 된다.
+func (cm *ChannelManager) RegisterChannelAddedHook(fn func(channelID string)) {
+	cm.mutex.Lock()
+	defer cm.mutex.Unlock()
+
+	cm.addedHooks = append(cm.addedHooks, fn)
+}
+
+// AddChannel 채널 설정을 등록 (이미 존재하면 설정을 교체)
+func (cm *ChannelManager) AddChannel(channelName string, channelConfig *configtx.ChannelConfig) {
+	joinedAt := time.Now()
+	// 설정 블록이 이미 저장된 채널이면 재시작 후에도 참여 시각이 유지되도록 기록
+	if cm.blockStorage.GetChannelHeight(channelName) > 0 {
+		if err := cm.storeJoinedAt(channelName, joinedAt); err != nil {
+			logger.Warnf("Failed to persist join time of channel %s: %v", channelName, err)
+		}
+	}
+
+	cm.mutex.Lock()
+	_, exists := cm.channels[channelName]
+	cm.channels[channelName] = &Channel{
+		Name:     channelName,
+		Config:   channelConfig,
+		Status:   ChannelStatusCreated,
+		JoinedAt: joinedAt,
+	}
+	hooks := cm.addedHooks
+	cm.mutex.Unlock()
+
+	if !exists {
+		runChannelAddedHooks(hooks, channelName)
+	}
+}
+
+// JoinChannelByBlock 채널 설정 블록을 블록 0으로 저장하고 채널을 등록
+// 이미 참여한 채널(JoinedAt이 기록됨)이면 아무 것도 하지 않는다.
+func (cm *ChannelManager) JoinChannelByBlock(channelName string, configBlock *pb_common.Block) error {
+	cm.mutex.Lock()
+	channel, exists := cm.channels[channelName]
+	if exists && !channel.JoinedAt.IsZero() {
+		cm.mutex.Unlock()
+		logger.Infof("[Peer] Channel %s already joined at %s", channelName, channel.JoinedAt.Format(time.RFC3339))
+		return nil
+	}
+	err := cm.joinChannelByBlock(channelName, configBlock)
+	hooks := cm.addedHooks
+	cm.mutex.Unlock()
+
+	if err == nil && !exists {
+		runChannelAddedHooks(hooks, channelName)
+	}
+	return err
+}
+
+func runChannelAddedHooks(hooks []func(channelID string), channelID string) {
+	for _, hook := range hooks {
+		hook(channelID)
+	}
+}
+
+// ResetChannel 채널의 로컬 블록 파일과 메모리 상 정보를 모두 삭제한 뒤 설정 블록으로 다시 참여
+// 서명 없는 블록을 다시 받아올 수 있도록 UnsignedBlockHeight는 유지한다.
+func (cm *ChannelManager) ResetChannel(channelName string, configBlock *pb_common.Block) error {
+	cm.mutex.Lock()
+	defer cm.mutex.Unlock()
+
+	unsignedHeight := cm.UnsignedBlockHeight(channelName)
+	if err := cm.removeChannel(channelName); err != nil {
+		return err
+	}
+	if err := cm.joinChannelByBlock(channelName, configBlock); err != nil {
+		return err
+	}
+	if unsignedHeight > 0 {
+		return cm.storeUnsignedBlockHeight(channelName, unsignedHeight)
+	}
+	return nil
+}
+
+// RemoveChannel 채널을 등록 해제하고 로컬 블록 파일을 삭제
+func (cm *ChannelManager) RemoveChannel(channelName string) error {
+	cm.mutex.Lock()
+	defer cm.mutex.Unlock()
+
+	return cm.removeChannel(channelName)
+}
+
+// removeChannel lock을 잡은 상태에서 호출되어야 함
+func (cm *ChannelManager) removeChannel(channelName string) error {
+	if _, exists := cm.channels[channelName]; !exists {
+		return errors.Errorf("channel not found: %s", channelName)
+	}
+
+	if err := cm.blockStorage.RemoveChannel(channelName); err != nil {
+		return err
+	}
+	delete(cm.channels, channelName)
+	logger.Infof("[Peer] Removed local data of channel %s", channelName)
+	return nil
+}
+
+// joinChannelByBlock lock을 잡은 상태에서 호출되어야 함
+func (cm *ChannelManager) joinChannelByBlock(channelName string, configBlock *pb_common.Block) error {
+	channelConfig, err := blockutil.ExtractChannelConfigFromBlock(configBlock)
+	if err != nil {
+		return errors.Wrap(err, "failed to extract channel config")
+	}
+	// 조직 MSP를 구성할 수 없는 설정 블록은 저장하기 전에 거부
+	if channelConfig.CC != nil {
+		for _, org := range channelConfig.CC.Organizations {
+			if _, err := orgMSP(channelName, org); err != nil {
+				return err
+			}
+		}
+	}
+	if cm.blockStorage.GetChannelHeight(channelName) == 0 {
+		if err := cm.blockStorage.StoreBlock(channelName, configBlock); err != nil {
+			return errors.Wrap(err, "failed to save config block")
+		}
+	}
+
+	joinedAt := time.Now()
+	if err := cm.storeJoinedAt(channelName, joinedAt); err != nil {
+		return err
+	}
+	cm.channels[channelName] = &Channel{
+		Name:     channelName,
+		Config:   channelConfig,
+		Status:   ChannelStatusJoined,
+		JoinedAt: joinedAt,
+	}
+	return nil
+}
+
+// storeJoinedAt 채널 메타데이터에 참여 시각 기록 (다른 메타데이터는 유지)
+func (cm *ChannelManager) storeJoinedAt(channelName string, joinedAt time.Time) error {
+	metadata, err := cm.blockStorage.LoadChannelMetadata(channelName)
+	if err != nil {
+		return errors.Wrapf(err, "failed to load metadata of channel %s", channelName)
+	}
+	metadata.JoinedAt = joinedAt
+	if err := cm.blockStorage.StoreChannelMetadata(channelName, metadata); err != nil {
+		return errors.Wrapf(err, "failed to persist join time of channel %s", channelName)
+	}
+	return nil
+}
+
+// UnsignedBlockHeight 채널에서 orderer 서명 없이 받아들이는 블록 번호의 상한 (이 번호 미만만 허용, 기록이 없으면 0)
+func (cm *ChannelManager) UnsignedBlockHeight(channelName string) uint64 {
+	metadata, err := cm.blockStorage.LoadChannelMetadata(channelName)
+	if err != nil {
+		logger.Warnf("Failed to load metadata of channel %s: %v", channelName, err)
+		return 0
+	}
+	return metadata.UnsignedBlockHeight
+}
+
+// AllowUnsignedBlocks 채널에서 height 미만 번호의 서명 없는 블록을 받아들이도록 기록
+// orderer가 블록에 서명하기 전에 만든 원장을 새 peer가 동기화할 때 사용한다.
+func (cm *ChannelManager) AllowUnsignedBlocks(channelName string, height uint64) error {
+	if !cm.HasChannel(channelName) {
+		return errors.Errorf("channel not found: %s", channelName)
+	}
+	return cm.storeUnsignedBlockHeight(channelName, height)
+}
+
+// storeUnsignedBlockHeight 채널 메타데이터에 UnsignedBlockHeight 기록 (다른 메타데이터는 유지)
+func (cm *ChannelManager) storeUnsignedBlockHeight(channelName string, height uint64) error {
+	metadata, err := cm.blockStorage.LoadChannelMetadata(channelName)
+	if err != nil {
+		return errors.Wrapf(err, "failed to load metadata of channel %s", channelName)
+	}
+	metadata.UnsignedBlockHeight = height
+	if err := cm.blockStorage.StoreChannelMetadata(channelName, metadata); err != nil {
+		return errors.Wrapf(err, "failed to persist unsigned block height of channel %s", channelName)
+	}
+	return nil
+}
+
+// recordUnsignedLedger 서명 검증 도입 전에 저장된 원장이면 현재 높이를 UnsignedBlockHeight로 기록
+// 마지막 블록에 orderer 서명이 없으면 서명 없이 저장된 원장으로 보며, 이미 기록된 값은 바꾸지 않는다.
+func (cm *ChannelManager) recordUnsignedLedger(channelName string, metadata *storage.ChannelMetadata) {
+	height := cm.blockStorage.GetChannelHeight(channelName)
+	if metadata.UnsignedBlockHeight > 0 || height <= 1 {
+		return
+	}
+	lastBlock, err := cm.blockStorage.GetLastBlock(channelName)
+	if err != nil || len(lastBlock.GetMetadata().GetSignature()) > 0 {
+		return
+	}
+	if err := cm.storeUnsignedBlockHeight(channelName, height); err != nil {
+		logger.Warnf("Failed to record unsigned ledger of channel %s: %v", channelName, err)
+		return
+	}
+	metadata.UnsignedBlockHeight = height
+	logger.Warnf("[Peer] Channel %s ledger has unsigned blocks, accepting unsigned blocks below %d", channelName, height)
+}
+
+// HasChannel 채널이 등록되어 있는지 여부
+func (cm *ChannelManager) HasChannel(channelName string) bool {
+	cm.mutex.RLock()
+	defer cm.mutex.RUnlock()
+
+	_, exists := cm.channels[channelName]
+	return exists
+}
+
+// GetChannelConfig 채널 설정의 깊은 복사본 반환
+// 내부 Channel 객체를 노출하지 않으므로 반환값을 lock 없이 읽거나 수정해도 안전하다.
+func (cm *ChannelManager) GetChannelConfig(channelName string) (*configtx.ChannelConfig, error) {
+	cm.mutex.RLock()
+	defer cm.mutex.RUnlock()
+
+	channel, exists := cm.channels[channelName]
+	if !exists {
+		return nil, errors.Errorf("channel not found: %s", channelName)
+	}
+	if channel.Config == nil {
+		return nil, errors.Errorf("channel %s has no config", channelName)
+	}
+	return channel.Config.Clone()
+}
+
+// GetChannelSummary 채널 하나의 요약 정보를 복사해 반환
+func (cm *ChannelManager) GetChannelSummary(channelName string) (ChannelSummary, error) {
+	cm.mutex.RLock()
+	defer cm.mutex.RUnlock()
+
+	channel, exists := cm.channels[channelName]
+	if !exists {
+		return ChannelSummary{}, errors.Errorf("channel not found: %s", channelName)
+	}
+	return cm.summarize(channel), nil
+}
+
+// GetChannelNames 등록된 채널 이름을 오름차순으로 정렬해 반환
+func (cm *ChannelManager) GetChannelNames() []string {
+	cm.mutex.RLock()
+	defer cm.mutex.RUnlock()
+
+	channels := make([]string, 0, len(cm.channels))
+	for channelName := range cm.channels {
+		channels = append(channels, channelName)
+	}
+	sort.Strings(channels)
+	return channels
+}
+
+// ChannelCount 등록된 채널 수
+func (cm *ChannelManager) ChannelCount() int {
+	cm.mutex.RLock()
+	defer cm.mutex.RUnlock()
+
+	return len(cm.channels)
+}
+
+// IncrementTransactionCount 채널의 트랜잭션 제출 횟수 증가
+func (cm *ChannelManager) IncrementTransactionCount(channelName string) error {
+	cm.mutex.Lock()
+	defer cm.mutex.Unlock()
+
+	channel, exists := cm.channels[channelName]
+	if !exists {
+		return errors.Errorf("channel not found: %s", channelName)
+	}
+	channel.TransactionCount++
+	return nil
+}
+
+// MarkSynced 채널이 orderer와 동기화를 마쳤음을 기록
+func (cm *ChannelManager) MarkSynced(channelName string) error {
+	cm.mutex.Lock()
+	defer cm.mutex.Unlock()
+
+	channel, exists := cm.channels[channelName]
+	if !exists {
+		return errors.Errorf("channel not found: %s", channelName)
+	}
+	channel.Status = ChannelStatusSynced
+	return nil
+}
+
+// ChannelStatuses 등록된 채널별 상태를 복사해 반환
+func (cm *ChannelManager) ChannelStatuses() map[string]ChannelStatus {
+	cm.mutex.RLock()
+	defer cm.mutex.RUnlock()
+
+	statuses := make(map[string]ChannelStatus, len(cm.channels))
+	for channelName, channel := range cm.channels {
+		statuses[channelName] = channel.Status
+	}
+	return statuses
+}
+
+// Snapshot 모니터링용 채널 요약 정보를 채널 이름별로 복사해 반환
+// 반환된 값은 채널 객체를 참조하지 않으므로 lock 없이 사용해도 안전하다.
+func (cm *ChannelManager) Snapshot() map[string]ChannelSummary {
+	cm.mutex.RLock()
+	defer cm.mutex.RUnlock()
+
+	snapshot := make(map[string]ChannelSummary, len(cm.channels))
+	for channelName, channel := range cm.channels {
+		snapshot[channelName] = cm.summarize(channel)
+	}
+	return snapshot
+}
+
+// summarize 채널 요약 정보 생성 (호출자가 cm.mutex를 잡고 있어야 함)
+func (cm *ChannelManager) summarize(channel *Channel) ChannelSummary {
+	return ChannelSummary{
+		Name:             channel.Name,
+		TransactionCount: channel.TransactionCount,
+		BlockHeight:      cm.blockStorage.GetChannelHeight(channel.Name),
+		JoinedAt:         channel.JoinedAt,
+	}
+}
+
+// GetOrdererEndpoints 채널 설정 블록에 기록된 orderer endpoint 목록 반환
+func (cm *ChannelManager) GetOrdererEndpoints(channelID string) ([]string, error) {
+	cm.mutex.RLock()
+	defer cm.mutex.RUnlock()
+
+	channel, exists := cm.channels[channelID]
+	if !exists {
+		return nil, errors.Errorf("channel not found: %s", channelID)
+	}
+	if channel.Config == nil || channel.Config.SCC == nil {
+		return nil, errors.Errorf("channel %s has no orderer config", channelID)
+	}
+
+	endpoints := channel.Config.SCC.Orderer.Organization.OrdererEndpoints
+	if len(endpoints) == 0 {
+		return nil, errors.Errorf("no orderer endpoints defined for channel %s", channelID)
+	}
+	return append([]string(nil), endpoints...), nil
+}
+
+// UpdateChannelOrdererEndpoints 채널의 orderer endpoint 목록을 교체하고 저장소에 기록
+// 저장된 목록은 peer 재시작 후에도 설정 블록의 endpoint 대신 사용되며, round-robin은 새 목록의 첫 endpoint부터 다시 시작한다.
+func (cm *ChannelManager) UpdateChannelOrdererEndpoints(channelID string, endpoints []string) error {
+	if len(endpoints) == 0 {
+		return errors.New("orderer endpoints cannot be empty")
+	}
+	for _, endpoint := range endpoints {
+		if _, _, err := net.SplitHostPort(endpoint); err != nil {
+			return errors.Wrapf(err, "invalid orderer endpoint %q", endpoint)
+		}
+	}
+
+	cm.mutex.Lock()
+	defer cm.mutex.Unlock()
+
+	channel, exists := cm.channels[channelID]
+	if !exists {
+		return errors.Errorf("channel not found: %s", channelID)
+	}
+	updated, err := withOrdererEndpoints(channel.Config, endpoints)
+	if err != nil {
+		return errors.Wrapf(err, "channel %s", channelID)
+	}
+	metadata, err := cm.blockStorage.LoadChannelMetadata(channelID)
+	if err != nil {
+		return errors.Wrapf(err, "failed to load metadata of channel %s", channelID)
+	}
+	metadata.OrdererEndpoints = endpoints
+	if err := cm.blockStorage.StoreChannelMetadata(channelID, metadata); err != nil {
+		return errors.Wrapf(err, "failed to persist orderer endpoints of channel %s", channelID)
+	}
+
+	channel.Config = updated
+	channel.nextOrderer = 0
+	logger.Infof("[Peer] Updated orderer endpoints of channel %s: %v", channelID, endpoints)
+	return nil
+}
+
+// withOrdererEndpoints orderer endpoint만 교체한 채널 설정 복사본 반환 (기존 설정은 변경하지 않음)
+func withOrdererEndpoints(channelConfig *configtx.ChannelConfig, endpoints []string) (*configtx.ChannelConfig, error) {
+	if channelConfig == nil || channelConfig.SCC == nil {
+		return nil, errors.New("channel has no orderer config")
+	}
+	scc := *channelConfig.SCC
+	scc.Orderer.Organization.OrdererEndpoints = append([]string(nil), endpoints...)
+	updated := *channelConfig
+	updated.SCC = &scc
+	return &updated, nil
+}
+
+// NextOrdererEndpoints round-robin 순서로 회전된 orderer endpoint 목록 반환
+// 호출할 때마다 시작 endpoint가 다음 것으로 이동한다.
+func (cm *ChannelManager) NextOrdererEndpoints(channelID string) ([]string, error) {
+	endpoints, err := cm.GetOrdererEndpoints(channelID)
+	if err != nil {
+		return nil, err
+	}
+
+	cm.mutex.Lock()
+	defer cm.mutex.Unlock()
+
+	channel, exists := cm.channels[channelID]
+	if !exists {
+		return nil, errors.Errorf("channel not found: %s", channelID)
+	}
+	start := channel.nextOrderer % len(endpoints)
+	channel.nextOrderer = start + 1
+
+	rotated := make([]string, 0, len(endpoints))
+	rotated = append(rotated, endpoints[start:]...)
+	return append(rotated, endpoints[:start]...), nil
+}
+
+// GetChannelMSP 채널 설정의 application 조직 중 mspID에 해당하는 조직의 MSP 구성 (서명 identity 없음)
+func (cm *ChannelManager) GetChannelMSP(channelID, mspID string) (msp.MSP, error) {
+	cm.mutex.RLock()
+	defer cm.mutex.RUnlock()
+
+	channel, exists := cm.channels[channelID]
+	if !exists {
+		return nil, errors.Errorf("channel not found: %s", channelID)
+	}
+	if channel.Config == nil || channel.Config.CC == nil {
+		return nil, errors.Errorf("channel %s has no application config", channelID)
+	}
+
+	for _, org := range channel.Config.CC.Organizations {
+		if org.ID == mspID {
+			return orgMSP(channelID, org)
+		}
+	}
+	return nil, errors.Errorf("MSP %s is not a member of channel %s", mspID, channelID)
+}
+
+// orgMSP 채널 설정의 조직 정보로 MSP 구성 (서명 identity 없음)
+func orgMSP(channelID string, org configtx.Organization) (msp.MSP, error) {
+	rootCert, err := x509.ParseCertificate(org.MSPCaCert)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to parse CA certificate of MSP %s in channel %s", org.ID, channelID)
+	}
+	return &msp.FabricMSP{MSPID: org.ID, RootCerts: rootCert}, nil
+}
+
+// GetOrdererMSP 채널 설정에 기록된 orderer 조직의 MSP 구성 (블록 서명 검증용, 서명 identity 없음)
+func (cm *ChannelManager) GetOrdererMSP(channelID string) (msp.MSP, error) {
+	cm.mutex.RLock()
+	defer cm.mutex.RUnlock()
+
+	channel, exists := cm.channels[channelID]
+	if !exists {
+		return nil, errors.Errorf("channel not found: %s", channelID)
+	}
+	if channel.Config == nil || channel.Config.SCC == nil {
+		return nil, errors.Errorf("channel %s has no orderer config", channelID)
+	}
+
+	org := channel.Config.SCC.Orderer.Organization
+	if len(org.MSPCaCert) == 0 {
+		return nil, errors.Errorf("channel %s has no CA certificate for orderer MSP %s", channelID, org.ID)
+	}
+	rootCert, err := x509.ParseCertificate(org.MSPCaCert)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to parse CA certificate of orderer MSP %s in channel %s", org.ID, channelID)
+	}
+	return &msp.FabricMSP{MSPID: org.ID, RootCerts: rootCert}, nil
+}
@@ -0,0 +1,314 @@
+package core
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ddr4869/minifab/common/configtx"
+	"github.com/ddr4869/minifab/peer/storage"
+)
+
+func TestSnapshotWithConcurrentTransactionCounts(t *testing.T) {
+	cm := NewChannelManager(storage.NewBlockStorageWithPath(t.TempDir()))
+	const channelCount = 5
+	channelName := func(i int) string { return fmt.Sprintf("channel%d", i) }
+	for i := 0; i < channelCount; i++ {
+		cm.AddChannel(channelName(i), &configtx.ChannelConfig{})
+	}
+
+	// channel<i>는 트랜잭션 (i+1)*20개를 여러 goroutine에서 나눠 기록
+	var writers sync.WaitGroup
+	for i := 0; i < channelCount; i++ {
+		for w := 0; w < 4; w++ {
+			writers.Add(1)
+			go func(name string, count int) {
+				defer writers.Done()
+				for j := 0; j < count; j++ {
+					if err := cm.IncrementTransactionCount(name); err != nil {
+						t.Error(err)
+						return
+					}
+				}
+			}(channelName(i), (i+1)*5)
+		}
+	}
+
+	// 기록 도중의 snapshot도 채널 수가 일정하고 트랜잭션 수가 줄어들지 않아야 한다
+	done := make(chan struct{})
+	var reader sync.WaitGroup
+	reader.Add(1)
+	go func() {
+		defer reader.Done()
+		previous := make(map[string]uint64)
+		for {
+			select {
+			case <-done:
+				return
+			default:
+			}
+			if got := cm.ChannelCount(); got != channelCount {
+				t.Errorf("ChannelCount() = %d, want %d", got, channelCount)
+				return
+			}
+			snapshot := cm.Snapshot()
+			if len(snapshot) != channelCount {
+				t.Errorf("snapshot has %d channels, want %d", len(snapshot), channelCount)
+				return
+			}
+			for name, summary := range snapshot {
+				if summary.TransactionCount < previous[name] {
+					t.Errorf("%s transaction count went from %d to %d", name, previous[name], summary.TransactionCount)
+					return
+				}
+				previous[name] = summary.TransactionCount
+			}
+		}
+	}()
+
+	writers.Wait()
+	close(done)
+	reader.Wait()
+
+	snapshot := cm.Snapshot()
+	for i := 0; i < channelCount; i++ {
+		summary, ok := snapshot[channelName(i)]
+		if !ok {
+			t.Fatalf("snapshot is missing %s", channelName(i))
+		}
+		if want := uint64((i + 1) * 20); summary.TransactionCount != want {
+			t.Errorf("%s transaction count = %d, want %d", summary.Name, summary.TransactionCount, want)
+		}
+	}
+
+	// snapshot은 복사본이므로 이후 기록에 영향을 받지 않는다
+	if err := cm.IncrementTransactionCount(channelName(0)); err != nil {
+		t.Fatal(err)
+	}
+	if snapshot[channelName(0)].TransactionCount != 20 {
+		t.Error("snapshot changed after a later increment")
+	}
+}
+
+func TestChannelSummaryMarshalJSON(t *testing.T) {
+	summary := ChannelSummary{
+		Name:             "mychannel",
+		TransactionCount: 3,
+		BlockHeight:      2,
+		JoinedAt:         time.Date(2024, 5, 1, 9, 30, 0, 0, time.FixedZone("KST", 9*60*60)),
+	}
+	data, err := json.Marshal(summary)
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+	want := `{"name":"mychannel","transaction_count":3,"block_height":2,"joined_at":"2024-05-01T00:30:00Z"}`
+	if string(data) != want {
+		t.Errorf("json = %s, want %s", data, want)
+	}
+}
+
+func TestGetChannelNamesSorted(t *testing.T) {
+	tests := [][]string{
+		nil,
+		{"mychannel"},
+		{"c", "b", "a"},
+		{"channel10", "channel2", "channel1"},
+		{"Zeta", "alpha", "Beta", "alpha-2", "alpha.1", "alpha_3"},
+	}
+	for _, names := range tests {
+		t.Run(fmt.Sprint(names), func(t *testing.T) {
+			cm := NewChannelManager(storage.NewBlockStorageWithPath(t.TempDir()))
+			for _, name := range names {
+				cm.AddChannel(name, &configtx.ChannelConfig{})
+			}
+
+			got := cm.GetChannelNames()
+			if len(got) != len(names) {
+				t.Fatalf("GetChannelNames() = %v, want %d names", got, len(names))
+			}
+			if !sort.StringsAreSorted(got) {
+				t.Errorf("GetChannelNames() = %v, want ascending order", got)
+			}
+			want := append([]string(nil), names...)
+			sort.Strings(want)
+			for i := range want {
+				if got[i] != want[i] {
+					t.Fatalf("GetChannelNames() = %v, want %v", got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestRemoveChannel(t *testing.T) {
+	storagePath := t.TempDir()
+	cm := NewChannelManager(storage.NewBlockStorageWithPath(storagePath))
+	channelIDs := []string{"channel1", "channel2", "channel3"}
+	for _, channelID := range channelIDs {
+		if err := cm.JoinChannelByBlock(channelID, newTestChannel(t, channelID).genesis); err != nil {
+			t.Fatalf("JoinChannelByBlock(%s): %v", channelID, err)
+		}
+	}
+
+	if err := cm.RemoveChannel("channel2"); err != nil {
+		t.Fatalf("RemoveChannel: %v", err)
+	}
+
+	if got := cm.GetChannelNames(); !reflect.DeepEqual(got, []string{"channel1", "channel3"}) {
+		t.Errorf("GetChannelNames() = %v, want [channel1 channel3]", got)
+	}
+	if _, err := os.Stat(filepath.Join(storagePath, "channel2")); !os.IsNotExist(err) {
+		t.Errorf("channel2 data still exists on disk: %v", err)
+	}
+	for _, channelID := range []string{"channel1", "channel3"} {
+		if !cm.HasChannel(channelID) {
+			t.Errorf("%s was removed with channel2", channelID)
+		}
+		if _, err := os.Stat(filepath.Join(storagePath, channelID)); err != nil {
+			t.Errorf("%s data was removed with channel2: %v", channelID, err)
+		}
+	}
+
+	if err := cm.RemoveChannel("channel2"); err == nil {
+		t.Error("removing an unknown channel succeeded, want error")
+	}
+}
+
+func TestUpdateChannelOrdererEndpoints(t *testing.T) {
+	c := newTestChannel(t, "mychannel")
+	cm := c.peer.ChannelManager
+	endpoints := []string{"orderer0:7050", "orderer1:7050"}
+
+	if err := cm.UpdateChannelOrdererEndpoints(c.id, endpoints); err != nil {
+		t.Fatalf("UpdateChannelOrdererEndpoints: %v", err)
+	}
+	channelConfig, err := cm.GetChannelConfig(c.id)
+	if err != nil {
+		t.Fatalf("GetChannelConfig: %v", err)
+	}
+	if got := channelConfig.SCC.Orderer.Organization.OrdererEndpoints; !reflect.DeepEqual(got, endpoints) {
+		t.Errorf("channel config orderer endpoints = %v, want %v", got, endpoints)
+	}
+
+	// round-robin은 새 목록의 첫 endpoint부터 다시 시작한다
+	for _, want := range [][]string{endpoints, {"orderer1:7050", "orderer0:7050"}} {
+		got, err := cm.NextOrdererEndpoints(c.id)
+		if err != nil {
+			t.Fatalf("NextOrdererEndpoints: %v", err)
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("NextOrdererEndpoints() = %v, want %v", got, want)
+		}
+	}
+
+	// 재시작한 peer도 저장된 endpoint를 사용한다
+	restarted := NewChannelManager(c.peer.BlockStorage)
+	if got, err := restarted.GetOrdererEndpoints(c.id); err != nil || !reflect.DeepEqual(got, endpoints) {
+		t.Errorf("GetOrdererEndpoints after restart = %v, %v, want %v", got, err, endpoints)
+	}
+}
+
+func TestUpdateChannelOrdererEndpointsRejectsInvalidInput(t *testing.T) {
+	c := newTestChannel(t, "mychannel")
+	cm := c.peer.ChannelManager
+
+	tests := []struct {
+		name      string
+		channelID string
+		endpoints []string
+	}{
+		{"empty endpoints", c.id, nil},
+		{"endpoint without port", c.id, []string{"orderer0:7050", "orderer1"}},
+		{"unknown channel", "otherchannel", []string{"orderer0:7050"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := cm.UpdateChannelOrdererEndpoints(tt.channelID, tt.endpoints); err == nil {
+				t.Fatal("UpdateChannelOrdererEndpoints succeeded, want error")
+			}
+		})
+	}
+
+	channelConfig, err := cm.GetChannelConfig(c.id)
+	if err != nil {
+		t.Fatalf("GetChannelConfig: %v", err)
+	}
+	if got := channelConfig.SCC.Orderer.Organization.OrdererEndpoints; len(got) != 0 {
+		t.Errorf("orderer endpoints after rejected updates = %v, want none", got)
+	}
+}
+
+func TestGetChannelConfigConcurrentAccess(t *testing.T) {
+	c := newTestChannel(t, "mychannel")
+	cm := c.peer.ChannelManager
+	original, err := cm.GetChannelConfig(c.id)
+	if err != nil {
+		t.Fatalf("GetChannelConfig: %v", err)
+	}
+
+	const goroutines = 8
+	var wg sync.WaitGroup
+	errs := make(chan error, 2*goroutines*20)
+	for i := 0; i < goroutines; i++ {
+		wg.Add(2)
+		// 복사본을 수정하는 reader
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 20; j++ {
+				channelConfig, err := cm.GetChannelConfig(c.id)
+				if err != nil {
+					errs <- err
+					return
+				}
+				org := &channelConfig.SCC.Orderer.Organization
+				org.ID = "ChangedMSP"
+				org.MSPCaCert[0] ^= 0xff
+				org.OrdererEndpoints = append(org.OrdererEndpoints, "copy:7050")
+				channelConfig.CC.Organizations = append(channelConfig.CC.Organizations, configtx.Organization{ID: "ExtraMSP"})
+			}
+		}()
+		// 내부 상태를 바꾸는 writer
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 20; j++ {
+				if err := cm.UpdateChannelOrdererEndpoints(c.id, []string{fmt.Sprintf("orderer%d:7050", i)}); err != nil {
+					errs <- err
+					return
+				}
+				if err := cm.IncrementTransactionCount(c.id); err != nil {
+					errs <- err
+					return
+				}
+			}
+		}(i)
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		t.Fatal(err)
+	}
+
+	current, err := cm.GetChannelConfig(c.id)
+	if err != nil {
+		t.Fatalf("GetChannelConfig: %v", err)
+	}
+	if !reflect.DeepEqual(current.CC, original.CC) {
+		t.Errorf("stored application config changed through a copy: %+v, want %+v", current.CC, original.CC)
+	}
+	org := current.SCC.Orderer.Organization
+	if org.ID != "OrdererMSP" || !reflect.DeepEqual(org.MSPCaCert, original.SCC.Orderer.Organization.MSPCaCert) {
+		t.Errorf("stored orderer organization changed through a copy: %s", org.ID)
+	}
+	if len(org.OrdererEndpoints) != 1 {
+		t.Errorf("stored orderer endpoints = %v, want the single endpoint of the last update", org.OrdererEndpoints)
+	}
+	if summary, err := cm.GetChannelSummary(c.id); err != nil || summary.TransactionCount != goroutines*20 {
+		t.Errorf("GetChannelSummary = %+v, %v, want %d transactions", summary, err, goroutines*20)
+	}
+}
@@ -0,0 +1,29 @@
+package core
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// ChannelSummary 모니터링용 채널 요약 정보 (MSP 등 내부 객체 참조 없음)
+type ChannelSummary struct {
+	Name             string
+	TransactionCount uint64
+	BlockHeight      uint64
+	JoinedAt         time.Time
+}
+
+// MarshalJSON metric endpoint에서 사용하는 JSON 형식으로 변환
+func (s ChannelSummary) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Name             string `json:"name"`
+		TransactionCount uint64 `json:"transaction_count"`
+		BlockHeight      uint64 `json:"block_height"`
+		JoinedAt         string `json:"joined_at"`
+	}{
+		Name:             s.Name,
+		TransactionCount: s.TransactionCount,
+		BlockHeight:      s.BlockHeight,
+		JoinedAt:         s.JoinedAt.UTC().Format(time.RFC3339),
+	})
+}
@@ -0,0 +1,126 @@
+package core
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"sync"
+	"testing"
+
+	"github.com/ddr4869/minifab/common/blockutil"
+	"github.com/ddr4869/minifab/common/configtx"
+	"github.com/ddr4869/minifab/common/msp/msptest"
+	"github.com/ddr4869/minifab/peer/common"
+	"github.com/ddr4869/minifab/peer/storage"
+	pb_common "github.com/ddr4869/minifab/proto/common"
+	pb_orderer "github.com/ddr4869/minifab/proto/orderer"
+	"google.golang.org/grpc"
+)
+
+// testChannel peer 저장소 하나에 참여한 채널과 그 채널의 orderer 조직
+type testChannel struct {
+	peer       *Peer
+	ordererOrg *msptest.Org
+	id         string
+	genesis    *pb_common.Block
+}
+
+// newTestChannel 임시 저장소를 가진 peer를 만들고 orderer가 서명한 설정 블록으로 채널에 참여
+func newTestChannel(t *testing.T, channelID string) *testChannel {
+	t.Helper()
+
+	ordererOrg := msptest.NewOrg(t, "OrdererMSP")
+	channelConfig := &configtx.ChannelConfig{
+		CC: &configtx.AppChannelConfig{},
+		SCC: &configtx.SystemChannelInfo{
+			Orderer: configtx.SystemChannelConfig{
+				Organization: configtx.Organization{ID: "OrdererMSP", MSPCaCert: ordererOrg.CACert.Raw},
+			},
+		},
+	}
+	configBytes, err := json.Marshal(channelConfig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	genesis, err := blockutil.GenerateConfigBlock(configBytes, channelID, ordererOrg.SigningIdentity())
+	if err != nil {
+		t.Fatalf("GenerateConfigBlock: %v", err)
+	}
+	if err := blockutil.SignBlock(genesis, ordererOrg.SigningIdentity()); err != nil {
+		t.Fatalf("SignBlock: %v", err)
+	}
+
+	blockStorage := storage.NewBlockStorageWithPath(t.TempDir())
+	peer := &Peer{BlockStorage: blockStorage, ChannelManager: NewChannelManager(blockStorage)}
+	if err := peer.ChannelManager.JoinChannelByBlock(channelID, genesis); err != nil {
+		t.Fatalf("JoinChannelByBlock: %v", err)
+	}
+	return &testChannel{peer: peer, ordererOrg: ordererOrg, id: channelID, genesis: genesis}
+}
+
+// nextBlock previous 다음 번호의 데이터 블록 (sign이면 orderer 서명을 붙임)
+func (c *testChannel) nextBlock(t *testing.T, previous *pb_common.Block, sign bool) *pb_common.Block {
+	t.Helper()
+
+	number := previous.Header.Number + 1
+	tx := &pb_common.Transaction{Payload: []byte(fmt.Sprintf("tx-%d", number))}
+	block, err := blockutil.GenerateDataBlock([]*pb_common.Transaction{tx}, c.id, c.ordererOrg.SigningIdentity())
+	if err != nil {
+		t.Fatalf("GenerateDataBlock: %v", err)
+	}
+	blockutil.SetBlockPosition(block, number, blockutil.CalculateBlockHash(previous))
+	if sign {
+		if err := blockutil.SignBlock(block, c.ordererOrg.SigningIdentity()); err != nil {
+			t.Fatalf("SignBlock: %v", err)
+		}
+	}
+	return block
+}
+
+// fakeOrderer 채널별 블록 목록을 GetBlock으로 제공하는 gRPC orderer
+type fakeOrderer struct {
+	pb_orderer.UnimplementedOrdererServiceServer
+	mutex  sync.Mutex
+	blocks map[string][]*pb_common.Block
+}
+
+// startFakeOrderer 임의 포트에서 fakeOrderer를 시작하고 연결된 OrdererClient 반환
+func startFakeOrderer(t *testing.T) (*fakeOrderer, *common.OrdererClient) {
+	t.Helper()
+
+	orderer := &fakeOrderer{blocks: make(map[string][]*pb_common.Block)}
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	server := grpc.NewServer()
+	pb_orderer.RegisterOrdererServiceServer(server, orderer)
+	go server.Serve(lis)
+	t.Cleanup(server.Stop)
+
+	client, err := common.NewOrdererClient(lis.Addr().String())
+	if err != nil {
+		t.Fatalf("NewOrdererClient: %v", err)
+	}
+	t.Cleanup(func() { client.Close() })
+	return orderer, client
+}
+
+// append 채널 끝에 블록 추가
+func (o *fakeOrderer) append(channelID string, blocks ...*pb_common.Block) {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+	o.blocks[channelID] = append(o.blocks[channelID], blocks...)
+}
+
+func (o *fakeOrderer) GetBlock(ctx context.Context, req *pb_orderer.BlockRequest) (*pb_orderer.BlockResponse, error) {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	blocks := o.blocks[req.ChannelId]
+	if req.BlockNumber >= uint64(len(blocks)) {
+		return &pb_orderer.BlockResponse{Status: pb_common.Status_NOT_FOUND}, nil
+	}
+	return &pb_orderer.BlockResponse{Status: pb_common.Status_OK, Block: blocks[req.BlockNumber]}, nil
+}
@@ -0,0 +1,111 @@
+package core
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/ddr4869/minifab/common/logger"
+	"github.com/pkg/errors"
+)
+
+// ExportedIdentity MSP 관리자에게 전달하기 위한 peer signing identity 정보
+type ExportedIdentity struct {
+	MSPID          string `json:"msp_id"`
+	CertificatePEM string `json:"certificate_pem"`
+	PublicKeyPEM   string `json:"public_key_pem"`
+}
+
+// ExportIdentity peer signing identity의 MSP ID, 인증서, 공개키를 JSON 파일로 저장
+func (p *Peer) ExportIdentity(outputPath string) error {
+	if p.Peer == nil || p.Peer.MSP == nil {
+		return errors.New("peer MSP is not loaded")
+	}
+	signer := p.Peer.MSP.GetSigningIdentity()
+	if signer == nil {
+		return errors.New("peer MSP has no signing identity")
+	}
+
+	certPEM, err := signer.GetSigningCertPEM()
+	if err != nil {
+		return errors.Wrap(err, "failed to encode signing certificate")
+	}
+	publicKeyPEM, err := signer.GetPublicKeyPEM()
+	if err != nil {
+		return errors.Wrap(err, "failed to encode public key")
+	}
+
+	data, err := json.MarshalIndent(ExportedIdentity{
+		MSPID:          signer.GetIdentifier().Mspid,
+		CertificatePEM: string(certPEM),
+		PublicKeyPEM:   string(publicKeyPEM),
+	}, "", "  ")
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal identity")
+	}
+	if err := os.WriteFile(outputPath, data, 0644); err != nil {
+		return errors.Wrapf(err, "failed to write identity file: %s", outputPath)
+	}
+	logger.Infof("✅ Exported identity of %s to %s", signer.GetIdentifier().Mspid, outputPath)
+	return nil
+}
+
+// IdentityInfo peer signing identity의 상태 요약
+type IdentityInfo struct {
+	MSPID      string    `json:"msp_id"`
+	CertCN     string    `json:"cert_cn"`
+	CertExpiry time.Time `json:"cert_expiry"`
+	OUs        []string  `json:"ous"`
+	KeyType    string    `json:"key_type"`
+	// 서명 인증서가 있고 만료되지 않았는지 여부
+	IsValid bool `json:"is_valid"`
+}
+
+// TimeUntilExpiry 인증서 만료까지 남은 시간 (이미 만료되었으면 음수)
+func (info IdentityInfo) TimeUntilExpiry() time.Duration {
+	return time.Until(info.CertExpiry)
+}
+
+// GetIdentityInfo peer MSP의 signing identity로부터 IdentityInfo 구성
+// MSP나 signing identity가 없으면 IsValid가 false인 값을 반환한다.
+func (p *Peer) GetIdentityInfo() IdentityInfo {
+	info := IdentityInfo{OUs: []string{}}
+	if p.Peer == nil || p.Peer.MSP == nil {
+		return info
+	}
+	info.OUs = p.Peer.MSP.GetOrganizationalUnits()
+	signer := p.Peer.MSP.GetSigningIdentity()
+	if signer == nil {
+		return info
+	}
+
+	info.MSPID = signer.GetIdentifier().Mspid
+	cert := signer.GetCertificate()
+	if cert == nil {
+		return info
+	}
+	info.CertCN = cert.Subject.CommonName
+	info.CertExpiry = cert.NotAfter
+	info.KeyType = publicKeyType(cert)
+	info.IsValid = signer.Validate() == nil
+	return info
+}
+
+// publicKeyType 인증서 공개키 종류 (ECDSA는 곡선, RSA는 비트 수 포함)
+func publicKeyType(cert *x509.Certificate) string {
+	switch key := cert.PublicKey.(type) {
+	case *ecdsa.PublicKey:
+		return fmt.Sprintf("ECDSA %s", key.Curve.Params().Name)
+	case *rsa.PublicKey:
+		return fmt.Sprintf("RSA %d", key.N.BitLen())
+	case ed25519.PublicKey:
+		return "Ed25519"
+	default:
+		return cert.PublicKeyAlgorithm.String()
+	}
+}
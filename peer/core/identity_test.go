@@ -0,0 +1,117 @@
+package core
+
+import (
+	"bytes"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/ddr4869/minifab/common/msp"
+	"github.com/ddr4869/minifab/common/msp/msptest"
+	"github.com/ddr4869/minifab/config"
+)
+
+func TestExportIdentity(t *testing.T) {
+	org := msptest.NewOrg(t, "Org1MSP")
+	peer := &Peer{Peer: &config.PeerCfg{ID: "peer0", MSPID: org.MSPID, MSP: org.MSP}}
+
+	outputPath := filepath.Join(t.TempDir(), "identity.json")
+	if err := peer.ExportIdentity(outputPath); err != nil {
+		t.Fatalf("ExportIdentity: %v", err)
+	}
+
+	data, err := os.ReadFile(outputPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var exported ExportedIdentity
+	if err := json.Unmarshal(data, &exported); err != nil {
+		t.Fatalf("exported identity is not valid JSON: %v", err)
+	}
+	if exported.MSPID != "Org1MSP" {
+		t.Errorf("msp_id = %q, want Org1MSP", exported.MSPID)
+	}
+
+	block, _ := pem.Decode([]byte(exported.CertificatePEM))
+	if block == nil {
+		t.Fatal("certificate_pem is not PEM encoded")
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		t.Fatalf("failed to parse exported certificate: %v", err)
+	}
+	if !cert.Equal(org.SignCert) {
+		t.Errorf("exported certificate %q is not the peer signing certificate", cert.Subject.CommonName)
+	}
+
+	block, _ = pem.Decode([]byte(exported.PublicKeyPEM))
+	if block == nil {
+		t.Fatal("public_key_pem is not PEM encoded")
+	}
+	wantKey, err := x509.MarshalPKIXPublicKey(org.SignCert.PublicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(block.Bytes, wantKey) {
+		t.Error("exported public key does not match the signing certificate")
+	}
+}
+
+func TestExportIdentityWithoutMSP(t *testing.T) {
+	peer := &Peer{Peer: &config.PeerCfg{ID: "peer0"}}
+	outputPath := filepath.Join(t.TempDir(), "identity.json")
+	if err := peer.ExportIdentity(outputPath); err == nil {
+		t.Fatal("ExportIdentity without MSP succeeded, want error")
+	}
+	if _, err := os.Stat(outputPath); !os.IsNotExist(err) {
+		t.Errorf("identity file was written without MSP: %v", err)
+	}
+}
+
+func TestGetIdentityInfo(t *testing.T) {
+	org := msptest.NewOrg(t, "Org1MSP")
+	cert, key := org.Issue(t, "peer0.org1", "peer")
+	signer, err := msp.NewSigner(msp.NewIdentity(cert, cert.PublicKey, org.MSPID), key)
+	if err != nil {
+		t.Fatalf("NewSigner: %v", err)
+	}
+	var signingIdentity msp.SigningIdentity = signer
+	peerMSP := msp.NewFabricMSP()
+	if err := peerMSP.Setup(&msp.MSPConfig{MSPID: org.MSPID, SigningIdentity: &signingIdentity, RootCerts: org.CACert}); err != nil {
+		t.Fatalf("Setup: %v", err)
+	}
+	peer := &Peer{Peer: &config.PeerCfg{ID: "peer0", MSPID: org.MSPID, MSP: peerMSP}}
+
+	info := peer.GetIdentityInfo()
+	if info.MSPID != "Org1MSP" {
+		t.Errorf("MSPID = %q, want Org1MSP", info.MSPID)
+	}
+	if info.CertCN != "peer0.org1" {
+		t.Errorf("CertCN = %q, want peer0.org1", info.CertCN)
+	}
+	if !info.CertExpiry.Equal(cert.NotAfter) {
+		t.Errorf("CertExpiry = %v, want %v", info.CertExpiry, cert.NotAfter)
+	}
+	if !reflect.DeepEqual(info.OUs, []string{"peer"}) {
+		t.Errorf("OUs = %v, want [peer]", info.OUs)
+	}
+	if info.KeyType != "ECDSA P-256" {
+		t.Errorf("KeyType = %q, want ECDSA P-256", info.KeyType)
+	}
+	if !info.IsValid {
+		t.Error("IsValid = false for a certificate issued by the MSP root")
+	}
+	if remaining := info.TimeUntilExpiry(); remaining <= 0 || remaining > time.Until(cert.NotAfter)+time.Second {
+		t.Errorf("TimeUntilExpiry() = %s, want about %s", remaining, time.Until(cert.NotAfter))
+	}
+
+	empty := (&Peer{}).GetIdentityInfo()
+	if empty.IsValid || empty.MSPID != "" || empty.OUs == nil {
+		t.Errorf("identity info without MSP = %+v, want invalid with empty OUs", empty)
+	}
+}
@@ -1,19 +1,35 @@
 package core
 
 import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"time"
+
 	"github.com/ddr4869/minifab/common/logger"
 	"github.com/ddr4869/minifab/common/msp"
 	"github.com/ddr4869/minifab/config"
+	"github.com/ddr4869/minifab/peer/chaincode"
 	"github.com/ddr4869/minifab/peer/common"
+	"github.com/ddr4869/minifab/peer/storage"
+	pb_common "github.com/ddr4869/minifab/proto/common"
+	"github.com/pkg/errors"
 )
 
 type Peer struct {
 	// PeerConfig    *config.Config
-	Peer          *config.PeerCfg
-	Orderer       *config.OrdererCfg
-	Client        *config.ClientCfg
-	Channel       *config.ChannelCfg
-	OrdererClient *common.OrdererClient
+	Peer           *config.PeerCfg
+	Orderer        *config.OrdererCfg
+	Client         *config.ClientCfg
+	Channel        *config.ChannelCfg
+	OrdererClient  *common.OrdererClient
+	BlockStorage   *storage.BlockStorage
+	ChannelManager ChannelManagerAPI
+	Chaincodes     *chaincode.ChaincodeRegistry
+	Endorser       *chaincode.Endorser
+	// TxTimestampSkew ValidateTransaction이 허용하는 timestamp 오차 (0이면 DefaultTxTimestampSkew)
+	TxTimestampSkew time.Duration
 }
 
 func NewPeer(peerId, mspId, mspPath, ordererAddress string) (*Peer, error) {
@@ -30,12 +46,36 @@ func NewPeer(peerId, mspId, mspPath, ordererAddress string) (*Peer, error) {
 	peerConfig.Client.MSPPath = mspPath
 	peerConfig.Orderer.Address = ordererAddress
 
+	return newPeer(peerConfig, filepath.Join(peerConfig.Peer.FilesystemPath, "statedb"), nil)
+}
+
+// NewPeerWithChannelManager 미리 채널을 등록해 둔 channelManager를 사용하는 peer 생성
+// peer의 MSP ID는 mspID이며 MSP 경로, client와 orderer 설정은 peerId의 설정을 따른다.
+// 체인코드 world state는 chaincodePath에 저장하고, channelManager의 BlockStorage를 원장으로 사용한다.
+// channelManager가 nil이면 NewPeer와 같이 설정의 LedgerPath에서 채널을 로드한다.
+func NewPeerWithChannelManager(peerId, chaincodePath, mspID string, channelManager ChannelManagerAPI) (*Peer, error) {
+	logger.Infof("✅ Creating peer with ID: %s, MSP ID: %s, chaincode path: %s", peerId, mspID, chaincodePath)
+
+	peerConfig, err := config.LoadPeerConfig(peerId)
+	if err != nil {
+		logger.Errorf("Failed to load peer config: %v", err)
+		return nil, err
+	}
+	peerConfig.PrintConfig()
+	peerConfig.Peer.MSPID = mspID
+
+	return newPeer(peerConfig, chaincodePath, channelManager)
+}
+
+// newPeer 설정의 peer/client MSP와 orderer client를 로드해 peer 생성
+func newPeer(peerConfig *config.Config, chaincodePath string, channelManager ChannelManagerAPI) (*Peer, error) {
 	peerMSP, err := msp.LoadMSPFromFiles(peerConfig.Peer.MSPID, peerConfig.Peer.MSPPath)
 	if err != nil {
 		logger.Errorf("Failed to load MSP from files: %v", err)
 		return nil, err
 	}
 	peerConfig.Peer.MSP = peerMSP
+	logger.Infof("✅ Peer MSP organizational units: %v", peerMSP.GetOrganizationalUnits())
 
 	logger.Infof("✅ Loading client MSP from files: %s", peerConfig.Client.MSPPath)
 	clientMSP, err := msp.LoadMSPFromFiles(peerConfig.Client.MSPID, peerConfig.Client.MSPPath)
@@ -51,11 +91,168 @@ func NewPeer(peerId, mspId, mspPath, ordererAddress string) (*Peer, error) {
 		return nil, err
 	}
 
-	return &Peer{
+	chaincodes := chaincode.NewChaincodeRegistry(chaincodePath)
+	p := &Peer{
 		Peer:          peerConfig.Peer,
 		Orderer:       peerConfig.Orderer,
 		Client:        peerConfig.Client,
 		Channel:       peerConfig.Channel,
 		OrdererClient: ordererClient,
-	}, nil
+		Chaincodes:    chaincodes,
+		Endorser:      chaincode.NewEndorser(chaincodes),
+	}
+	if channelManager == nil {
+		p.SetLedgerPath(peerConfig.Peer.LedgerPath)
+		return p, nil
+	}
+	p.BlockStorage = channelManager.BlockStorage()
+	p.Peer.LedgerPath = p.BlockStorage.StoragePath()
+	p.ChannelManager = channelManager
+	return p, nil
+}
+
+// SetLedgerPath 블록 저장 경로를 변경하고 해당 경로의 채널 정보를 다시 로드
+func (p *Peer) SetLedgerPath(ledgerPath string) {
+	p.Peer.LedgerPath = ledgerPath
+	p.BlockStorage = storage.NewBlockStorageWithPath(ledgerPath)
+	p.ChannelManager = NewChannelManager(p.BlockStorage)
+}
+
+// GetPeerSigningIdentity peer MSP의 서명 identity (채널 설정 블록 서명에 사용)
+func (p *Peer) GetPeerSigningIdentity() msp.SigningIdentity {
+	return p.Peer.MSP.GetSigningIdentity()
+}
+
+// GetClientSigningIdentity client MSP의 서명 identity (트랜잭션 서명에 사용)
+func (p *Peer) GetClientSigningIdentity() msp.SigningIdentity {
+	return p.Client.MSP.GetSigningIdentity()
+}
+
+// GetOrdererClient 기본 orderer에 연결된 client (연결하지 않은 peer는 nil)
+func (p *Peer) GetOrdererClient() *common.OrdererClient {
+	return p.OrdererClient
+}
+
+// GetOrdererAddress 기본 orderer 주소
+func (p *Peer) GetOrdererAddress() string {
+	return p.Orderer.Address
+}
+
+// GetBlockStorage 로컬 블록 저장소
+func (p *Peer) GetBlockStorage() *storage.BlockStorage {
+	return p.BlockStorage
+}
+
+// GetChannelManager peer가 참여한 채널 관리자
+func (p *Peer) GetChannelManager() ChannelManagerAPI {
+	return p.ChannelManager
+}
+
+// GetChaincodes 채널별 world state를 가진 체인코드 레지스트리
+func (p *Peer) GetChaincodes() *chaincode.ChaincodeRegistry {
+	return p.Chaincodes
+}
+
+// GetBlockCount 채널의 로컬 저장소에 저장된 블록 수
+func (p *Peer) GetBlockCount(channelID string) uint64 {
+	return p.BlockStorage.GetChannelHeight(channelID)
+}
+
+// GetLastBlock 채널의 로컬 저장소에 마지막으로 저장된 블록
+func (p *Peer) GetLastBlock(channelID string) (*pb_common.Block, error) {
+	return p.BlockStorage.GetLastBlock(channelID)
+}
+
+// GetTransaction 채널의 로컬 저장소에서 트랜잭션 ID로 트랜잭션 조회 (재시작 후에도 블록에서 다시 찾음)
+func (p *Peer) GetTransaction(channelID, txID string) (*pb_common.Transaction, error) {
+	tx, _, err := p.BlockStorage.GetTransactionByID(channelID, txID)
+	return tx, err
+}
+
+// IsConnected DefaultPingTimeout 안에 orderer가 Ping에 응답하는지 여부
+func (p *Peer) IsConnected() bool {
+	if p.OrdererClient == nil {
+		return false
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), common.DefaultPingTimeout)
+	defer cancel()
+
+	return p.OrdererClient.Ping(ctx) == nil
+}
+
+// GetChannelMembership peer가 참여한 채널별 상태 (created/joined/synced)
+func (p *Peer) GetChannelMembership() map[string]ChannelStatus {
+	return p.ChannelManager.ChannelStatuses()
+}
+
+// Reset 채널의 로컬 원장을 삭제하고 orderer로부터 다시 동기화
+// 설정 블록을 먼저 받아온 뒤 삭제하므로 orderer에 접속할 수 없으면 로컬 데이터는 유지된다.
+func (p *Peer) Reset(channelID string) error {
+	configBlock, err := p.OrdererClient.GetBlock(channelID, 0)
+	if err != nil {
+		return errors.Wrapf(err, "failed to fetch config block of channel %s", channelID)
+	}
+	return p.resetChannel(channelID, configBlock)
+}
+
+// ResetAll peer가 참여한 모든 채널의 로컬 원장을 삭제하고 orderer로부터 다시 동기화
+// 모든 채널의 설정 블록을 먼저 받아오므로 하나라도 받지 못하면 어떤 채널도 삭제하지 않는다.
+// 이후 실패한 채널이 있어도 나머지 채널은 계속 처리하며, 실패한 채널들을 묶어 에러로 반환한다.
+func (p *Peer) ResetAll() error {
+	channelIDs := p.ChannelManager.GetChannelNames()
+	configBlocks := make(map[string]*pb_common.Block, len(channelIDs))
+	for _, channelID := range channelIDs {
+		configBlock, err := p.OrdererClient.GetBlock(channelID, 0)
+		if err != nil {
+			return errors.Wrapf(err, "failed to fetch config block of channel %s", channelID)
+		}
+		configBlocks[channelID] = configBlock
+	}
+
+	var failures []string
+	for i, channelID := range channelIDs {
+		logger.Infof("[Peer] Resetting channel %s (%d/%d)", channelID, i+1, len(channelIDs))
+		if err := p.resetChannel(channelID, configBlocks[channelID]); err != nil {
+			logger.Errorf("Failed to reset channel %s: %v", channelID, err)
+			failures = append(failures, fmt.Sprintf("%s: %v", channelID, err))
+		}
+	}
+	if len(failures) > 0 {
+		return errors.Errorf("failed to reset %d of %d channel(s): %s", len(failures), len(channelIDs), strings.Join(failures, "; "))
+	}
+	return nil
+}
+
+// resetChannel 받아 둔 설정 블록으로 채널을 다시 참여시킨 뒤 나머지 블록을 동기화
+func (p *Peer) resetChannel(channelID string, configBlock *pb_common.Block) error {
+	if err := p.ChannelManager.ResetChannel(channelID, configBlock); err != nil {
+		return errors.Wrapf(err, "failed to reset channel %s", channelID)
+	}
+
+	if err := p.SyncChannel(channelID); err != nil {
+		return errors.Wrapf(err, "failed to resync channel %s", channelID)
+	}
+	logger.Infof("✅ Channel %s reset and resynced (height: %d)", channelID, p.BlockStorage.GetChannelHeight(channelID))
+	return nil
+}
+
+// SyncChannel 로컬 높이 이후의 블록을 orderer로부터 받아 저장
+func (p *Peer) SyncChannel(channelID string) error {
+	for {
+		height := p.BlockStorage.GetChannelHeight(channelID)
+		block, err := p.OrdererClient.GetBlock(channelID, height)
+		if errors.Is(err, common.ErrBlockNotFound) {
+			logger.Infof("[Peer] Channel %s is up to date (height: %d)", channelID, height)
+			return p.ChannelManager.MarkSynced(channelID)
+		}
+		if err != nil {
+			return err
+		}
+		if err := p.VerifyBlock(channelID, block); err != nil {
+			return errors.Wrapf(err, "failed to verify block %d", height)
+		}
+		if err := p.BlockStorage.StoreBlock(channelID, block); err != nil {
+			return errors.Wrapf(err, "failed to save block %d", height)
+		}
+	}
 }
@@ -0,0 +1,231 @@
+package core
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/ddr4869/minifab/common/blockutil"
+	"github.com/ddr4869/minifab/common/configtx"
+	"github.com/ddr4869/minifab/common/msp/msptest"
+	"github.com/ddr4869/minifab/peer/storage"
+	pb_common "github.com/ddr4869/minifab/proto/common"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestResetRestoresCorruptedChannel(t *testing.T) {
+	c := newTestChannel(t, "mychannel")
+	orderer, client := startFakeOrderer(t)
+	c.peer.OrdererClient = client
+
+	blocks := []*pb_common.Block{c.genesis}
+	for i := 0; i < 3; i++ {
+		blocks = append(blocks, c.nextBlock(t, blocks[len(blocks)-1], true))
+	}
+	orderer.append(c.id, blocks...)
+	for _, block := range blocks[1:] {
+		if err := c.peer.BlockStorage.StoreBlock(c.id, block); err != nil {
+			t.Fatalf("StoreBlock: %v", err)
+		}
+	}
+
+	// 블록 2 파일을 깨뜨린다
+	channelDir := filepath.Join(c.peer.BlockStorage.StoragePath(), c.id)
+	if err := os.WriteFile(filepath.Join(channelDir, blockutil.PaddedBlockFileName(2)), []byte("corrupted"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.peer.BlockStorage.GetBlock(c.id, 2); err == nil {
+		t.Fatal("GetBlock succeeded on a corrupted block file")
+	}
+
+	if err := c.peer.Reset(c.id); err != nil {
+		t.Fatalf("Reset: %v", err)
+	}
+
+	if height := c.peer.GetBlockCount(c.id); height != uint64(len(blocks)) {
+		t.Fatalf("height after reset = %d, want %d", height, len(blocks))
+	}
+	for _, want := range blocks {
+		got, err := c.peer.BlockStorage.GetBlock(c.id, want.Header.Number)
+		if err != nil {
+			t.Fatalf("GetBlock(%d): %v", want.Header.Number, err)
+		}
+		if !bytes.Equal(blockutil.CalculateBlockHash(got), blockutil.CalculateBlockHash(want)) {
+			t.Errorf("block %d differs from the orderer's block", want.Header.Number)
+		}
+	}
+	if names := c.peer.ChannelManager.GetChannelNames(); len(names) != 1 || names[0] != c.id {
+		t.Errorf("channels after reset = %v, want [%s]", names, c.id)
+	}
+}
+
+func TestResetFailsWithoutOrdererBlock(t *testing.T) {
+	c := newTestChannel(t, "mychannel")
+	_, client := startFakeOrderer(t)
+	c.peer.OrdererClient = client
+
+	if err := c.peer.Reset(c.id); err == nil {
+		t.Fatal("Reset succeeded although the orderer has no config block")
+	}
+	// 설정 블록을 받지 못하면 로컬 원장은 그대로 남아 있어야 한다
+	if height := c.peer.GetBlockCount(c.id); height != 1 {
+		t.Errorf("height = %d, want 1", height)
+	}
+}
+
+func TestGetBlockCountAndLastBlock(t *testing.T) {
+	c := newTestChannel(t, "mychannel")
+
+	if count := c.peer.GetBlockCount(c.id); count != 1 {
+		t.Fatalf("GetBlockCount after join = %d, want 1", count)
+	}
+	last, err := c.peer.GetLastBlock(c.id)
+	if err != nil {
+		t.Fatalf("GetLastBlock: %v", err)
+	}
+	if !bytes.Equal(blockutil.CalculateBlockHash(last), blockutil.CalculateBlockHash(c.genesis)) {
+		t.Error("last block after join is not the genesis block")
+	}
+
+	previous := c.genesis
+	for i := 1; i <= 3; i++ {
+		block := c.nextBlock(t, previous, true)
+		if err := c.peer.BlockStorage.StoreBlock(c.id, block); err != nil {
+			t.Fatalf("StoreBlock: %v", err)
+		}
+		if count := c.peer.GetBlockCount(c.id); count != uint64(i+1) {
+			t.Fatalf("GetBlockCount after block %d = %d, want %d", i, count, i+1)
+		}
+		last, err := c.peer.GetLastBlock(c.id)
+		if err != nil {
+			t.Fatalf("GetLastBlock: %v", err)
+		}
+		if last.Header.Number != uint64(i) || !bytes.Equal(blockutil.CalculateBlockHash(last), blockutil.CalculateBlockHash(block)) {
+			t.Fatalf("last block = %d, want the stored block %d", last.Header.Number, i)
+		}
+		previous = block
+	}
+
+	if count := c.peer.GetBlockCount("nochannel"); count != 0 {
+		t.Errorf("GetBlockCount of unknown channel = %d, want 0", count)
+	}
+	if _, err := c.peer.GetLastBlock("nochannel"); err == nil {
+		t.Error("GetLastBlock succeeded for a channel without blocks")
+	}
+}
+
+func TestGetTransactionAfterRestart(t *testing.T) {
+	c := newTestChannel(t, "mychannel")
+	var txs []*pb_common.Transaction
+	for i := 0; i < 3; i++ {
+		tx := &pb_common.Transaction{
+			Payload:   []byte(fmt.Sprintf("tx-%d", i)),
+			Identity:  &pb_common.Identity{MspId: "Org1MSP", Creator: []byte("creator")},
+			Timestamp: time.Now().Unix(),
+			Type:      pb_common.MessageType_MESSAGE_TYPE_TRANSACTION,
+		}
+		txID, err := blockutil.CalculateTxHash(tx)
+		if err != nil {
+			t.Fatalf("CalculateTxHash: %v", err)
+		}
+		tx.TxId = txID
+		txs = append(txs, tx)
+	}
+	block, err := blockutil.GenerateDataBlock(txs, c.id, c.ordererOrg.SigningIdentity())
+	if err != nil {
+		t.Fatalf("GenerateDataBlock: %v", err)
+	}
+	blockutil.SetBlockPosition(block, 1, blockutil.CalculateBlockHash(c.genesis))
+	if err := c.peer.BlockStorage.StoreBlock(c.id, block); err != nil {
+		t.Fatalf("StoreBlock: %v", err)
+	}
+
+	// 같은 저장 경로를 사용하는 새 Peer는 인덱스를 블록에서 다시 만든다
+	blockStorage := storage.NewBlockStorageWithPath(c.peer.BlockStorage.StoragePath())
+	restarted := &Peer{BlockStorage: blockStorage, ChannelManager: NewChannelManager(blockStorage)}
+	got, err := restarted.GetTransaction(c.id, txs[1].TxId)
+	if err != nil {
+		t.Fatalf("GetTransaction after restart: %v", err)
+	}
+	if !proto.Equal(got, txs[1]) {
+		t.Errorf("GetTransaction after restart = %v, want %v", got, txs[1])
+	}
+	if _, err := restarted.GetTransaction(c.id, "unknown"); err == nil {
+		t.Error("GetTransaction of an unknown ID succeeded, want error")
+	}
+}
+
+// setupPeerEnv 임시 디렉터리에 빈 .env를 두고 그곳으로 이동한 뒤 org1peer0 설정 환경 변수 지정 (테스트 종료 시 복원)
+// 반환값은 NewPeer에 넘길 MSP 경로
+func setupPeerEnv(t *testing.T) string {
+	t.Helper()
+
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, ".env"), nil, 0644); err != nil {
+		t.Fatal(err)
+	}
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chdir(dir); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.Chdir(wd) })
+
+	org := msptest.NewOrg(t, "Org1MSP")
+	mspPath := org.WriteMSPDir(t, filepath.Join(dir, "msp"))
+	t.Setenv("ORG1_PEER0_MSP_PATH", mspPath)
+	t.Setenv("ORG1_PEER0_MSPID", org.MSPID)
+	t.Setenv("ORG1_PEER0_FILESYSTEM_PATH", filepath.Join(dir, "filesystem"))
+	t.Setenv("ORG1_PEER0_LEDGER_PATH", "")
+	t.Setenv("ORG1_CLIENT_MSP_PATH", mspPath)
+	t.Setenv("ORG1_CLIENT_MSPID", org.MSPID)
+	return mspPath
+}
+
+func TestNewPeerWithChannelManager(t *testing.T) {
+	setupPeerEnv(t)
+	ledgerPath := t.TempDir()
+	channelManager := NewChannelManager(storage.NewBlockStorageWithPath(ledgerPath))
+	for _, channelID := range []string{"channel2", "channel1"} {
+		channelManager.AddChannel(channelID, &configtx.ChannelConfig{})
+	}
+
+	chaincodePath := filepath.Join(t.TempDir(), "statedb")
+	peer, err := NewPeerWithChannelManager("org1peer0", chaincodePath, "Org1MSP", channelManager)
+	if err != nil {
+		t.Fatalf("NewPeerWithChannelManager: %v", err)
+	}
+	if peer.GetChannelManager() != ChannelManagerAPI(channelManager) {
+		t.Fatal("peer does not use the given ChannelManager")
+	}
+	if got := peer.GetChannelManager().GetChannelNames(); !reflect.DeepEqual(got, []string{"channel1", "channel2"}) {
+		t.Errorf("GetChannelNames() = %v, want [channel1 channel2]", got)
+	}
+	if peer.Peer.LedgerPath != ledgerPath || peer.BlockStorage.StoragePath() != ledgerPath {
+		t.Errorf("ledger path = %s, storage path = %s, want %s", peer.Peer.LedgerPath, peer.BlockStorage.StoragePath(), ledgerPath)
+	}
+	if peer.Peer.MSPID != "Org1MSP" {
+		t.Errorf("peer MSP ID = %s, want Org1MSP", peer.Peer.MSPID)
+	}
+}
+
+func TestNewPeerLoadsChannelsFromLedgerPath(t *testing.T) {
+	mspPath := setupPeerEnv(t)
+
+	peer, err := NewPeer("org1peer0", "Org1MSP", mspPath, "127.0.0.1:7050")
+	if err != nil {
+		t.Fatalf("NewPeer: %v", err)
+	}
+	if want := filepath.Join(os.Getenv("ORG1_PEER0_FILESYSTEM_PATH"), "blocks"); peer.Peer.LedgerPath != want {
+		t.Errorf("LedgerPath = %s, want %s", peer.Peer.LedgerPath, want)
+	}
+	if count := peer.ChannelManager.ChannelCount(); count != 0 {
+		t.Errorf("new peer has %d channels, want 0", count)
+	}
+}
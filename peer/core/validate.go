@@ -0,0 +1,73 @@
+package core
+
+import (
+	"github.com/ddr4869/minifab/common/blockutil"
+	"github.com/ddr4869/minifab/common/logger"
+	"github.com/ddr4869/minifab/common/msp"
+	pb_common "github.com/ddr4869/minifab/proto/common"
+	"github.com/pkg/errors"
+)
+
+// DefaultTxTimestampSkew 트랜잭션 timestamp와 현재 시각의 기본 허용 오차 (과거/미래 모두)
+const DefaultTxTimestampSkew = blockutil.MaxTimestampSkew
+
+// ValidateTransaction 트랜잭션 형식, timestamp 허용 범위, 생성자 identity와 서명을 검증
+// 생성자 identity는 채널 설정의 조직 MSP로 역직렬화하고, 실패하면 peer 자신의 MSP로 다시 시도한다.
+// timestamp가 현재 시각에서 TxTimestampSkew(0이면 DefaultTxTimestampSkew)보다 멀면 재전송으로 보고 거부한다.
+func (p *Peer) ValidateTransaction(channelID string, tx *pb_common.Transaction) error {
+	if err := blockutil.ValidateTransaction(tx, channelID, p.TxTimestampSkew); err != nil {
+		return err
+	}
+
+	identity, err := p.deserializeTxCreator(channelID, tx.Identity)
+	if err != nil {
+		return errors.Wrapf(err, "transaction %s", tx.TxId)
+	}
+	// CreateTransaction은 payload의 SHA256 해시에 서명하며, Verify가 메시지를 해시한다
+	if err := identity.Verify(tx.Payload, tx.Signature); err != nil {
+		return errors.Wrapf(err, "transaction %s", tx.TxId)
+	}
+	return nil
+}
+
+// deserializeTxCreator 채널 MSP로 생성자 identity를 역직렬화하고, 실패하면 같은 MSP ID인 peer MSP로 재시도
+func (p *Peer) deserializeTxCreator(channelID string, creator *pb_common.Identity) (msp.Identity, error) {
+	channelMSP, err := p.ChannelManager.GetChannelMSP(channelID, creator.MspId)
+	if err == nil {
+		identity, deserializeErr := channelMSP.DeserializeIdentity(creator.Creator)
+		if deserializeErr == nil {
+			return identity, nil
+		}
+		err = deserializeErr
+	}
+
+	if p.Peer == nil || p.Peer.MSP == nil || p.Peer.MSPID != creator.MspId {
+		return nil, errors.Wrapf(err, "failed to deserialize identity of MSP %s", creator.MspId)
+	}
+	logger.Warnf("[Peer] Channel %s MSP could not deserialize identity of %s, falling back to peer MSP: %v", channelID, creator.MspId, err)
+	identity, fallbackErr := p.Peer.MSP.DeserializeIdentity(creator.Creator)
+	if fallbackErr != nil {
+		return nil, errors.Wrapf(fallbackErr, "failed to deserialize identity of MSP %s with peer MSP", creator.MspId)
+	}
+	return identity, nil
+}
+
+// VerifyBlock orderer로부터 받은 블록의 서명을 채널 설정의 orderer MSP로 검증 (저장 전에 호출)
+// 서명이 없는 블록은 채널의 UnsignedBlockHeight 미만 번호일 때만 받아들인다.
+func (p *Peer) VerifyBlock(channelID string, block *pb_common.Block) error {
+	if len(block.GetMetadata().GetSignature()) == 0 {
+		if unsignedHeight := p.ChannelManager.UnsignedBlockHeight(channelID); block.GetHeader().GetNumber() < unsignedHeight {
+			logger.Warnf("[Peer] Accepting unsigned block %d of channel %s (unsigned blocks allowed below %d)",
+				block.GetHeader().GetNumber(), channelID, unsignedHeight)
+			return nil
+		}
+	}
+	ordererMSP, err := p.ChannelManager.GetOrdererMSP(channelID)
+	if err != nil {
+		return err
+	}
+	if err := blockutil.VerifyBlockSignature(block, ordererMSP); err != nil {
+		return errors.Wrapf(err, "channel %s", channelID)
+	}
+	return nil
+}
@@ -0,0 +1,200 @@
+package core
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ddr4869/minifab/common/blockutil"
+	"github.com/ddr4869/minifab/common/configtx"
+	"github.com/ddr4869/minifab/common/msp/msptest"
+	"github.com/ddr4869/minifab/config"
+	pb_common "github.com/ddr4869/minifab/proto/common"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestVerifyBlockRequiresOrdererSignature(t *testing.T) {
+	c := newTestChannel(t, "mychannel")
+
+	signed := c.nextBlock(t, c.genesis, true)
+	if err := c.peer.VerifyBlock(c.id, signed); err != nil {
+		t.Fatalf("VerifyBlock rejected signed block: %v", err)
+	}
+
+	tampered := proto.Clone(signed).(*pb_common.Block)
+	tampered.Header.Number = 2
+	if err := c.peer.VerifyBlock(c.id, tampered); err == nil {
+		t.Fatal("VerifyBlock accepted block with tampered number")
+	}
+
+	if err := c.peer.VerifyBlock(c.id, c.nextBlock(t, c.genesis, false)); err == nil {
+		t.Fatal("VerifyBlock accepted unsigned block without a recorded unsigned height")
+	}
+}
+
+func TestVerifyBlockAcceptsUnsignedBlocksBelowRecordedHeight(t *testing.T) {
+	c := newTestChannel(t, "mychannel")
+	if err := c.peer.ChannelManager.AllowUnsignedBlocks(c.id, 3); err != nil {
+		t.Fatalf("AllowUnsignedBlocks: %v", err)
+	}
+
+	block1 := c.nextBlock(t, c.genesis, false)
+	block2 := c.nextBlock(t, block1, false)
+	block3 := c.nextBlock(t, block2, false)
+	for _, block := range []*pb_common.Block{block1, block2} {
+		if err := c.peer.VerifyBlock(c.id, block); err != nil {
+			t.Fatalf("VerifyBlock rejected unsigned block %d below height 3: %v", block.Header.Number, err)
+		}
+	}
+	if err := c.peer.VerifyBlock(c.id, block3); err == nil {
+		t.Fatal("VerifyBlock accepted unsigned block 3 at the recorded height")
+	}
+
+	// 서명이 있는 블록은 높이와 관계없이 검증된다
+	forged := c.nextBlock(t, c.genesis, true)
+	forged.Metadata.Signature[0] ^= 0xff
+	if err := c.peer.VerifyBlock(c.id, forged); err == nil {
+		t.Fatal("VerifyBlock accepted block 1 with an invalid signature")
+	}
+
+	if err := c.peer.ChannelManager.AllowUnsignedBlocks("unknown", 3); err == nil {
+		t.Fatal("AllowUnsignedBlocks accepted an unknown channel")
+	}
+}
+
+func TestNewChannelManagerRecordsUnsignedLedger(t *testing.T) {
+	c := newTestChannel(t, "mychannel")
+
+	// 서명 검증 도입 전의 peer가 저장한 원장
+	previous := c.genesis
+	for i := 0; i < 2; i++ {
+		block := c.nextBlock(t, previous, false)
+		if err := c.peer.BlockStorage.StoreBlock(c.id, block); err != nil {
+			t.Fatalf("StoreBlock: %v", err)
+		}
+		previous = block
+	}
+
+	reloaded := NewChannelManager(c.peer.BlockStorage)
+	if got := reloaded.UnsignedBlockHeight(c.id); got != 3 {
+		t.Fatalf("UnsignedBlockHeight after restart = %d, want 3", got)
+	}
+
+	// 서명된 블록으로 끝나는 원장은 기록하지 않는다
+	signed := newTestChannel(t, "signedchannel")
+	if err := signed.peer.BlockStorage.StoreBlock(signed.id, signed.nextBlock(t, signed.genesis, true)); err != nil {
+		t.Fatalf("StoreBlock: %v", err)
+	}
+	if got := NewChannelManager(signed.peer.BlockStorage).UnsignedBlockHeight(signed.id); got != 0 {
+		t.Fatalf("UnsignedBlockHeight of signed ledger = %d, want 0", got)
+	}
+}
+
+func TestResetChannelKeepsUnsignedBlockHeight(t *testing.T) {
+	c := newTestChannel(t, "mychannel")
+	if err := c.peer.ChannelManager.AllowUnsignedBlocks(c.id, 5); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.peer.ChannelManager.ResetChannel(c.id, c.genesis); err != nil {
+		t.Fatalf("ResetChannel: %v", err)
+	}
+	if got := c.peer.ChannelManager.UnsignedBlockHeight(c.id); got != 5 {
+		t.Fatalf("UnsignedBlockHeight after reset = %d, want 5", got)
+	}
+	metadata, err := c.peer.BlockStorage.LoadChannelMetadata(c.id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if metadata.JoinedAt.IsZero() {
+		t.Fatal("ResetChannel lost the join time")
+	}
+}
+
+func TestValidateTransactionWithChannelMSP(t *testing.T) {
+	c := newTestChannel(t, "mychannel")
+	org := msptest.NewOrg(t, "Org1MSP")
+	c.peer.ChannelManager.AddChannel("appchannel", &configtx.ChannelConfig{
+		CC: &configtx.AppChannelConfig{
+			Organizations: []configtx.Organization{{Name: "Org1", ID: "Org1MSP", MSPCaCert: org.CACert.Raw}},
+		},
+	})
+
+	tx, err := blockutil.CreateTransaction(org.SigningIdentity(), []byte("payload"))
+	if err != nil {
+		t.Fatalf("CreateTransaction: %v", err)
+	}
+	if err := c.peer.ValidateTransaction("appchannel", tx); err != nil {
+		t.Fatalf("ValidateTransaction: %v", err)
+	}
+
+	tampered := proto.Clone(tx).(*pb_common.Transaction)
+	tampered.Payload = []byte("tampered")
+	if err := c.peer.ValidateTransaction("appchannel", tampered); err == nil {
+		t.Fatal("ValidateTransaction accepted a tampered payload")
+	}
+
+	// 채널 조직이 아닌 다른 CA의 identity는 거부된다
+	outsider, err := blockutil.CreateTransaction(msptest.NewOrg(t, "Org1MSP").SigningIdentity(), []byte("payload"))
+	if err != nil {
+		t.Fatalf("CreateTransaction: %v", err)
+	}
+	if err := c.peer.ValidateTransaction("appchannel", outsider); err == nil {
+		t.Fatal("ValidateTransaction accepted an identity from a different CA")
+	}
+}
+
+func TestValidateTransactionFallsBackToPeerMSP(t *testing.T) {
+	c := newTestChannel(t, "mychannel")
+	org := msptest.NewOrg(t, "Org1MSP")
+	tx, err := blockutil.CreateTransaction(org.SigningIdentity(), []byte("payload"))
+	if err != nil {
+		t.Fatalf("CreateTransaction: %v", err)
+	}
+
+	// 채널 설정에 Org1MSP가 없고 peer MSP도 없으면 거부
+	if err := c.peer.ValidateTransaction(c.id, tx); err == nil {
+		t.Fatal("ValidateTransaction accepted an identity without any matching MSP")
+	}
+
+	c.peer.Peer = &config.PeerCfg{ID: "peer0", MSPID: org.MSPID, MSP: org.MSP}
+	if err := c.peer.ValidateTransaction(c.id, tx); err != nil {
+		t.Fatalf("ValidateTransaction with peer MSP fallback: %v", err)
+	}
+}
+
+func TestValidateTransactionRejectsTimestampOutsideSkew(t *testing.T) {
+	c := newTestChannel(t, "mychannel")
+	org := msptest.NewOrg(t, "Org1MSP")
+	c.peer.Peer = &config.PeerCfg{ID: "peer0", MSPID: org.MSPID, MSP: org.MSP}
+
+	tests := []struct {
+		name   string
+		offset time.Duration
+		skew   time.Duration
+		valid  bool
+	}{
+		{"within default skew", -time.Minute, 0, true},
+		{"older than default skew", -10 * time.Minute, 0, false},
+		{"newer than default skew", 10 * time.Minute, 0, false},
+		{"older timestamp within configured skew", -10 * time.Minute, time.Hour, true},
+		{"newer timestamp within configured skew", 10 * time.Minute, time.Hour, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tx, err := blockutil.CreateTransaction(org.SigningIdentity(), []byte("payload"))
+			if err != nil {
+				t.Fatalf("CreateTransaction: %v", err)
+			}
+			// 서명 대상은 payload이므로 timestamp를 바꿔도 서명은 유효하다
+			tx.Timestamp = time.Now().Add(tt.offset).Unix()
+			c.peer.TxTimestampSkew = tt.skew
+
+			err = c.peer.ValidateTransaction(c.id, tx)
+			if tt.valid && err != nil {
+				t.Fatalf("ValidateTransaction: %v", err)
+			}
+			if !tt.valid && err == nil {
+				t.Fatal("ValidateTransaction accepted a timestamp outside the skew window")
+			}
+		})
+	}
+}
@@ -0,0 +1,215 @@
+package events
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/ddr4869/minifab/common/blockutil"
+	"github.com/ddr4869/minifab/common/logger"
+	pb_common "github.com/ddr4869/minifab/proto/common"
+	"github.com/pkg/errors"
+)
+
+const (
+	// DefaultWebhookRetries 첫 요청이 실패한 뒤 다시 시도하는 횟수
+	DefaultWebhookRetries = 3
+	// DefaultWebhookRetryDelay 첫 재시도 전 대기 시간 (재시도마다 두 배로 늘어남)
+	DefaultWebhookRetryDelay = 500 * time.Millisecond
+	// DefaultWebhookTimeout webhook 요청 하나의 timeout
+	DefaultWebhookTimeout = 5 * time.Second
+	// DefaultWebhookQueueSize 채널별로 전송을 기다릴 수 있는 알림 수 (가득 차면 새 알림은 버려짐)
+	DefaultWebhookQueueSize = 256
+)
+
+// BlockCommitEvent webhook으로 POST하는 블록 커밋 알림
+type BlockCommitEvent struct {
+	ChannelID   string `json:"channel_id"`
+	BlockNumber uint64 `json:"block_number"`
+	DataHashHex string `json:"data_hash_hex"`
+	// 블록 헤더에는 시각이 없으므로 peer가 블록을 저장한 시각 (RFC3339, UTC)
+	Timestamp string `json:"timestamp"`
+	TxCount   int    `json:"tx_count"`
+}
+
+// WebhookNotifier는 채널에 블록이 커밋될 때마다 채널별로 등록된 URL에 BlockCommitEvent를 POST한다.
+// BlockStorage.RegisterCommitHook에 BlockCommitted를 등록해 사용한다.
+// 채널마다 worker 하나가 큐의 알림을 블록 순서대로 하나씩 전송한다.
+type WebhookNotifier struct {
+	mutex   sync.RWMutex
+	workers map[string]*webhookWorker
+	client  *http.Client
+	// Retries 실패한 요청을 다시 시도하는 횟수
+	Retries int
+	// RetryDelay 첫 재시도 전 대기 시간 (이후 재시도마다 두 배)
+	RetryDelay time.Duration
+	// QueueSize 새로 등록되는 채널의 알림 큐 크기
+	QueueSize int
+}
+
+// webhookWorker 채널 하나의 알림 큐와 전송 대상 URL
+type webhookWorker struct {
+	mutex sync.Mutex
+	url   string
+	queue chan *BlockCommitEvent
+	done  chan struct{}
+}
+
+func NewWebhookNotifier() *WebhookNotifier {
+	return &WebhookNotifier{
+		workers:    make(map[string]*webhookWorker),
+		client:     &http.Client{Timeout: DefaultWebhookTimeout},
+		Retries:    DefaultWebhookRetries,
+		RetryDelay: DefaultWebhookRetryDelay,
+		QueueSize:  DefaultWebhookQueueSize,
+	}
+}
+
+// Register 채널의 webhook URL 등록 (이미 있으면 교체)
+func (n *WebhookNotifier) Register(channelID, webhookURL string) error {
+	if err := blockutil.ValidateChannelName(channelID); err != nil {
+		return err
+	}
+	parsed, err := url.Parse(webhookURL)
+	if err != nil {
+		return errors.Wrapf(err, "invalid webhook URL %q", webhookURL)
+	}
+	if (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return errors.Errorf("invalid webhook URL %q (must be an http or https URL)", webhookURL)
+	}
+
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+
+	// 이미 등록된 채널은 worker를 유지한 채 URL만 바꿔 알림 순서가 섞이지 않게 한다
+	if worker, exists := n.workers[channelID]; exists {
+		worker.setURL(webhookURL)
+	} else {
+		worker := &webhookWorker{
+			url:   webhookURL,
+			queue: make(chan *BlockCommitEvent, n.QueueSize),
+			done:  make(chan struct{}),
+		}
+		n.workers[channelID] = worker
+		go n.run(channelID, worker)
+	}
+	logger.Infof("[Peer] Registered block webhook for channel %s: %s", channelID, webhookURL)
+	return nil
+}
+
+// Unregister 채널의 webhook 등록 해제 (등록되어 있지 않으면 에러)
+// 이미 큐에 들어간 알림은 worker가 모두 전송한 뒤 종료한다.
+func (n *WebhookNotifier) Unregister(channelID string) error {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+
+	worker, exists := n.workers[channelID]
+	if !exists {
+		return errors.Errorf("no webhook registered for channel %s", channelID)
+	}
+	delete(n.workers, channelID)
+	close(worker.queue)
+	return nil
+}
+
+// Close 모든 채널의 webhook 등록을 해제하고 큐에 남은 알림이 전송될 때까지 대기
+func (n *WebhookNotifier) Close() {
+	n.mutex.Lock()
+	workers := n.workers
+	n.workers = make(map[string]*webhookWorker)
+	for _, worker := range workers {
+		close(worker.queue)
+	}
+	n.mutex.Unlock()
+
+	for _, worker := range workers {
+		<-worker.done
+	}
+}
+
+// BlockCommitted 채널에 webhook이 등록되어 있으면 커밋 알림을 채널 큐에 추가
+// 블록 저장이 webhook 응답을 기다리지 않도록 바로 반환하며, 큐가 가득 차면 알림을 버린다.
+func (n *WebhookNotifier) BlockCommitted(channelID string, block *pb_common.Block) {
+	event := &BlockCommitEvent{
+		ChannelID:   channelID,
+		BlockNumber: block.GetHeader().GetNumber(),
+		DataHashHex: hex.EncodeToString(block.GetHeader().GetDataHash()),
+		Timestamp:   time.Now().UTC().Format(time.RFC3339),
+		TxCount:     blockutil.GetBlockTransactionCount(block),
+	}
+
+	// Unregister가 큐를 닫는 동안 보내지 않도록 read lock을 잡은 채로 추가
+	n.mutex.RLock()
+	defer n.mutex.RUnlock()
+
+	worker, exists := n.workers[channelID]
+	if !exists {
+		return
+	}
+	select {
+	case worker.queue <- event:
+	default:
+		logger.Errorf("[Peer] Webhook queue of channel %s is full, dropping notification of block %d", channelID, event.BlockNumber)
+	}
+}
+
+// run 큐가 닫힐 때까지 채널의 알림을 순서대로 전송
+func (n *WebhookNotifier) run(channelID string, worker *webhookWorker) {
+	defer close(worker.done)
+
+	for event := range worker.queue {
+		if err := n.deliver(worker.getURL(), event); err != nil {
+			logger.Errorf("[Peer] Failed to notify webhook of block %d in channel %s: %v", event.BlockNumber, channelID, err)
+		}
+	}
+}
+
+func (w *webhookWorker) setURL(webhookURL string) {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	w.url = webhookURL
+}
+
+func (w *webhookWorker) getURL() string {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	return w.url
+}
+
+// deliver event를 POST하고 실패하면 Retries번까지 지수적으로 늘어나는 간격으로 다시 시도
+func (n *WebhookNotifier) deliver(webhookURL string, event *BlockCommitEvent) error {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal block commit event")
+	}
+
+	delay := n.RetryDelay
+	for attempt := 0; ; attempt++ {
+		err = n.post(webhookURL, body)
+		if err == nil {
+			return nil
+		}
+		if attempt >= n.Retries {
+			return errors.Wrapf(err, "giving up after %d attempts", attempt+1)
+		}
+		logger.Warnf("[Peer] Webhook %s failed (attempt %d), retrying in %s: %v", webhookURL, attempt+1, delay, err)
+		time.Sleep(delay)
+		delay *= 2
+	}
+}
+
+func (n *WebhookNotifier) post(webhookURL string, body []byte) error {
+	response, err := n.client.Post(webhookURL, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	response.Body.Close()
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return errors.Errorf("webhook returned %s", response.Status)
+	}
+	return nil
+}
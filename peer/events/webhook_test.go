@@ -0,0 +1,177 @@
+package events
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	pb_common "github.com/ddr4869/minifab/proto/common"
+)
+
+// webhookRecorder httptest 서버가 받은 BlockCommitEvent를 순서대로 기록
+type webhookRecorder struct {
+	mutex  sync.Mutex
+	events []BlockCommitEvent
+	// failures 처음 몇 번의 요청에 500으로 응답할지
+	failures atomic.Int32
+}
+
+func (r *webhookRecorder) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodPost || req.Header.Get("Content-Type") != "application/json" {
+		http.Error(w, "unexpected request", http.StatusBadRequest)
+		return
+	}
+	if r.failures.Add(-1) >= 0 {
+		http.Error(w, "try again", http.StatusInternalServerError)
+		return
+	}
+	body, err := io.ReadAll(req.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	var event BlockCommitEvent
+	if err := json.Unmarshal(body, &event); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	r.mutex.Lock()
+	r.events = append(r.events, event)
+	r.mutex.Unlock()
+}
+
+func (r *webhookRecorder) received() []BlockCommitEvent {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	return append([]BlockCommitEvent(nil), r.events...)
+}
+
+func newTestNotifier() *WebhookNotifier {
+	notifier := NewWebhookNotifier()
+	notifier.RetryDelay = time.Millisecond
+	return notifier
+}
+
+func testBlock(number uint64, txCount int) *pb_common.Block {
+	transactions := make([][]byte, txCount)
+	for i := range transactions {
+		transactions[i] = []byte{byte(i)}
+	}
+	return &pb_common.Block{
+		Header: &pb_common.BlockHeader{Number: number, DataHash: []byte{0xde, 0xad, byte(number)}},
+		Data:   &pb_common.BlockData{Transactions: transactions},
+	}
+}
+
+func TestWebhookNotifierPostsBlockCommitEvent(t *testing.T) {
+	recorder := &webhookRecorder{}
+	server := httptest.NewServer(recorder)
+	defer server.Close()
+
+	notifier := newTestNotifier()
+	if err := notifier.Register("mychannel", server.URL); err != nil {
+		t.Fatalf("Register: %v", err)
+	}
+	before := time.Now().UTC().Add(-time.Second)
+	notifier.BlockCommitted("mychannel", testBlock(7, 3))
+	notifier.BlockCommitted("otherchannel", testBlock(1, 1))
+	notifier.Close()
+
+	events := recorder.received()
+	if len(events) != 1 {
+		t.Fatalf("received %d events, want 1", len(events))
+	}
+	event := events[0]
+	if event.ChannelID != "mychannel" || event.BlockNumber != 7 || event.TxCount != 3 {
+		t.Fatalf("unexpected event %+v", event)
+	}
+	if event.DataHashHex != hex.EncodeToString([]byte{0xde, 0xad, 7}) {
+		t.Fatalf("data_hash_hex = %q", event.DataHashHex)
+	}
+	timestamp, err := time.Parse(time.RFC3339, event.Timestamp)
+	if err != nil {
+		t.Fatalf("timestamp %q is not RFC3339: %v", event.Timestamp, err)
+	}
+	if timestamp.Before(before.Truncate(time.Second)) {
+		t.Fatalf("timestamp %s is before the commit", event.Timestamp)
+	}
+}
+
+func TestWebhookNotifierDeliversInOrderWithRetries(t *testing.T) {
+	recorder := &webhookRecorder{}
+	recorder.failures.Store(2)
+	server := httptest.NewServer(recorder)
+	defer server.Close()
+
+	notifier := newTestNotifier()
+	if err := notifier.Register("mychannel", server.URL); err != nil {
+		t.Fatal(err)
+	}
+	for number := uint64(1); number <= 20; number++ {
+		notifier.BlockCommitted("mychannel", testBlock(number, 1))
+	}
+	notifier.Close()
+
+	events := recorder.received()
+	if len(events) != 20 {
+		t.Fatalf("received %d events, want 20", len(events))
+	}
+	for i, event := range events {
+		if event.BlockNumber != uint64(i+1) {
+			t.Fatalf("event %d is block %d, want %d", i, event.BlockNumber, i+1)
+		}
+	}
+}
+
+func TestWebhookNotifierDropsWhenQueueIsFull(t *testing.T) {
+	release := make(chan struct{})
+	var received atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+		received.Add(1)
+	}))
+	defer server.Close()
+
+	notifier := newTestNotifier()
+	notifier.QueueSize = 2
+	if err := notifier.Register("mychannel", server.URL); err != nil {
+		t.Fatal(err)
+	}
+	// 첫 알림은 worker가 꺼내 전송 중이므로 큐에는 최대 2개까지만 남는다
+	notifier.BlockCommitted("mychannel", testBlock(1, 1))
+	time.Sleep(50 * time.Millisecond)
+	for number := uint64(2); number <= 10; number++ {
+		notifier.BlockCommitted("mychannel", testBlock(number, 1))
+	}
+	close(release)
+	notifier.Close()
+
+	if got := received.Load(); got != 3 {
+		t.Fatalf("webhook received %d notifications, want 3", got)
+	}
+}
+
+func TestWebhookNotifierUnregister(t *testing.T) {
+	notifier := newTestNotifier()
+	if err := notifier.Register("mychannel", "ftp://example.com"); err == nil {
+		t.Fatal("Register accepted a non-http URL")
+	}
+	if err := notifier.Unregister("mychannel"); err == nil {
+		t.Fatal("Unregister succeeded for an unregistered channel")
+	}
+	if err := notifier.Register("mychannel", "http://127.0.0.1:1"); err != nil {
+		t.Fatal(err)
+	}
+	if err := notifier.Unregister("mychannel"); err != nil {
+		t.Fatalf("Unregister: %v", err)
+	}
+	// 해제된 채널의 알림은 무시된다
+	notifier.BlockCommitted("mychannel", testBlock(1, 1))
+	notifier.Close()
+}
@@ -0,0 +1,114 @@
+package identity
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ddr4869/minifab/common/logger"
+	"github.com/ddr4869/minifab/common/msp"
+	"github.com/ddr4869/minifab/config"
+	"github.com/ddr4869/minifab/peer/core"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+// Cmd returns the identity command with all subcommands
+func Cmd() *cobra.Command {
+	var peerID string
+
+	identityCmd := &cobra.Command{
+		Use:   "identity",
+		Short: "peer identity 관련 작업을 수행합니다",
+		Long:  `peer signing identity를 다른 조직의 MSP 관리자와 공유하기 위한 작업을 수행합니다.`,
+	}
+
+	identityCmd.PersistentFlags().StringVar(&peerID, "id", "org1peer0", "Peer ID")
+
+	identityCmd.AddCommand(identityExportCmd(&peerID))
+	identityCmd.AddCommand(identityInfoCmd(&peerID))
+
+	return identityCmd
+}
+
+func identityExportCmd(peerID *string) *cobra.Command {
+	var outputPath string
+
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "peer의 MSP ID, 인증서, 공개키를 JSON 파일로 내보냅니다",
+		Run: func(cmd *cobra.Command, args []string) {
+			peer, err := loadPeerIdentity(*peerID)
+			if err != nil {
+				logger.Fatalf("Failed to load peer identity: %v", err)
+			}
+			if err := peer.ExportIdentity(outputPath); err != nil {
+				logger.Fatalf("Failed to export identity: %v", err)
+			}
+		},
+	}
+
+	cmd.Flags().StringVarP(&outputPath, "output", "o", "", "Output JSON file path (required)")
+	cmd.MarkFlagRequired("output")
+
+	return cmd
+}
+
+func identityInfoCmd(peerID *string) *cobra.Command {
+	var output string
+
+	cmd := &cobra.Command{
+		Use:   "info",
+		Short: "peer signing identity의 MSP ID, 인증서 CN, 만료 시각, OU, 키 종류를 표시합니다",
+		Run: func(cmd *cobra.Command, args []string) {
+			peer, err := loadPeerIdentity(*peerID)
+			if err != nil {
+				logger.Fatalf("Failed to load peer identity: %v", err)
+			}
+			if err := PrintIdentityInfo(peer.GetIdentityInfo(), output); err != nil {
+				logger.Fatalf("Failed to print identity info: %v", err)
+			}
+		},
+	}
+
+	cmd.Flags().StringVarP(&output, "output", "o", "text", "Output format (text|json)")
+
+	return cmd
+}
+
+// PrintIdentityInfo identity 정보를 text 또는 json 형식으로 출력
+func PrintIdentityInfo(info core.IdentityInfo, output string) error {
+	switch output {
+	case "json":
+		data, err := json.MarshalIndent(info, "", "  ")
+		if err != nil {
+			return errors.Wrap(err, "failed to marshal identity info")
+		}
+		fmt.Println(string(data))
+	case "text":
+		fmt.Printf("MSP ID: %s\n", info.MSPID)
+		fmt.Printf("Certificate CN: %s\n", info.CertCN)
+		fmt.Printf("Certificate expiry: %s (in %s)\n", info.CertExpiry.UTC().Format(time.RFC3339), info.TimeUntilExpiry().Round(time.Second))
+		fmt.Printf("OUs: %s\n", strings.Join(info.OUs, ", "))
+		fmt.Printf("Key type: %s\n", info.KeyType)
+		fmt.Printf("Valid: %t\n", info.IsValid)
+	default:
+		return errors.Errorf("unsupported output format: %s", output)
+	}
+	return nil
+}
+
+// loadPeerIdentity orderer 연결 없이 peer 설정과 MSP만 로드한 Peer 생성
+func loadPeerIdentity(peerID string) (*core.Peer, error) {
+	peerConfig, err := config.LoadPeerConfig(peerID)
+	if err != nil {
+		return nil, err
+	}
+	peerMSP, err := msp.LoadMSPFromFiles(peerConfig.Peer.MSPID, peerConfig.Peer.MSPPath)
+	if err != nil {
+		return nil, err
+	}
+	peerConfig.Peer.MSP = peerMSP
+	return &core.Peer{Peer: peerConfig.Peer}, nil
+}
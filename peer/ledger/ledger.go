@@ -0,0 +1,133 @@
+package ledger
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ddr4869/minifab/common/blockutil"
+	"github.com/ddr4869/minifab/common/logger"
+	"github.com/ddr4869/minifab/config"
+	"github.com/ddr4869/minifab/peer/storage"
+	"github.com/spf13/cobra"
+)
+
+// Cmd returns the ledger command with all subcommands
+func Cmd() *cobra.Command {
+	var peerID, ledgerPath string
+
+	ledgerCmd := &cobra.Command{
+		Use:   "ledger",
+		Short: "로컬 원장 관련 작업을 수행합니다",
+		Long:  `peer의 로컬 블록 저장소를 관리합니다.`,
+	}
+
+	flags := ledgerCmd.PersistentFlags()
+	flags.StringVar(&peerID, "id", "org1peer0", "Peer ID")
+	flags.StringVar(&ledgerPath, "ledger-path", "", "Block storage path (default: <FILESYSTEM_PATH>/blocks)")
+
+	ledgerCmd.AddCommand(ledgerCompactCmd(&peerID, &ledgerPath))
+	ledgerCmd.AddCommand(ledgerInfoCmd(&peerID, &ledgerPath))
+	ledgerCmd.AddCommand(ledgerTxCmd(&peerID, &ledgerPath))
+
+	return ledgerCmd
+}
+
+func ledgerCompactCmd(peerID, ledgerPath *string) *cobra.Command {
+	var channelID string
+
+	cmd := &cobra.Command{
+		Use:   "compact",
+		Short: "채널 원장에 속하지 않는 블록 파일을 삭제해 공간을 회수합니다",
+		Run: func(cmd *cobra.Command, args []string) {
+			blockStorage := openBlockStorage(*peerID, *ledgerPath)
+			if err := blockStorage.Compact(channelID); err != nil {
+				logger.Fatalf("Failed to compact channel %s: %v", channelID, err)
+			}
+			logger.Infof("✅ Compacted ledger of channel %s", channelID)
+		},
+	}
+
+	cmd.Flags().StringVarP(&channelID, "channelID", "c", "", "Channel name (required)")
+	cmd.MarkFlagRequired("channelID")
+
+	return cmd
+}
+
+func ledgerInfoCmd(peerID, ledgerPath *string) *cobra.Command {
+	var channelID string
+
+	cmd := &cobra.Command{
+		Use:   "info",
+		Short: "채널의 로컬 블록 높이, 마지막 블록 해시와 디스크 사용량을 출력합니다",
+		Run: func(cmd *cobra.Command, args []string) {
+			blockStorage := openBlockStorage(*peerID, *ledgerPath)
+
+			height := blockStorage.GetChannelHeight(channelID)
+			fmt.Printf("Height: %d\n", height)
+			if height > 0 {
+				lastBlock, err := blockStorage.GetLastBlock(channelID)
+				if err != nil {
+					logger.Fatalf("Failed to get last block of channel %s: %v", channelID, err)
+				}
+				fmt.Printf("Last block hash: %s\n", blockutil.HashBlock(lastBlock))
+				size, err := blockStorage.GetStorageSizeBytes(channelID)
+				if err != nil {
+					logger.Fatalf("Failed to get storage size of channel %s: %v", channelID, err)
+				}
+				fmt.Printf("Storage size: %d bytes\n", size)
+			}
+			total, err := blockStorage.GetTotalStorageSizeBytes()
+			if err != nil {
+				logger.Fatalf("Failed to get total storage size: %v", err)
+			}
+			fmt.Printf("Total storage size: %d bytes\n", total)
+		},
+	}
+
+	cmd.Flags().StringVarP(&channelID, "channelID", "c", "", "Channel name (required)")
+	cmd.MarkFlagRequired("channelID")
+
+	return cmd
+}
+
+func ledgerTxCmd(peerID, ledgerPath *string) *cobra.Command {
+	var channelID, txID string
+
+	cmd := &cobra.Command{
+		Use:   "tx",
+		Short: "트랜잭션 ID로 로컬 원장의 트랜잭션을 조회합니다",
+		Run: func(cmd *cobra.Command, args []string) {
+			blockStorage := openBlockStorage(*peerID, *ledgerPath)
+
+			tx, blockNumber, err := blockStorage.GetTransactionByID(channelID, txID)
+			if err != nil {
+				logger.Fatalf("Failed to get transaction %s: %v", txID, err)
+			}
+			fmt.Printf("TxID: %s\n", tx.TxId)
+			fmt.Printf("Block: %d\n", blockNumber)
+			fmt.Printf("Type: %s\n", tx.Type)
+			fmt.Printf("Timestamp: %s\n", time.Unix(tx.Timestamp, 0).UTC().Format(time.RFC3339))
+			fmt.Printf("Creator MSP: %s\n", tx.Identity.GetMspId())
+			fmt.Printf("Payload size: %d bytes\n", len(tx.Payload))
+		},
+	}
+
+	cmd.Flags().StringVarP(&channelID, "channelID", "c", "", "Channel name (required)")
+	cmd.Flags().StringVar(&txID, "txid", "", "Transaction ID (required)")
+	cmd.MarkFlagRequired("channelID")
+	cmd.MarkFlagRequired("txid")
+
+	return cmd
+}
+
+// openBlockStorage --ledger-path가 없으면 peer 설정의 저장 경로로 블록 저장소를 연다
+func openBlockStorage(peerID, ledgerPath string) *storage.BlockStorage {
+	if ledgerPath == "" {
+		peerConfig, err := config.LoadPeerConfig(peerID)
+		if err != nil {
+			logger.Fatalf("Failed to load peer config: %v", err)
+		}
+		ledgerPath = peerConfig.Peer.LedgerPath
+	}
+	return storage.NewBlockStorageWithPath(ledgerPath)
+}
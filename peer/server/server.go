@@ -0,0 +1,118 @@
+package server
+
+import (
+	"context"
+	"crypto/tls"
+	"net"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/ddr4869/minifab/common/logger"
+	"github.com/ddr4869/minifab/peer/core"
+	peersync "github.com/ddr4869/minifab/peer/sync"
+	pb_common "github.com/ddr4869/minifab/proto/common"
+	pb_peer "github.com/ddr4869/minifab/proto/peer"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+// PeerServer는 peer 노드의 gRPC 서비스(PeerService)를 제공한다.
+type PeerServer struct {
+	Peer         *core.Peer
+	Synchronizer *peersync.BlockSynchronizer
+	Server       *grpc.Server
+	options      PeerServerOptions
+	pb_peer.UnimplementedPeerServiceServer
+}
+
+// PeerServerOptions peer gRPC 서버 생성 옵션
+type PeerServerOptions struct {
+	// TLSConfig 설정되면 gRPC 서버를 TLS로 실행 (nil이면 평문)
+	TLSConfig *tls.Config
+}
+
+func NewPeerServer(peer *core.Peer) *PeerServer {
+	return NewPeerServerWithOptions(peer, PeerServerOptions{})
+}
+
+// NewPeerServerWithOptions 옵션을 적용한 peer 서버 생성
+func NewPeerServerWithOptions(peer *core.Peer, options PeerServerOptions) *PeerServer {
+	return &PeerServer{
+		Peer:         peer,
+		Synchronizer: peersync.NewBlockSynchronizer(peer, peer.OrdererClient, peer.BlockStorage),
+		options:      options,
+	}
+}
+
+// Start 블록 동기화를 시작하고 종료 시그널을 받을 때까지 gRPC 서버 실행
+func (s *PeerServer) Start(address string) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	sigChan := make(chan os.Signal, 1)
+	signal.Notify(sigChan, syscall.SIGINT, syscall.SIGTERM)
+
+	go func() {
+		<-sigChan
+		logger.Info("Received shutdown signal, stopping peer...")
+		cancel()
+	}()
+
+	return s.StartWithContext(ctx, address)
+}
+
+// StartWithContext address에서 gRPC 서버와 블록 동기화를 시작하고 ctx가 끝나면 정리 후 반환
+func (s *PeerServer) StartWithContext(ctx context.Context, address string) error {
+	lis, err := net.Listen("tcp", address)
+	if err != nil {
+		return errors.Wrap(err, "failed to listen")
+	}
+
+	logger.Infof("Peer server listening on %s", address)
+
+	var serverOpts []grpc.ServerOption
+	if s.options.TLSConfig != nil {
+		serverOpts = append(serverOpts, grpc.Creds(credentials.NewTLS(s.options.TLSConfig)))
+		logger.Info("Peer server TLS enabled")
+	}
+	s.Server = grpc.NewServer(serverOpts...)
+	pb_peer.RegisterPeerServiceServer(s.Server, s)
+
+	go func() {
+		if err := s.Server.Serve(lis); err != nil {
+			logger.Errorf("Server error: %v", err)
+		}
+	}()
+
+	if err := s.Synchronizer.StartSync(ctx, peersync.DefaultSyncConfig()); err != nil {
+		logger.Errorf("Failed to start block synchronization: %v", err)
+	}
+
+	<-ctx.Done()
+	s.Synchronizer.StopSync()
+	s.Server.GracefulStop()
+	logger.Info("Peer server stopped gracefully")
+	return nil
+}
+
+// GetSyncStatus 채널별 로컬/orderer 블록 높이와 동기화 지연(lag) 조회
+func (s *PeerServer) GetSyncStatus(ctx context.Context, req *pb_peer.SyncStatusRequest) (*pb_peer.SyncStatusResponse, error) {
+	statuses := s.Synchronizer.GetSyncStatus(req.ChannelIds...)
+
+	response := &pb_peer.SyncStatusResponse{
+		Status:   pb_common.Status_OK,
+		Channels: make([]*pb_peer.ChannelSyncStatus, 0, len(statuses)),
+	}
+	for _, status := range statuses {
+		response.Channels = append(response.Channels, &pb_peer.ChannelSyncStatus{
+			ChannelId:    status.ChannelID,
+			LocalHeight:  status.LocalHeight,
+			RemoteHeight: status.RemoteHeight,
+			Lag:          status.Lag,
+			IsSyncing:    status.IsSyncing,
+		})
+	}
+	return response, nil
+}
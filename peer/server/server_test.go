@@ -0,0 +1,80 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ddr4869/minifab/common/msp/msptest"
+	"github.com/ddr4869/minifab/config"
+	"github.com/ddr4869/minifab/orderer/orderertest"
+	"github.com/ddr4869/minifab/peer/common"
+	"github.com/ddr4869/minifab/peer/core"
+	"github.com/ddr4869/minifab/peer/storage"
+	pb_peer "github.com/ddr4869/minifab/proto/peer"
+)
+
+// newTestPeer orderer의 channelID 채널에 설정 블록으로 참여한 peer
+func newTestPeer(t *testing.T, orderer *orderertest.Server, channelID string) *core.Peer {
+	t.Helper()
+
+	org := msptest.NewOrg(t, "Org1MSP")
+	genesis := orderer.NewChannel(t, channelID, org)
+
+	client, err := common.NewOrdererClient(orderer.Address)
+	if err != nil {
+		t.Fatalf("NewOrdererClient: %v", err)
+	}
+	t.Cleanup(func() { client.Close() })
+
+	blockStorage := storage.NewBlockStorageWithPath(t.TempDir())
+	peer := &core.Peer{
+		Peer:           &config.PeerCfg{ID: "peer0", MSPID: org.MSPID, MSP: org.MSP},
+		OrdererClient:  client,
+		BlockStorage:   blockStorage,
+		ChannelManager: core.NewChannelManager(blockStorage),
+	}
+	if err := peer.ChannelManager.JoinChannelByBlock(channelID, genesis); err != nil {
+		t.Fatalf("JoinChannelByBlock: %v", err)
+	}
+	return peer
+}
+
+func TestGetSyncStatusAfterSync(t *testing.T) {
+	orderer := orderertest.NewServer(t)
+	s := NewPeerServer(newTestPeer(t, orderer, "mychannel"))
+	orderer.AppendBlocks(t, "mychannel", 10)
+
+	response, err := s.GetSyncStatus(context.Background(), &pb_peer.SyncStatusRequest{ChannelIds: []string{"mychannel"}})
+	if err != nil {
+		t.Fatalf("GetSyncStatus: %v", err)
+	}
+	if len(response.Channels) != 1 {
+		t.Fatalf("got %d channels, want 1", len(response.Channels))
+	}
+	if status := response.Channels[0]; status.LocalHeight != 1 || status.RemoteHeight != 11 || status.Lag != 10 {
+		t.Errorf("before sync: local=%d remote=%d lag=%d, want 1/11/10", status.LocalHeight, status.RemoteHeight, status.Lag)
+	}
+
+	if err := s.Synchronizer.SyncChannel(context.Background(), "mychannel", nil); err != nil {
+		t.Fatalf("SyncChannel: %v", err)
+	}
+
+	// 채널을 지정하지 않으면 참여한 모든 채널 조회
+	response, err = s.GetSyncStatus(context.Background(), &pb_peer.SyncStatusRequest{})
+	if err != nil {
+		t.Fatalf("GetSyncStatus: %v", err)
+	}
+	if len(response.Channels) != 1 {
+		t.Fatalf("got %d channels, want 1", len(response.Channels))
+	}
+	status := response.Channels[0]
+	if status.ChannelId != "mychannel" {
+		t.Errorf("channel = %q, want mychannel", status.ChannelId)
+	}
+	if status.LocalHeight != 11 || status.RemoteHeight != 11 || status.Lag != 0 {
+		t.Errorf("after sync: local=%d remote=%d lag=%d, want 11/11/0", status.LocalHeight, status.RemoteHeight, status.Lag)
+	}
+	if status.IsSyncing {
+		t.Error("IsSyncing = true after SyncChannel returned")
+	}
+}
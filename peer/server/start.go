@@ -0,0 +1,91 @@
+package server
+
+import (
+	"strings"
+
+	"github.com/ddr4869/minifab/common/logger"
+	"github.com/ddr4869/minifab/common/msp"
+	"github.com/ddr4869/minifab/peer/core"
+	"github.com/ddr4869/minifab/peer/events"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+// Cmd returns the peer node command with all subcommands
+func Cmd() *cobra.Command {
+	nodeCmd := &cobra.Command{
+		Use:   "node",
+		Short: "peer 노드를 실행합니다",
+		Long:  `peer gRPC 서버를 실행하고 참여한 채널의 블록을 orderer로부터 동기화합니다.`,
+	}
+
+	nodeCmd.AddCommand(nodeStartCmd())
+
+	return nodeCmd
+}
+
+func nodeStartCmd() *cobra.Command {
+	var peerID, mspID, mspPath, ordererAddress, ledgerPath string
+	var webhooks []string
+
+	cmd := &cobra.Command{
+		Use:   "start",
+		Short: "peer 노드를 시작합니다",
+		Run: func(cmd *cobra.Command, args []string) {
+			peer, err := core.NewPeer(peerID, mspID, mspPath, ordererAddress)
+			if err != nil {
+				logger.Fatalf("Failed to create peer: %v", err)
+			}
+			if ledgerPath != "" {
+				peer.SetLedgerPath(ledgerPath)
+			}
+			if len(webhooks) > 0 {
+				notifier, err := newWebhookNotifier(webhooks)
+				if err != nil {
+					logger.Fatalf("Failed to register block webhooks: %v", err)
+				}
+				peer.BlockStorage.RegisterCommitHook(notifier.BlockCommitted)
+				// 서버가 종료된 뒤 큐에 남은 알림을 마저 전송
+				defer notifier.Close()
+			}
+
+			var options PeerServerOptions
+			if peer.Peer.TLSEnabled {
+				tlsConfig, err := msp.BuildServerTLSConfig(peer.Peer.TLSCertFile, peer.Peer.TLSKeyFile, peer.Peer.TLSRootCertFile)
+				if err != nil {
+					logger.Fatalf("Failed to build TLS config: %v", err)
+				}
+				options.TLSConfig = tlsConfig
+			}
+
+			if err := NewPeerServerWithOptions(peer, options).Start(peer.Peer.Address); err != nil {
+				logger.Fatalf("Failed to start peer server: %v", err)
+			}
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.StringVar(&ordererAddress, "orderer", "localhost:7050", "Orderer server address")
+	flags.StringVar(&peerID, "id", "org1peer0", "Peer ID")
+	flags.StringVar(&mspID, "mspid", "Org1MSP", "MSP ID for peer")
+	flags.StringVar(&mspPath, "mspdir", "/Users/mac/go/src/github.com/ddr4869/minifab/ca/Org1/ca-client/admin", "Path to MSP directory with certificates")
+	flags.StringVar(&ledgerPath, "ledger-path", "", "Block storage path (default: <FILESYSTEM_PATH>/blocks)")
+	flags.StringArrayVar(&webhooks, "block-webhook", nil, "Notify <channel>=<url> with a POST when a block is committed; repeat for each channel")
+
+	return cmd
+}
+
+// newWebhookNotifier --block-webhook의 <channel>=<url> 항목들을 등록한 WebhookNotifier 생성
+func newWebhookNotifier(webhooks []string) (*events.WebhookNotifier, error) {
+	notifier := events.NewWebhookNotifier()
+	for _, webhook := range webhooks {
+		channelID, webhookURL, found := strings.Cut(webhook, "=")
+		if !found {
+			return nil, errors.Errorf("invalid block webhook %q (expected <channel>=<url>)", webhook)
+		}
+		if err := notifier.Register(channelID, webhookURL); err != nil {
+			return nil, err
+		}
+	}
+	return notifier, nil
+}
@@ -0,0 +1,134 @@
+package server
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ddr4869/minifab/common/msp"
+	"github.com/ddr4869/minifab/common/msp/msptest"
+	"github.com/ddr4869/minifab/orderer/orderertest"
+	pb_peer "github.com/ddr4869/minifab/proto/peer"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+)
+
+// startTLSPeerServer tlsOrg CA가 발급한 인증서로 mutual TLS를 사용하는 peer 서버를 임의 포트에서 시작
+func startTLSPeerServer(t *testing.T, tlsOrg *msptest.Org) string {
+	t.Helper()
+
+	dir := t.TempDir()
+	serverCert, serverKey := tlsOrg.IssueTLS(t, "peer0", "127.0.0.1")
+	files := map[string][]byte{
+		"server.crt": msptest.CertPEM(serverCert),
+		"server.key": msptest.KeyPEM(t, serverKey),
+		"ca.crt":     tlsOrg.CACertPEM(),
+	}
+	for name, data := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), data, 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	tlsConfig, err := msp.BuildServerTLSConfig(filepath.Join(dir, "server.crt"), filepath.Join(dir, "server.key"), filepath.Join(dir, "ca.crt"))
+	if err != nil {
+		t.Fatalf("BuildServerTLSConfig: %v", err)
+	}
+
+	orderer := orderertest.NewServer(t)
+	s := NewPeerServerWithOptions(newTestPeer(t, orderer, "mychannel"), PeerServerOptions{TLSConfig: tlsConfig})
+
+	// 사용 가능한 포트를 얻은 뒤 닫고 그 주소로 서버 시작
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	address := lis.Addr().String()
+	lis.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() { done <- s.StartWithContext(ctx, address) }()
+	t.Cleanup(func() {
+		cancel()
+		if err := <-done; err != nil {
+			t.Errorf("StartWithContext: %v", err)
+		}
+	})
+
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		conn, err := net.Dial("tcp", address)
+		if err == nil {
+			conn.Close()
+			return address
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("peer did not start on %s: %v", address, err)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+// callGetSyncStatus creds로 address에 연결해 GetSyncStatus 호출
+func callGetSyncStatus(t *testing.T, address string, creds credentials.TransportCredentials) error {
+	t.Helper()
+
+	conn, err := grpc.NewClient(address, grpc.WithTransportCredentials(creds))
+	if err != nil {
+		t.Fatalf("grpc.NewClient: %v", err)
+	}
+	defer conn.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	_, err = pb_peer.NewPeerServiceClient(conn).GetSyncStatus(ctx, &pb_peer.SyncStatusRequest{})
+	return err
+}
+
+func TestPeerServerRequiresMutualTLS(t *testing.T) {
+	tlsOrg := msptest.NewOrg(t, "Org1MSP")
+	address := startTLSPeerServer(t, tlsOrg)
+
+	rootCAs := x509.NewCertPool()
+	rootCAs.AddCert(tlsOrg.CACert)
+
+	t.Run("plaintext", func(t *testing.T) {
+		err := callGetSyncStatus(t, address, insecure.NewCredentials())
+		if status.Code(err) != codes.Unavailable {
+			t.Fatalf("plaintext call error = %v, want Unavailable", err)
+		}
+	})
+
+	t.Run("TLS without client certificate", func(t *testing.T) {
+		err := callGetSyncStatus(t, address, credentials.NewTLS(&tls.Config{RootCAs: rootCAs}))
+		if status.Code(err) != codes.Unavailable || !strings.Contains(err.Error(), "tls") {
+			t.Fatalf("call without client certificate error = %v, want Unavailable TLS error", err)
+		}
+	})
+
+	t.Run("client certificate from other CA", func(t *testing.T) {
+		otherCert, otherKey := msptest.NewOrg(t, "Org2MSP").IssueTLS(t, "client")
+		clientCert := tls.Certificate{Certificate: [][]byte{otherCert.Raw}, PrivateKey: otherKey}
+		err := callGetSyncStatus(t, address, credentials.NewTLS(&tls.Config{RootCAs: rootCAs, Certificates: []tls.Certificate{clientCert}}))
+		if status.Code(err) != codes.Unavailable || !strings.Contains(err.Error(), "tls") {
+			t.Fatalf("call with untrusted client certificate error = %v, want Unavailable TLS error", err)
+		}
+	})
+
+	t.Run("client certificate from trusted CA", func(t *testing.T) {
+		cert, key := tlsOrg.IssueTLS(t, "client")
+		clientCert := tls.Certificate{Certificate: [][]byte{cert.Raw}, PrivateKey: key}
+		if err := callGetSyncStatus(t, address, credentials.NewTLS(&tls.Config{RootCAs: rootCAs, Certificates: []tls.Certificate{clientCert}})); err != nil {
+			t.Fatalf("mutual TLS call: %v", err)
+		}
+	})
+}
@@ -1,398 +1,476 @@
 package storage
 
-// import (
-// 	"crypto/sha256"
-// 	"encoding/json"
-// 	"fmt"
-// 	"os"
-// 	"path/filepath"
-// 	"sync"
-// 	"time"
-
-// 	"github.com/ddr4869/minifab/common/logger"
-// 	"github.com/ddr4869/minifab/common/types"
-// 	"github.com/pkg/errors"
-// )
-
-// // BlockStorage handles persistent storage of blocks with atomic writes
-// type BlockStorage struct {
-// 	mutex       sync.RWMutex
-// 	storagePath string
-// 	// In-memory cache for quick access
-// 	channelHeights  map[string]uint64
-// 	lastBlockHash   map[string][]byte
-// 	committedBlocks map[string]map[uint64]bool
-// }
-
-// // StoredBlock represents a block stored on disk
-// type StoredBlock struct {
-// 	Block       *types.Block `json:"block"`
-// 	ChannelID   string       `json:"channel_id"`
-// 	StoredAt    time.Time    `json:"stored_at"`
-// 	IsCommitted bool         `json:"is_committed"`
-// 	BlockHash   []byte       `json:"block_hash"`
-// }
-
-// // NewBlockStorage creates a new block storage instance
-// func NewBlockStorage() *BlockStorage {
-// 	storagePath := "./blocks" // Default storage path
-
-// 	// Create storage directory if it doesn't exist
-// 	if err := os.MkdirAll(storagePath, 0755); err != nil {
-// 		logger.Errorf("Failed to create storage directory: %v", err)
-// 	}
-
-// 	storage := &BlockStorage{
-// 		storagePath:     storagePath,
-// 		channelHeights:  make(map[string]uint64),
-// 		lastBlockHash:   make(map[string][]byte),
-// 		committedBlocks: make(map[string]map[uint64]bool),
-// 	}
-
-// 	// Initialize storage by loading existing blocks
-// 	if err := storage.initialize(); err != nil {
-// 		logger.Errorf("Failed to initialize block storage: %v", err)
-// 	}
-
-// 	return storage
-// }
-
-// // initialize loads existing blocks from disk to rebuild in-memory state
-// func (bs *BlockStorage) initialize() error {
-// 	logger.Info("Initializing block storage...")
-
-// 	// Walk through storage directory to find existing blocks
-// 	return filepath.Walk(bs.storagePath, func(path string, info os.FileInfo, err error) error {
-// 		if err != nil {
-// 			return err
-// 		}
-
-// 		// Skip directories and non-JSON files
-// 		if info.IsDir() || filepath.Ext(path) != ".json" {
-// 			return nil
-// 		}
-
-// 		// Load block from file
-// 		storedBlock, err := bs.loadBlockFromFile(path)
-// 		if err != nil {
-// 			logger.Warnf("Failed to load block from %s: %v", path, err)
-// 			return nil // Continue with other files
-// 		}
-
-// 		// Update in-memory state
-// 		channelID := storedBlock.ChannelID
-// 		blockNumber := storedBlock.Block.Number
-
-// 		// Update channel height
-// 		if currentHeight, exists := bs.channelHeights[channelID]; !exists || blockNumber >= currentHeight {
-// 			bs.channelHeights[channelID] = blockNumber + 1
-// 			bs.lastBlockHash[channelID] = storedBlock.BlockHash
-// 		}
-
-// 		// Track committed blocks
-// 		if bs.committedBlocks[channelID] == nil {
-// 			bs.committedBlocks[channelID] = make(map[uint64]bool)
-// 		}
-// 		bs.committedBlocks[channelID][blockNumber] = storedBlock.IsCommitted
-
-// 		return nil
-// 	})
-// }
-
-// // StoreBlock stores a block atomically to disk
-// func (bs *BlockStorage) StoreBlock(channelID string, block *types.Block) error {
-// 	if channelID == "" {
-// 		return errors.New("channel ID cannot be empty")
-// 	}
-// 	if block == nil {
-// 		return errors.New("block cannot be nil")
-// 	}
-
-// 	bs.mutex.Lock()
-// 	defer bs.mutex.Unlock()
-
-// 	logger.Debugf("Storing block %d for channel %s", block.Number, channelID)
-
-// 	// Calculate block hash
-// 	blockHash := bs.calculateBlockHash(block)
-
-// 	// Create stored block structure
-// 	storedBlock := &StoredBlock{
-// 		Block:       block,
-// 		ChannelID:   channelID,
-// 		StoredAt:    time.Now(),
-// 		IsCommitted: false,
-// 		BlockHash:   blockHash,
-// 	}
-
-// 	// Create channel directory if it doesn't exist
-// 	channelDir := filepath.Join(bs.storagePath, channelID)
-// 	if err := os.MkdirAll(channelDir, 0755); err != nil {
-// 		return errors.Wrap(err, "failed to create channel directory")
-// 	}
-
-// 	// Generate file path
-// 	fileName := fmt.Sprintf("block_%d.json", block.Number)
-// 	filePath := filepath.Join(channelDir, fileName)
-// 	tempPath := filePath + ".tmp"
-
-// 	// Serialize block to JSON
-// 	data, err := json.MarshalIndent(storedBlock, "", "  ")
-// 	if err != nil {
-// 		return errors.Wrap(err, "failed to marshal block")
-// 	}
-
-// 	// Write to temporary file first (atomic write)
-// 	if err := os.WriteFile(tempPath, data, 0644); err != nil {
-// 		return errors.Wrap(err, "failed to write temporary block file")
-// 	}
-
-// 	// Atomically rename temporary file to final file
-// 	if err := os.Rename(tempPath, filePath); err != nil {
-// 		// Clean up temporary file on failure
-// 		os.Remove(tempPath)
-// 		return errors.Wrap(err, "failed to rename temporary block file")
-// 	}
-
-// 	// Update in-memory state
-// 	bs.channelHeights[channelID] = block.Number + 1
-// 	bs.lastBlockHash[channelID] = blockHash
-
-// 	if bs.committedBlocks[channelID] == nil {
-// 		bs.committedBlocks[channelID] = make(map[uint64]bool)
-// 	}
-// 	bs.committedBlocks[channelID][block.Number] = false
-
-// 	logger.Debugf("Successfully stored block %d for channel %s", block.Number, channelID)
-// 	return nil
-// }
-
-// // GetBlock retrieves a specific block from storage
-// func (bs *BlockStorage) GetBlock(channelID string, blockNumber uint64) (*types.Block, error) {
-// 	if channelID == "" {
-// 		return nil, errors.New("channel ID cannot be empty")
-// 	}
-
-// 	bs.mutex.RLock()
-// 	defer bs.mutex.RUnlock()
-
-// 	fileName := fmt.Sprintf("block_%d.json", blockNumber)
-// 	filePath := filepath.Join(bs.storagePath, channelID, fileName)
-
-// 	storedBlock, err := bs.loadBlockFromFile(filePath)
-// 	if err != nil {
-// 		return nil, errors.Wrapf(err, "failed to load block %d", blockNumber)
-// 	}
-
-// 	return storedBlock.Block, nil
-// }
-
-// // GetBlockRange retrieves a range of blocks from storage
-// func (bs *BlockStorage) GetBlockRange(channelID string, startBlock, endBlock uint64) ([]*types.Block, error) {
-// 	if channelID == "" {
-// 		return nil, errors.New("channel ID cannot be empty")
-// 	}
-
-// 	bs.mutex.RLock()
-// 	defer bs.mutex.RUnlock()
-
-// 	var blocks []*types.Block
-
-// 	// Determine actual end block
-// 	channelHeight := bs.channelHeights[channelID]
-// 	if endBlock == 0 || endBlock > channelHeight {
-// 		endBlock = channelHeight
-// 	}
-
-// 	// Load blocks in range
-// 	for blockNum := startBlock; blockNum < endBlock; blockNum++ {
-// 		block, err := bs.getBlockUnsafe(channelID, blockNum)
-// 		if err != nil {
-// 			logger.Warnf("Failed to load block %d: %v", blockNum, err)
-// 			continue
-// 		}
-// 		blocks = append(blocks, block)
-// 	}
-
-// 	return blocks, nil
-// }
-
-// // getBlockUnsafe retrieves a block without locking (internal use)
-// func (bs *BlockStorage) getBlockUnsafe(channelID string, blockNumber uint64) (*types.Block, error) {
-// 	fileName := fmt.Sprintf("block_%d.json", blockNumber)
-// 	filePath := filepath.Join(bs.storagePath, channelID, fileName)
-
-// 	storedBlock, err := bs.loadBlockFromFile(filePath)
-// 	if err != nil {
-// 		return nil, err
-// 	}
-
-// 	return storedBlock.Block, nil
-// }
-
-// // GetChannelHeight returns the current height of a channel
-// func (bs *BlockStorage) GetChannelHeight(channelID string) uint64 {
-// 	bs.mutex.RLock()
-// 	defer bs.mutex.RUnlock()
-
-// 	return bs.channelHeights[channelID]
-// }
-
-// // GetLastBlockHash returns the hash of the last block in a channel
-// func (bs *BlockStorage) GetLastBlockHash(channelID string) []byte {
-// 	bs.mutex.RLock()
-// 	defer bs.mutex.RUnlock()
-
-// 	return bs.lastBlockHash[channelID]
-// }
-
-// // MarkBlockCommitted marks a block as committed
-// func (bs *BlockStorage) MarkBlockCommitted(channelID string, blockNumber uint64) error {
-// 	if channelID == "" {
-// 		return errors.New("channel ID cannot be empty")
-// 	}
-
-// 	bs.mutex.Lock()
-// 	defer bs.mutex.Unlock()
-
-// 	// Update in-memory state
-// 	if bs.committedBlocks[channelID] == nil {
-// 		bs.committedBlocks[channelID] = make(map[uint64]bool)
-// 	}
-// 	bs.committedBlocks[channelID][blockNumber] = true
-
-// 	// Update file on disk
-// 	fileName := fmt.Sprintf("block_%d.json", blockNumber)
-// 	filePath := filepath.Join(bs.storagePath, channelID, fileName)
-
-// 	storedBlock, err := bs.loadBlockFromFile(filePath)
-// 	if err != nil {
-// 		return errors.Wrapf(err, "failed to load block %d for commit update", blockNumber)
-// 	}
-
-// 	storedBlock.IsCommitted = true
-
-// 	// Write updated block back to disk
-// 	data, err := json.MarshalIndent(storedBlock, "", "  ")
-// 	if err != nil {
-// 		return errors.Wrap(err, "failed to marshal updated block")
-// 	}
-
-// 	tempPath := filePath + ".tmp"
-// 	if err := os.WriteFile(tempPath, data, 0644); err != nil {
-// 		return errors.Wrap(err, "failed to write updated block file")
-// 	}
-
-// 	if err := os.Rename(tempPath, filePath); err != nil {
-// 		os.Remove(tempPath)
-// 		return errors.Wrap(err, "failed to update block file")
-// 	}
-
-// 	logger.Debugf("Marked block %d as committed for channel %s", blockNumber, channelID)
-// 	return nil
-// }
-
-// // IsBlockCommitted checks if a block is committed
-// func (bs *BlockStorage) IsBlockCommitted(channelID string, blockNumber uint64) bool {
-// 	bs.mutex.RLock()
-// 	defer bs.mutex.RUnlock()
-
-// 	if channelBlocks, exists := bs.committedBlocks[channelID]; exists {
-// 		return channelBlocks[blockNumber]
-// 	}
-// 	return false
-// }
-
-// // loadBlockFromFile loads a stored block from a file
-// func (bs *BlockStorage) loadBlockFromFile(filePath string) (*StoredBlock, error) {
-// 	data, err := os.ReadFile(filePath)
-// 	if err != nil {
-// 		return nil, errors.Wrap(err, "failed to read block file")
-// 	}
-
-// 	var storedBlock StoredBlock
-// 	if err := json.Unmarshal(data, &storedBlock); err != nil {
-// 		return nil, errors.Wrap(err, "failed to unmarshal block")
-// 	}
-
-// 	return &storedBlock, nil
-// }
-
-// // calculateBlockHash calculates the hash of a block
-// func (bs *BlockStorage) calculateBlockHash(block *types.Block) []byte {
-// 	if block == nil {
-// 		return nil
-// 	}
-
-// 	// Create a deterministic representation of the block for hashing
-// 	blockData := fmt.Sprintf("%d:%x:%s",
-// 		block.Number,
-// 		block.PreviousHash,
-// 		block.Timestamp.Format(time.RFC3339Nano))
-
-// 	// Add block data if present
-// 	if len(block.Data) > 0 {
-// 		blockData += ":" + string(block.Data)
-// 	}
-
-// 	// Use SHA256 for hashing (consistent with Fabric)
-// 	hash := sha256.Sum256([]byte(blockData))
-// 	return hash[:]
-// }
-
-// // GetStorageStats returns storage statistics
-// func (bs *BlockStorage) GetStorageStats() map[string]any {
-// 	bs.mutex.RLock()
-// 	defer bs.mutex.RUnlock()
-
-// 	stats := make(map[string]any)
-// 	stats["storage_path"] = bs.storagePath
-// 	stats["channels"] = len(bs.channelHeights)
-
-// 	channelStats := make(map[string]any)
-// 	for channelID, height := range bs.channelHeights {
-// 		committedCount := 0
-// 		if channelBlocks, exists := bs.committedBlocks[channelID]; exists {
-// 			for _, committed := range channelBlocks {
-// 				if committed {
-// 					committedCount++
-// 				}
-// 			}
-// 		}
-
-// 		channelStats[channelID] = map[string]any{
-// 			"height":           height,
-// 			"committed_blocks": committedCount,
-// 			"total_blocks":     height,
-// 		}
-// 	}
-// 	stats["channel_stats"] = channelStats
-
-// 	return stats
-// }
-
-// // Cleanup removes old block files (for maintenance)
-// func (bs *BlockStorage) Cleanup(channelID string, keepBlocks uint64) error {
-// 	bs.mutex.Lock()
-// 	defer bs.mutex.Unlock()
-
-// 	channelHeight := bs.channelHeights[channelID]
-// 	if channelHeight <= keepBlocks {
-// 		return nil // Nothing to cleanup
-// 	}
-
-// 	channelDir := filepath.Join(bs.storagePath, channelID)
-
-// 	// Remove old block files
-// 	for blockNum := uint64(0); blockNum < channelHeight-keepBlocks; blockNum++ {
-// 		fileName := fmt.Sprintf("block_%d.json", blockNum)
-// 		filePath := filepath.Join(channelDir, fileName)
-
-// 		if err := os.Remove(filePath); err != nil && !os.IsNotExist(err) {
-// 			logger.Warnf("Failed to remove old block file %s: %v", filePath, err)
-// 		}
-// 	}
-
-// 	logger.Infof("Cleaned up old blocks for channel %s, kept last %d blocks", channelID, keepBlocks)
-// 	return nil
-// }
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/ddr4869/minifab/common/blockutil"
+	"github.com/ddr4869/minifab/common/configtx"
+	"github.com/ddr4869/minifab/common/logger"
+	pb_common "github.com/ddr4869/minifab/proto/common"
+	"github.com/pkg/errors"
+)
+
+// DefaultStoragePath StoragePath가 지정되지 않았을 때 사용하는 기본 경로
+const DefaultStoragePath = "./blocks"
+
+// indexFileName 비순차 naming 전략에서 블록 번호 순서대로 파일 이름을 기록하는 파일
+const indexFileName = blockutil.BlockIndexFileName
+
+// BlockStorageOptions BlockStorage 생성 옵션
+type BlockStorageOptions struct {
+	// StoragePath 블록 파일이 저장될 기본 경로 (<StoragePath>/<channel>/<NamingStrategy가 정한 파일 이름>)
+	StoragePath string
+	// NamingStrategy 블록 파일 이름 결정 방식 (기본: SequentialNaming)
+	NamingStrategy BlockFileNamingStrategy
+}
+
+// BlockStorage handles persistent storage of blocks
+// 블록은 채널별 폴더에 NamingStrategy가 정한 이름으로 저장된다 (기본: 0을 채운 SequentialNaming)
+type BlockStorage struct {
+	mutex       sync.RWMutex
+	storagePath string
+	naming      BlockFileNamingStrategy
+	txIndex     *txIndex
+	commitHooks []func(channelID string, block *pb_common.Block)
+}
+
+// NewBlockStorage creates a new block storage instance
+func NewBlockStorage(options BlockStorageOptions) *BlockStorage {
+	storagePath := options.StoragePath
+	if storagePath == "" {
+		storagePath = DefaultStoragePath
+	}
+
+	// Create storage directory if it doesn't exist
+	if err := os.MkdirAll(storagePath, 0755); err != nil {
+		logger.Errorf("Failed to create storage directory: %v", err)
+	}
+
+	naming := options.NamingStrategy
+	if naming == nil {
+		naming = SequentialNaming{}
+	}
+
+	return &BlockStorage{
+		storagePath: storagePath,
+		naming:      naming,
+		txIndex:     newTxIndex(),
+	}
+}
+
+// NewBlockStorageWithPath 기본 naming 전략으로 path 아래에 블록을 저장하는 BlockStorage 생성
+func NewBlockStorageWithPath(path string) *BlockStorage {
+	return NewBlockStorage(BlockStorageOptions{StoragePath: path})
+}
+
+// StoragePath 블록 저장 기본 경로 반환
+func (bs *BlockStorage) StoragePath() string {
+	return bs.storagePath
+}
+
+// RegisterCommitHook 블록이 저장될 때마다 호출될 hook 등록
+// hook은 lock 밖에서 저장 순서대로 호출되므로 BlockStorage 메서드를 사용해도 된다.
+func (bs *BlockStorage) RegisterCommitHook(fn func(channelID string, block *pb_common.Block)) {
+	bs.mutex.Lock()
+	defer bs.mutex.Unlock()
+
+	bs.commitHooks = append(bs.commitHooks, fn)
+}
+
+// StoreBlock 블록을 채널의 다음 블록 파일로 저장하고 commit hook 호출
+// 블록 번호가 현재 채널 높이와 다르면 저장하지 않는다.
+func (bs *BlockStorage) StoreBlock(channelID string, block *pb_common.Block) error {
+	if err := bs.storeBlock(channelID, block); err != nil {
+		return err
+	}
+
+	bs.mutex.RLock()
+	hooks := bs.commitHooks
+	bs.mutex.RUnlock()
+	for _, hook := range hooks {
+		hook(channelID, block)
+	}
+	return nil
+}
+
+func (bs *BlockStorage) storeBlock(channelID string, block *pb_common.Block) error {
+	if channelID == "" {
+		return errors.New("channel ID cannot be empty")
+	}
+	if block == nil || block.Header == nil {
+		return errors.New("block cannot be nil")
+	}
+
+	bs.mutex.Lock()
+	defer bs.mutex.Unlock()
+
+	height := bs.height(channelID)
+	if block.Header.Number != height {
+		return errors.Errorf("unexpected block number %d for channel %s (height: %d)", block.Header.Number, channelID, height)
+	}
+
+	channelDir := filepath.Join(bs.storagePath, channelID)
+	if err := os.MkdirAll(channelDir, 0755); err != nil {
+		return errors.Wrapf(err, "failed to create directory: %s", channelDir)
+	}
+	fileName := bs.naming.FileName(block.Header.Number, block)
+	blockFilePath := filepath.Join(channelDir, fileName)
+	if !bs.naming.Sequential() {
+		// content-addressed 이름이 겹치면 기존 블록을 덮어쓰지 않음
+		if _, err := os.Stat(blockFilePath); err == nil {
+			return errors.Errorf("block file %s already exists in channel %s", fileName, channelID)
+		}
+	}
+
+	blockData, err := blockutil.MarshalBlockToProto(block)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal block to proto")
+	}
+	if err := os.WriteFile(blockFilePath, blockData, 0644); err != nil {
+		return errors.Wrapf(err, "failed to write block file: %s", blockFilePath)
+	}
+	if !bs.naming.Sequential() {
+		if err := bs.appendIndex(channelID, fileName); err != nil {
+			return err
+		}
+	}
+	logger.Infof("✅ Block %d saved successfully at %s", block.Header.Number, blockFilePath)
+	return nil
+}
+
+// GetBlock retrieves a specific block from storage
+func (bs *BlockStorage) GetBlock(channelID string, blockNumber uint64) (*pb_common.Block, error) {
+	if channelID == "" {
+		return nil, errors.New("channel ID cannot be empty")
+	}
+
+	bs.mutex.RLock()
+	defer bs.mutex.RUnlock()
+
+	blockFilePath, err := bs.blockFilePath(channelID, blockNumber)
+	if err != nil {
+		return nil, err
+	}
+	block, err := blockutil.LoadBlock(blockFilePath)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to load block %d", blockNumber)
+	}
+	return block, nil
+}
+
+// GetChannelHeight returns the current height of a channel
+func (bs *BlockStorage) GetChannelHeight(channelID string) uint64 {
+	bs.mutex.RLock()
+	defer bs.mutex.RUnlock()
+
+	return bs.height(channelID)
+}
+
+// GetLastBlock 채널에 마지막으로 저장된 블록 조회
+func (bs *BlockStorage) GetLastBlock(channelID string) (*pb_common.Block, error) {
+	height := bs.GetChannelHeight(channelID)
+	if height == 0 {
+		return nil, errors.Errorf("channel %s has no blocks", channelID)
+	}
+	return bs.GetBlock(channelID, height-1)
+}
+
+// GetLastBlockHash 채널에 마지막으로 저장된 블록의 해시(CalculateBlockHash) 조회
+func (bs *BlockStorage) GetLastBlockHash(channelID string) ([]byte, error) {
+	block, err := bs.GetLastBlock(channelID)
+	if err != nil {
+		return nil, err
+	}
+	return blockutil.CalculateBlockHash(block), nil
+}
+
+// RemoveChannel 채널의 모든 블록 파일 삭제
+func (bs *BlockStorage) RemoveChannel(channelID string) error {
+	if channelID == "" {
+		return errors.New("channel ID cannot be empty")
+	}
+
+	bs.mutex.Lock()
+	channelDir := filepath.Join(bs.storagePath, channelID)
+	err := os.RemoveAll(channelDir)
+	bs.mutex.Unlock()
+	if err != nil {
+		return errors.Wrapf(err, "failed to remove channel directory: %s", channelDir)
+	}
+
+	// txIndex lock은 블록 조회 중 bs.mutex를 잡으므로 bs.mutex를 놓은 뒤 인덱스를 지운다
+	bs.txIndex.remove(channelID)
+	return nil
+}
+
+// Compact 채널 폴더에서 원장에 속하지 않는 블록 파일을 삭제해 공간 회수
+// 순차 naming은 높이 이후에 남은 블록 파일(중간 블록이 삭제되어 더 이상 읽을 수 없는 블록)과
+// 0을 채운 파일이 있는 번호의 이전 형식 파일을, 비순차 naming은 인덱스에 없는 블록 파일을 삭제한다.
+// 중단된 메타데이터 저장이 남긴 임시 파일도 삭제하며, 블록 저장과 겹치지 않도록 write lock을 잡는다.
+func (bs *BlockStorage) Compact(channelID string) error {
+	if channelID == "" {
+		return errors.New("channel ID cannot be empty")
+	}
+
+	bs.mutex.Lock()
+	defer bs.mutex.Unlock()
+
+	channelDir := filepath.Join(bs.storagePath, channelID)
+	entries, err := os.ReadDir(channelDir)
+	if err != nil {
+		return errors.Wrapf(err, "failed to read channel directory: %s", channelDir)
+	}
+	live, err := bs.liveBlockFiles(channelID)
+	if err != nil {
+		return err
+	}
+
+	var removed int
+	var reclaimed int64
+	for _, entry := range entries {
+		name := entry.Name()
+		if !entry.Type().IsRegular() || live[name] || !bs.isCompactable(name) {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil {
+			return errors.Wrapf(err, "failed to stat %s", name)
+		}
+		if err := os.Remove(filepath.Join(channelDir, name)); err != nil {
+			return errors.Wrapf(err, "failed to remove %s", name)
+		}
+		removed++
+		reclaimed += info.Size()
+	}
+	logger.Infof("[Peer] Compacted channel %s: removed %d file(s), reclaimed %d bytes", channelID, removed, reclaimed)
+	return nil
+}
+
+// liveBlockFiles 원장에 속한 블록 파일 이름 집합 (lock을 잡은 상태에서 호출되어야 함)
+func (bs *BlockStorage) liveBlockFiles(channelID string) (map[string]bool, error) {
+	live := make(map[string]bool)
+	if !bs.naming.Sequential() {
+		fileNames, err := bs.readIndex(channelID)
+		if err != nil {
+			return nil, err
+		}
+		for _, fileName := range fileNames {
+			live[fileName] = true
+		}
+		return live, nil
+	}
+	for blockNumber := uint64(0); ; blockNumber++ {
+		blockFilePath, exists := bs.sequentialBlockFile(channelID, blockNumber)
+		if !exists {
+			return live, nil
+		}
+		live[filepath.Base(blockFilePath)] = true
+	}
+}
+
+// isCompactable Compact가 삭제할 수 있는 형식의 파일 이름인지 여부
+// 블록 파일과 임시 파일만 대상이며, 인덱스와 채널 메타데이터 등 다른 파일은 건드리지 않는다.
+func (bs *BlockStorage) isCompactable(name string) bool {
+	if strings.HasSuffix(name, ".tmp") {
+		return true
+	}
+	if bs.naming.Sequential() {
+		return strings.HasPrefix(name, "blockfile")
+	}
+	_, err := hex.DecodeString(name)
+	return err == nil && len(name) == 2*sha256.Size
+}
+
+// ListChannels 저장소에 블록 폴더가 있는 채널 ID를 오름차순으로 정렬해 반환
+func (bs *BlockStorage) ListChannels() ([]string, error) {
+	bs.mutex.RLock()
+	defer bs.mutex.RUnlock()
+
+	entries, err := os.ReadDir(bs.storagePath)
+	if os.IsNotExist(err) {
+		return []string{}, nil
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read storage directory: %s", bs.storagePath)
+	}
+
+	channelIDs := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		if entry.IsDir() {
+			channelIDs = append(channelIDs, entry.Name())
+		}
+	}
+	sort.Strings(channelIDs)
+	return channelIDs, nil
+}
+
+// GetStorageSizeBytes 채널 폴더의 모든 파일(블록 파일, blockindex, 채널 메타데이터) 크기 합계
+func (bs *BlockStorage) GetStorageSizeBytes(channelID string) (int64, error) {
+	bs.mutex.RLock()
+	defer bs.mutex.RUnlock()
+
+	return bs.channelSizeBytes(channelID)
+}
+
+// GetTotalStorageSizeBytes 저장소의 모든 채널 폴더 크기 합계
+func (bs *BlockStorage) GetTotalStorageSizeBytes() (int64, error) {
+	channelIDs, err := bs.ListChannels()
+	if err != nil {
+		return 0, err
+	}
+
+	bs.mutex.RLock()
+	defer bs.mutex.RUnlock()
+
+	var total int64
+	for _, channelID := range channelIDs {
+		size, err := bs.channelSizeBytes(channelID)
+		if err != nil {
+			return 0, err
+		}
+		total += size
+	}
+	return total, nil
+}
+
+func (bs *BlockStorage) channelSizeBytes(channelID string) (int64, error) {
+	channelDir := filepath.Join(bs.storagePath, channelID)
+	if _, err := os.Stat(channelDir); err != nil {
+		return 0, errors.Wrapf(err, "channel %s not found in storage", channelID)
+	}
+
+	var size int64
+	err := filepath.Walk(channelDir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.Mode().IsRegular() {
+			size += info.Size()
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to measure storage of channel %s", channelID)
+	}
+	return size, nil
+}
+
+// HasChannel 저장소에 채널 블록 폴더가 있는지 확인
+func (bs *BlockStorage) HasChannel(channelID string) bool {
+	bs.mutex.RLock()
+	defer bs.mutex.RUnlock()
+
+	stat, err := os.Stat(filepath.Join(bs.storagePath, channelID))
+	return err == nil && stat.IsDir()
+}
+
+// LoadChannelConfigs 저장된 채널마다 0번 설정 블록에서 채널 설정을 읽어 반환
+// 설정 블록을 읽을 수 없는 채널은 건너뛴다.
+func (bs *BlockStorage) LoadChannelConfigs() (map[string]*configtx.ChannelConfig, error) {
+	channelIDs, err := bs.ListChannels()
+	if err != nil {
+		return nil, err
+	}
+
+	channelConfigs := make(map[string]*configtx.ChannelConfig)
+	for _, channelID := range channelIDs {
+		configBlock, err := bs.GetBlock(channelID, 0)
+		if err != nil {
+			logger.Warnf("Failed to load config block of channel %s: %v", channelID, err)
+			continue
+		}
+		channelConfig, err := blockutil.ExtractChannelConfigFromBlock(configBlock)
+		if err != nil {
+			logger.Warnf("Failed to load channel config data for %s: %v", channelID, err)
+			continue
+		}
+		channelConfigs[channelID] = channelConfig
+		logger.Infof("✅ Loaded app channel config for: %s", channelID)
+	}
+	return channelConfigs, nil
+}
+
+// height lock을 잡은 상태에서 호출되어야 함
+func (bs *BlockStorage) height(channelID string) uint64 {
+	if bs.naming.Sequential() {
+		var height uint64
+		for {
+			if _, exists := bs.sequentialBlockFile(channelID, height); !exists {
+				return height
+			}
+			height++
+		}
+	}
+	fileNames, err := bs.readIndex(channelID)
+	if err != nil {
+		logger.Errorf("Failed to read block index of channel %s: %v", channelID, err)
+		return 0
+	}
+	return uint64(len(fileNames))
+}
+
+func (bs *BlockStorage) blockFilePath(channelID string, blockNumber uint64) (string, error) {
+	if bs.naming.Sequential() {
+		blockFilePath, _ := bs.sequentialBlockFile(channelID, blockNumber)
+		return blockFilePath, nil
+	}
+	fileNames, err := bs.readIndex(channelID)
+	if err != nil {
+		return "", err
+	}
+	if blockNumber >= uint64(len(fileNames)) {
+		return "", errors.Errorf("block %d not found in channel %s", blockNumber, channelID)
+	}
+	return filepath.Join(bs.storagePath, channelID, fileNames[blockNumber]), nil
+}
+
+// sequentialBlockFile 순차 naming에서 blockNumber 블록의 파일 경로와 그 파일이 있는지 여부
+// SequentialNaming은 0을 채운 이름이 없으면 이전 형식(blockfileN)의 파일을 찾고,
+// 둘 다 없으면 새로 저장할 경로(0을 채운 이름)를 반환한다.
+func (bs *BlockStorage) sequentialBlockFile(channelID string, blockNumber uint64) (string, bool) {
+	channelDir := filepath.Join(bs.storagePath, channelID)
+	blockFilePath := filepath.Join(channelDir, bs.naming.FileName(blockNumber, nil))
+	if _, err := os.Stat(blockFilePath); err == nil {
+		return blockFilePath, true
+	}
+	if _, ok := bs.naming.(SequentialNaming); ok {
+		legacyPath := filepath.Join(channelDir, blockutil.LegacyBlockFileName(blockNumber))
+		if _, err := os.Stat(legacyPath); err == nil {
+			return legacyPath, true
+		}
+	}
+	return blockFilePath, false
+}
+
+// readIndex 블록 번호 순서대로 기록된 파일 이름 목록 (인덱스 파일이 없으면 빈 목록)
+func (bs *BlockStorage) readIndex(channelID string) ([]string, error) {
+	data, err := os.ReadFile(filepath.Join(bs.storagePath, channelID, indexFileName))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read block index of channel %s", channelID)
+	}
+	return strings.Fields(string(data)), nil
+}
+
+func (bs *BlockStorage) appendIndex(channelID, fileName string) error {
+	indexPath := filepath.Join(bs.storagePath, channelID, indexFileName)
+	file, err := os.OpenFile(indexPath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return errors.Wrapf(err, "failed to open block index: %s", indexPath)
+	}
+	defer file.Close()
+
+	if _, err := fmt.Fprintln(file, fileName); err != nil {
+		return errors.Wrapf(err, "failed to write block index: %s", indexPath)
+	}
+	return nil
+}
@@ -0,0 +1,136 @@
+package storage
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/ddr4869/minifab/common/blockutil"
+)
+
+func TestBlockStoragesWithDifferentPathsAreIndependent(t *testing.T) {
+	pathA := filepath.Join(t.TempDir(), "peer0")
+	pathB := filepath.Join(t.TempDir(), "peer1")
+	storageA := NewBlockStorageWithPath(pathA)
+	storageB := NewBlockStorageWithPath(pathB)
+
+	blocksA := newTestBlocks(t, 3)
+	blocksB := newTestBlocks(t, 5)
+	storeTestBlocks(t, storageA, "mychannel", blocksA)
+	storeTestBlocks(t, storageB, "mychannel", blocksB)
+
+	if height := storageA.GetChannelHeight("mychannel"); height != 3 {
+		t.Errorf("storage A height = %d, want 3", height)
+	}
+	if height := storageB.GetChannelHeight("mychannel"); height != 5 {
+		t.Errorf("storage B height = %d, want 5", height)
+	}
+	for _, want := range blocksA {
+		got, err := storageA.GetBlock("mychannel", want.Header.Number)
+		if err != nil {
+			t.Fatalf("storage A GetBlock(%d): %v", want.Header.Number, err)
+		}
+		if !bytes.Equal(blockutil.CalculateBlockHash(got), blockutil.CalculateBlockHash(want)) {
+			t.Errorf("storage A block %d is not the block stored in A", want.Header.Number)
+		}
+	}
+
+	entries, err := os.ReadDir(filepath.Join(pathA, "mychannel"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 3 {
+		t.Errorf("storage A has %d files, want 3", len(entries))
+	}
+	if storageA.StoragePath() != pathA || storageB.StoragePath() != pathB {
+		t.Errorf("StoragePath() = %s, %s; want %s, %s", storageA.StoragePath(), storageB.StoragePath(), pathA, pathB)
+	}
+}
+
+func TestNewBlockStorageDefaultPath(t *testing.T) {
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chdir(t.TempDir()); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.Chdir(wd) })
+
+	bs := NewBlockStorage(BlockStorageOptions{})
+	if bs.StoragePath() != DefaultStoragePath {
+		t.Errorf("StoragePath() = %s, want %s", bs.StoragePath(), DefaultStoragePath)
+	}
+	if info, err := os.Stat(DefaultStoragePath); err != nil || !info.IsDir() {
+		t.Errorf("default storage directory was not created: %v", err)
+	}
+}
+
+func TestListChannelsSorted(t *testing.T) {
+	bs := NewBlockStorageWithPath(filepath.Join(t.TempDir(), "ledger"))
+	if channels, err := bs.ListChannels(); err != nil || len(channels) != 0 {
+		t.Fatalf("ListChannels before any block = %v, %v, want empty", channels, err)
+	}
+
+	blocks := newTestBlocks(t, 1)
+	for _, channelID := range []string{"zeta", "alpha", "mychannel", "Beta"} {
+		storeTestBlocks(t, bs, channelID, blocks)
+	}
+	// 채널 폴더가 아닌 파일은 목록에서 제외된다
+	if err := os.WriteFile(filepath.Join(bs.StoragePath(), "notes.txt"), []byte("x"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	channels, err := bs.ListChannels()
+	if err != nil {
+		t.Fatalf("ListChannels: %v", err)
+	}
+	want := []string{"Beta", "alpha", "mychannel", "zeta"}
+	if !reflect.DeepEqual(channels, want) {
+		t.Errorf("ListChannels() = %v, want %v", channels, want)
+	}
+
+	if !bs.HasChannel("mychannel") {
+		t.Error("HasChannel(mychannel) = false for a stored channel")
+	}
+	for _, channelID := range []string{"nochannel", "notes.txt"} {
+		if bs.HasChannel(channelID) {
+			t.Errorf("HasChannel(%s) = true", channelID)
+		}
+	}
+}
+
+func TestNewBlockStorageWithPathStoresUnderPath(t *testing.T) {
+	path := t.TempDir()
+	bs := NewBlockStorageWithPath(path)
+	blocks := newTestBlocks(t, 2)
+	storeTestBlocks(t, bs, "mychannel", blocks)
+
+	for _, block := range blocks {
+		blockPath, err := bs.blockFilePath("mychannel", block.Header.Number)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if filepath.Dir(blockPath) != filepath.Join(path, "mychannel") {
+			t.Errorf("block %d stored at %s, want under %s", block.Header.Number, blockPath, path)
+		}
+		if _, err := os.Stat(blockPath); err != nil {
+			t.Errorf("block %d file: %v", block.Header.Number, err)
+		}
+	}
+
+	// 같은 경로로 다시 연 저장소에서 블록을 읽을 수 있다
+	reopened := NewBlockStorageWithPath(path)
+	if height := reopened.GetChannelHeight("mychannel"); height != 2 {
+		t.Fatalf("reopened height = %d, want 2", height)
+	}
+	got, err := reopened.GetBlock("mychannel", 1)
+	if err != nil {
+		t.Fatalf("GetBlock: %v", err)
+	}
+	if !bytes.Equal(blockutil.CalculateBlockHash(got), blockutil.CalculateBlockHash(blocks[1])) {
+		t.Error("reopened storage returned a different block 1")
+	}
+}
@@ -0,0 +1,78 @@
+package storage
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// channelMetadataFileName 블록에 기록되지 않는 채널별 peer 로컬 정보를 저장하는 파일
+const channelMetadataFileName = "channel.json"
+
+// ChannelMetadata 설정 블록과 별도로 보관하는 채널의 peer 로컬 정보
+type ChannelMetadata struct {
+	// JoinedAt peer가 채널에 처음 참여한 시각
+	JoinedAt time.Time `json:"joined_at,omitempty"`
+	// OrdererEndpoints 설정 블록의 orderer endpoint 대신 사용할 목록
+	OrdererEndpoints []string `json:"orderer_endpoints,omitempty"`
+	// UnsignedBlockHeight 이 번호 미만의 블록은 orderer 서명이 없어도 받아들인다
+	// (orderer가 블록에 서명하기 전에 기록된 원장을 위한 값, 0이면 모든 블록에 서명이 필요)
+	UnsignedBlockHeight uint64 `json:"unsigned_block_height,omitempty"`
+}
+
+// StoreChannelMetadata 채널 메타데이터를 임시 파일에 쓴 뒤 rename하여 저장
+func (bs *BlockStorage) StoreChannelMetadata(channelID string, metadata *ChannelMetadata) error {
+	if channelID == "" {
+		return errors.New("channel ID cannot be empty")
+	}
+	if metadata == nil {
+		return errors.New("channel metadata cannot be nil")
+	}
+
+	bs.mutex.Lock()
+	defer bs.mutex.Unlock()
+
+	channelDir := filepath.Join(bs.storagePath, channelID)
+	if err := os.MkdirAll(channelDir, 0755); err != nil {
+		return errors.Wrapf(err, "failed to create channel directory: %s", channelDir)
+	}
+
+	data, err := json.Marshal(metadata)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal channel metadata")
+	}
+	path := filepath.Join(channelDir, channelMetadataFileName)
+	tempPath := path + ".tmp"
+	if err := os.WriteFile(tempPath, data, 0644); err != nil {
+		return errors.Wrap(err, "failed to write temporary channel metadata file")
+	}
+	if err := os.Rename(tempPath, path); err != nil {
+		os.Remove(tempPath)
+		return errors.Wrap(err, "failed to rename temporary channel metadata file")
+	}
+	return nil
+}
+
+// LoadChannelMetadata StoreChannelMetadata로 저장된 채널 메타데이터 반환 (저장된 적이 없으면 빈 메타데이터)
+func (bs *BlockStorage) LoadChannelMetadata(channelID string) (*ChannelMetadata, error) {
+	bs.mutex.RLock()
+	defer bs.mutex.RUnlock()
+
+	path := filepath.Join(bs.storagePath, channelID, channelMetadataFileName)
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return &ChannelMetadata{}, nil
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read channel metadata file: %s", path)
+	}
+
+	metadata := &ChannelMetadata{}
+	if err := json.Unmarshal(data, metadata); err != nil {
+		return nil, errors.Wrapf(err, "failed to unmarshal channel metadata file: %s", path)
+	}
+	return metadata, nil
+}
@@ -0,0 +1,132 @@
+package storage
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ddr4869/minifab/common/blockutil"
+)
+
+func channelFileNames(t testing.TB, channelDir string) map[string]bool {
+	t.Helper()
+
+	entries, err := os.ReadDir(channelDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	names := make(map[string]bool, len(entries))
+	for _, entry := range entries {
+		names[entry.Name()] = true
+	}
+	return names
+}
+
+func TestCompactRemovesBlockFilesPastDeletedBlock(t *testing.T) {
+	dir := t.TempDir()
+	bs := NewBlockStorageWithPath(dir)
+	storeTestBlocks(t, bs, "mychannel", newTestBlocks(t, 10))
+	if err := bs.StoreChannelMetadata("mychannel", &ChannelMetadata{OrdererEndpoints: []string{"orderer:7050"}}); err != nil {
+		t.Fatal(err)
+	}
+	channelDir := filepath.Join(dir, "mychannel")
+
+	// 블록 6이 삭제되면 7~9는 읽을 수 없는 블록으로 남는다
+	if err := os.Remove(filepath.Join(channelDir, blockutil.PaddedBlockFileName(6))); err != nil {
+		t.Fatal(err)
+	}
+	// 0을 채운 파일과 같은 번호의 이전 형식 파일, 중단된 메타데이터 저장의 임시 파일
+	for _, name := range []string{blockutil.LegacyBlockFileName(2), channelMetadataFileName + ".tmp"} {
+		if err := os.WriteFile(filepath.Join(channelDir, name), []byte("stale"), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := bs.Compact("mychannel"); err != nil {
+		t.Fatalf("Compact: %v", err)
+	}
+	names := channelFileNames(t, channelDir)
+	for number := uint64(0); number < 6; number++ {
+		if !names[blockutil.PaddedBlockFileName(number)] {
+			t.Fatalf("Compact removed live block %d", number)
+		}
+	}
+	for _, name := range []string{
+		blockutil.PaddedBlockFileName(7), blockutil.PaddedBlockFileName(9),
+		blockutil.LegacyBlockFileName(2), channelMetadataFileName + ".tmp",
+	} {
+		if names[name] {
+			t.Fatalf("Compact left %s behind", name)
+		}
+	}
+	if !names[channelMetadataFileName] {
+		t.Fatal("Compact removed the channel metadata")
+	}
+	if height := bs.GetChannelHeight("mychannel"); height != 6 {
+		t.Fatalf("height after compaction = %d, want 6", height)
+	}
+}
+
+func TestCompactRemovesUnindexedHashNamedFiles(t *testing.T) {
+	dir := t.TempDir()
+	bs := NewBlockStorage(BlockStorageOptions{StoragePath: dir, NamingStrategy: HashNaming{}})
+	blocks := newTestBlocks(t, 4)
+	storeTestBlocks(t, bs, "mychannel", blocks[:3])
+	channelDir := filepath.Join(dir, "mychannel")
+
+	// 파일은 쓰였지만 인덱스에 기록되지 못한 블록
+	orphan := (HashNaming{}).FileName(3, blocks[3])
+	if err := os.WriteFile(filepath.Join(channelDir, orphan), []byte("orphan"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := bs.Compact("mychannel"); err != nil {
+		t.Fatalf("Compact: %v", err)
+	}
+	names := channelFileNames(t, channelDir)
+	if names[orphan] {
+		t.Fatal("Compact left an unindexed block file")
+	}
+	if !names[indexFileName] || len(names) != 4 {
+		t.Fatalf("unexpected files after compaction: %v", names)
+	}
+	for _, block := range blocks[:3] {
+		if _, err := bs.GetBlock("mychannel", block.Header.Number); err != nil {
+			t.Fatalf("GetBlock %d after compaction: %v", block.Header.Number, err)
+		}
+	}
+
+	if err := bs.Compact("missing"); err == nil {
+		t.Fatal("Compact succeeded for a missing channel")
+	}
+}
+
+// BenchmarkCompact 1000개 블록 중 블록 500을 삭제해 500~999를 원장 밖으로 만든 뒤
+// compaction 전후의 블록 읽기 처리량과 채널 폴더의 파일 수 측정
+func BenchmarkCompact(b *testing.B) {
+	const blockCount, deleted = 1000, 500
+
+	dir := b.TempDir()
+	bs := NewBlockStorageWithPath(dir)
+	storeTestBlocks(b, bs, "mychannel", newTestBlocks(b, blockCount))
+	channelDir := filepath.Join(dir, "mychannel")
+	if err := os.Remove(filepath.Join(channelDir, blockutil.PaddedBlockFileName(deleted))); err != nil {
+		b.Fatal(err)
+	}
+
+	readBlocks := func(b *testing.B) {
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			if _, err := bs.GetBlock("mychannel", uint64(i%deleted)); err != nil {
+				b.Fatal(err)
+			}
+		}
+		b.ReportMetric(float64(b.N)/b.Elapsed().Seconds(), "blocks/s")
+		b.ReportMetric(float64(len(channelFileNames(b, channelDir))), "files")
+	}
+
+	b.Run("before", readBlocks)
+	if err := bs.Compact("mychannel"); err != nil {
+		b.Fatal(err)
+	}
+	b.Run("after", readBlocks)
+}
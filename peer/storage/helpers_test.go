@@ -0,0 +1,43 @@
+package storage
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ddr4869/minifab/common/blockutil"
+	"github.com/ddr4869/minifab/common/msp/msptest"
+	pb_common "github.com/ddr4869/minifab/proto/common"
+)
+
+// newTestBlocks 설정 블록 0과 트랜잭션 하나씩을 담은 데이터 블록으로 이루어진 n개 블록
+func newTestBlocks(t testing.TB, n int) []*pb_common.Block {
+	t.Helper()
+
+	signer := msptest.NewOrg(t, "OrdererMSP").SigningIdentity()
+	genesis, err := blockutil.GenerateConfigBlock([]byte(`{}`), "testchannel", signer)
+	if err != nil {
+		t.Fatalf("GenerateConfigBlock: %v", err)
+	}
+	blocks := []*pb_common.Block{genesis}
+	for number := 1; number < n; number++ {
+		tx := &pb_common.Transaction{Payload: []byte(fmt.Sprintf("tx-%d", number))}
+		block, err := blockutil.GenerateDataBlock([]*pb_common.Transaction{tx}, "testchannel", signer)
+		if err != nil {
+			t.Fatalf("GenerateDataBlock: %v", err)
+		}
+		blockutil.SetBlockPosition(block, uint64(number), blockutil.CalculateBlockHash(blocks[number-1]))
+		blocks = append(blocks, block)
+	}
+	return blocks
+}
+
+// storeTestBlocks blocks를 channelID에 순서대로 저장
+func storeTestBlocks(t testing.TB, bs *BlockStorage, channelID string, blocks []*pb_common.Block) {
+	t.Helper()
+
+	for _, block := range blocks {
+		if err := bs.StoreBlock(channelID, block); err != nil {
+			t.Fatalf("StoreBlock %d: %v", block.Header.Number, err)
+		}
+	}
+}
@@ -0,0 +1,40 @@
+package storage
+
+import (
+	"encoding/hex"
+
+	"github.com/ddr4869/minifab/common/blockutil"
+	pb_common "github.com/ddr4869/minifab/proto/common"
+)
+
+// BlockFileNamingStrategy 블록 파일 이름 결정 방식
+type BlockFileNamingStrategy interface {
+	// FileName 블록을 저장할 채널 폴더 내 파일 이름
+	FileName(blockNumber uint64, block *pb_common.Block) string
+	// Sequential 파일 이름이 블록 번호만으로 결정되는지 여부
+	// false이면 BlockStorage가 블록 번호 순서대로 파일 이름을 인덱스 파일에 기록한다.
+	Sequential() bool
+}
+
+// SequentialNaming 블록 번호를 20자리로 0을 채운 blockfile00000000000000000042 형식의 순차 파일 이름 (기본값)
+// 0을 채우기 전에 저장된 blockfileN 파일도 BlockStorage가 같은 번호의 블록으로 읽는다.
+type SequentialNaming struct{}
+
+func (SequentialNaming) FileName(blockNumber uint64, _ *pb_common.Block) string {
+	return blockutil.PaddedBlockFileName(blockNumber)
+}
+
+func (SequentialNaming) Sequential() bool {
+	return true
+}
+
+// HashNaming 블록 data hash의 hex 문자열을 파일 이름으로 사용하는 content-addressed 방식
+type HashNaming struct{}
+
+func (HashNaming) FileName(_ uint64, block *pb_common.Block) string {
+	return hex.EncodeToString(block.GetHeader().GetDataHash())
+}
+
+func (HashNaming) Sequential() bool {
+	return false
+}
@@ -0,0 +1,123 @@
+package storage
+
+import (
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	"github.com/ddr4869/minifab/common/blockutil"
+	pb_common "github.com/ddr4869/minifab/proto/common"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestSequentialNamingZeroPadsFileNames(t *testing.T) {
+	dir := t.TempDir()
+	bs := NewBlockStorageWithPath(dir)
+	blocks := newTestBlocks(t, 12)
+	storeTestBlocks(t, bs, "mychannel", blocks)
+
+	if got := (SequentialNaming{}).FileName(42, nil); got != "blockfile00000000000000000042" {
+		t.Fatalf("FileName(42) = %q", got)
+	}
+	entries, err := os.ReadDir(filepath.Join(dir, "mychannel"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for _, entry := range entries {
+		names = append(names, entry.Name())
+	}
+	// 0을 채웠으므로 사전순 정렬이 블록 번호 순서와 같다
+	if !sort.StringsAreSorted(names) || names[10] != "blockfile00000000000000000010" {
+		t.Fatalf("block files are not zero-padded in order: %v", names)
+	}
+
+	if height := bs.GetChannelHeight("mychannel"); height != 12 {
+		t.Fatalf("height = %d, want 12", height)
+	}
+	for _, want := range blocks {
+		got, err := bs.GetBlock("mychannel", want.Header.Number)
+		if err != nil {
+			t.Fatalf("GetBlock %d: %v", want.Header.Number, err)
+		}
+		if !proto.Equal(got, want) {
+			t.Fatalf("GetBlock %d returned a different block", want.Header.Number)
+		}
+	}
+}
+
+func TestSequentialNamingReadsLegacyFileNames(t *testing.T) {
+	dir := t.TempDir()
+	blocks := newTestBlocks(t, 5)
+
+	// 0을 채우기 전의 peer가 저장한 블록 0~2
+	channelDir := filepath.Join(dir, "mychannel")
+	if err := os.MkdirAll(channelDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	for _, block := range blocks[:3] {
+		data, err := blockutil.MarshalBlockToProto(block)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filepath.Join(channelDir, blockutil.LegacyBlockFileName(block.Header.Number)), data, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	bs := NewBlockStorageWithPath(dir)
+	if height := bs.GetChannelHeight("mychannel"); height != 3 {
+		t.Fatalf("height of legacy ledger = %d, want 3", height)
+	}
+	storeTestBlocks(t, bs, "mychannel", blocks[3:])
+	if _, err := os.Stat(filepath.Join(channelDir, blockutil.PaddedBlockFileName(3))); err != nil {
+		t.Fatalf("new block was not stored under a padded name: %v", err)
+	}
+	if height := bs.GetChannelHeight("mychannel"); height != 5 {
+		t.Fatalf("height of mixed ledger = %d, want 5", height)
+	}
+	for _, want := range blocks {
+		got, err := bs.GetBlock("mychannel", want.Header.Number)
+		if err != nil || !proto.Equal(got, want) {
+			t.Fatalf("GetBlock %d = %v", want.Header.Number, err)
+		}
+	}
+
+	found, err := blockutil.LoadBlockByHash(channelDir, blockutil.CalculateBlockHash(blocks[4]))
+	if err != nil || found.Header.Number != 4 {
+		t.Fatalf("LoadBlockByHash over mixed file names = %v, %v", found.GetHeader().GetNumber(), err)
+	}
+}
+
+func TestHashNamingUsesDataHash(t *testing.T) {
+	dir := t.TempDir()
+	bs := NewBlockStorage(BlockStorageOptions{StoragePath: dir, NamingStrategy: HashNaming{}})
+	blocks := newTestBlocks(t, 4)
+	storeTestBlocks(t, bs, "mychannel", blocks)
+
+	for _, want := range blocks {
+		name := hex.EncodeToString(want.Header.DataHash)
+		if got := (HashNaming{}).FileName(want.Header.Number, want); got != name {
+			t.Fatalf("FileName = %q, want %q", got, name)
+		}
+		if _, err := os.Stat(filepath.Join(dir, "mychannel", name)); err != nil {
+			t.Fatalf("block %d not stored as %s: %v", want.Header.Number, name, err)
+		}
+		got, err := bs.GetBlock("mychannel", want.Header.Number)
+		if err != nil || !proto.Equal(got, want) {
+			t.Fatalf("GetBlock %d = %v", want.Header.Number, err)
+		}
+	}
+	if height := bs.GetChannelHeight("mychannel"); height != 4 {
+		t.Fatalf("height = %d, want 4", height)
+	}
+
+	// 같은 내용의 블록은 파일을 덮어쓰지 않는다
+	duplicate := proto.Clone(blocks[3]).(*pb_common.Block)
+	duplicate.Header.Number = 4
+	if err := bs.StoreBlock("mychannel", duplicate); err == nil {
+		t.Fatal("StoreBlock overwrote an existing content-addressed file")
+	}
+}
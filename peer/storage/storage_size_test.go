@@ -0,0 +1,63 @@
+package storage
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/ddr4869/minifab/common/blockutil"
+	"github.com/ddr4869/minifab/common/msp/msptest"
+	pb_common "github.com/ddr4869/minifab/proto/common"
+)
+
+func TestGetStorageSizeBytes(t *testing.T) {
+	const payloadSize = 100000
+	const dataBlocks = 4
+
+	bs := NewBlockStorageWithPath(t.TempDir())
+	signer := msptest.NewOrg(t, "OrdererMSP").SigningIdentity()
+	blocks := newTestBlocks(t, 1)
+	for i := 1; i <= dataBlocks; i++ {
+		tx := &pb_common.Transaction{Payload: bytes.Repeat([]byte{byte(i)}, payloadSize)}
+		block, err := blockutil.GenerateDataBlock([]*pb_common.Transaction{tx}, "bigchannel", signer)
+		if err != nil {
+			t.Fatalf("GenerateDataBlock: %v", err)
+		}
+		blockutil.SetBlockPosition(block, uint64(i), blockutil.CalculateBlockHash(blocks[i-1]))
+		blocks = append(blocks, block)
+	}
+	storeTestBlocks(t, bs, "bigchannel", blocks)
+	storeTestBlocks(t, bs, "smallchannel", newTestBlocks(t, 3))
+
+	size, err := bs.GetStorageSizeBytes("bigchannel")
+	if err != nil {
+		t.Fatalf("GetStorageSizeBytes: %v", err)
+	}
+	// 블록 헤더, 서명, 인덱스와 메타데이터를 포함해도 트랜잭션 payload 합계의 10% 이내여야 한다
+	want := int64(dataBlocks * payloadSize)
+	if size < want || size > want+want/10 {
+		t.Errorf("GetStorageSizeBytes(bigchannel) = %d, want within 10%% of %d", size, want)
+	}
+
+	small, err := bs.GetStorageSizeBytes("smallchannel")
+	if err != nil || small <= 0 {
+		t.Fatalf("GetStorageSizeBytes(smallchannel) = %d, %v", small, err)
+	}
+	total, err := bs.GetTotalStorageSizeBytes()
+	if err != nil {
+		t.Fatalf("GetTotalStorageSizeBytes: %v", err)
+	}
+	if total != size+small {
+		t.Errorf("GetTotalStorageSizeBytes = %d, want %d + %d", total, size, small)
+	}
+
+	if _, err := bs.GetStorageSizeBytes("nochannel"); err == nil {
+		t.Error("GetStorageSizeBytes of an unknown channel succeeded")
+	}
+}
+
+func TestGetTotalStorageSizeBytesEmpty(t *testing.T) {
+	bs := NewBlockStorageWithPath(t.TempDir())
+	if total, err := bs.GetTotalStorageSizeBytes(); err != nil || total != 0 {
+		t.Errorf("GetTotalStorageSizeBytes of empty storage = %d, %v, want 0", total, err)
+	}
+}
@@ -0,0 +1,111 @@
+package storage
+
+import (
+	"sync"
+
+	"github.com/ddr4869/minifab/common/blockutil"
+	"github.com/ddr4869/minifab/common/logger"
+	pb_common "github.com/ddr4869/minifab/proto/common"
+	"github.com/pkg/errors"
+)
+
+// txIndex 채널별 트랜잭션 ID -> 블록 번호 인덱스
+// 메모리에만 유지되며, 조회 시 아직 인덱싱하지 않은 블록부터 이어서 인덱싱한다 (재시작 후 첫 조회에서 재구성).
+type txIndex struct {
+	mutex   sync.Mutex
+	entries map[string]map[string]uint64
+	// indexed 채널별로 인덱싱을 마친 블록 수
+	indexed map[string]uint64
+}
+
+func newTxIndex() *txIndex {
+	return &txIndex{
+		entries: make(map[string]map[string]uint64),
+		indexed: make(map[string]uint64),
+	}
+}
+
+func (idx *txIndex) remove(channelID string) {
+	idx.mutex.Lock()
+	defer idx.mutex.Unlock()
+
+	delete(idx.entries, channelID)
+	delete(idx.indexed, channelID)
+}
+
+// GetBlockByTxID 트랜잭션 ID가 포함된 블록 조회
+func (bs *BlockStorage) GetBlockByTxID(channelID, txID string) (*pb_common.Block, error) {
+	blockNumber, err := bs.lookupTxID(channelID, txID)
+	if err != nil {
+		return nil, err
+	}
+	return bs.GetBlock(channelID, blockNumber)
+}
+
+// GetTransactionByID 트랜잭션 ID로 트랜잭션과 그 트랜잭션이 포함된 블록 번호 조회
+func (bs *BlockStorage) GetTransactionByID(channelID, txID string) (*pb_common.Transaction, uint64, error) {
+	block, err := bs.GetBlockByTxID(channelID, txID)
+	if err != nil {
+		return nil, 0, err
+	}
+	for _, protoTx := range block.Data.GetTransactions() {
+		tx, err := blockutil.UnmarshalTransactionFromProto(protoTx)
+		if err != nil {
+			continue
+		}
+		if tx.TxId == txID {
+			return tx, block.Header.Number, nil
+		}
+	}
+	return nil, 0, errors.Errorf("transaction %s not found in block %d of channel %s", txID, block.Header.Number, channelID)
+}
+
+// lookupTxID 인덱스를 채널의 현재 높이까지 갱신한 뒤 트랜잭션 ID의 블록 번호 반환
+func (bs *BlockStorage) lookupTxID(channelID, txID string) (uint64, error) {
+	if channelID == "" {
+		return 0, errors.New("channel ID cannot be empty")
+	}
+	if txID == "" {
+		return 0, errors.New("transaction ID cannot be empty")
+	}
+
+	idx := bs.txIndex
+	idx.mutex.Lock()
+	defer idx.mutex.Unlock()
+
+	height := bs.GetChannelHeight(channelID)
+	if height < idx.indexed[channelID] {
+		// 저장소가 밖에서 지워진 경우 인덱스를 처음부터 다시 만든다
+		delete(idx.entries, channelID)
+		idx.indexed[channelID] = 0
+	}
+	entries, exists := idx.entries[channelID]
+	if !exists {
+		entries = make(map[string]uint64)
+		idx.entries[channelID] = entries
+	}
+	for blockNumber := idx.indexed[channelID]; blockNumber < height; blockNumber++ {
+		block, err := bs.GetBlock(channelID, blockNumber)
+		if err != nil {
+			return 0, errors.Wrapf(err, "failed to index block %d of channel %s", blockNumber, channelID)
+		}
+		for i, protoTx := range block.Data.GetTransactions() {
+			tx, err := blockutil.UnmarshalTransactionFromProto(protoTx)
+			if err != nil {
+				logger.Warnf("Skipping transaction %d of block %d in channel %s: %v", i, blockNumber, channelID, err)
+				continue
+			}
+			// 같은 ID가 여러 번 기록된 경우 처음 기록된 블록을 사용
+			if _, duplicate := entries[tx.TxId]; !duplicate {
+				entries[tx.TxId] = blockNumber
+			}
+		}
+		idx.indexed[channelID] = blockNumber + 1
+	}
+
+	blockNumber, exists := entries[txID]
+	if !exists {
+		return 0, errors.Errorf("transaction %s not found in channel %s", txID, channelID)
+	}
+	return blockNumber, nil
+}
@@ -0,0 +1,82 @@
+package storage
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/ddr4869/minifab/common/blockutil"
+	"github.com/ddr4869/minifab/common/msp/msptest"
+	pb_common "github.com/ddr4869/minifab/proto/common"
+	"google.golang.org/protobuf/proto"
+)
+
+// newTestTxBlock previous 다음 번호로 payload "<prefix>-<i>"인 트랜잭션 count개를 담은 데이터 블록
+func newTestTxBlock(t *testing.T, previous *pb_common.Block, prefix string, count int) (*pb_common.Block, []*pb_common.Transaction) {
+	t.Helper()
+
+	var txs []*pb_common.Transaction
+	for i := 0; i < count; i++ {
+		tx := &pb_common.Transaction{
+			Payload:   []byte(fmt.Sprintf("%s-%d", prefix, i)),
+			Identity:  &pb_common.Identity{MspId: "Org1MSP", Creator: []byte("creator")},
+			Timestamp: time.Now().Unix(),
+			Type:      pb_common.MessageType_MESSAGE_TYPE_TRANSACTION,
+		}
+		txID, err := blockutil.CalculateTxHash(tx)
+		if err != nil {
+			t.Fatalf("CalculateTxHash: %v", err)
+		}
+		tx.TxId = txID
+		txs = append(txs, tx)
+	}
+	signer := msptest.NewOrg(t, "OrdererMSP").SigningIdentity()
+	block, err := blockutil.GenerateDataBlock(txs, "testchannel", signer)
+	if err != nil {
+		t.Fatalf("GenerateDataBlock: %v", err)
+	}
+	blockutil.SetBlockPosition(block, previous.Header.Number+1, blockutil.CalculateBlockHash(previous))
+	return block, txs
+}
+
+func TestGetTransactionByID(t *testing.T) {
+	bs := NewBlockStorageWithPath(t.TempDir())
+	genesis := newTestBlocks(t, 1)[0]
+	block1, txs1 := newTestTxBlock(t, genesis, "first", 2)
+	storeTestBlocks(t, bs, "mychannel", []*pb_common.Block{genesis, block1})
+
+	for _, want := range txs1 {
+		tx, blockNumber, err := bs.GetTransactionByID("mychannel", want.TxId)
+		if err != nil {
+			t.Fatalf("GetTransactionByID(%s): %v", want.TxId, err)
+		}
+		if blockNumber != 1 || !proto.Equal(tx, want) {
+			t.Errorf("GetTransactionByID(%s) = block %d %v, want block 1 %v", want.TxId, blockNumber, tx, want)
+		}
+	}
+
+	// 첫 조회 이후 저장된 블록도 인덱싱된다
+	block2, txs2 := newTestTxBlock(t, block1, "second", 1)
+	storeTestBlocks(t, bs, "mychannel", []*pb_common.Block{block2})
+	block, err := bs.GetBlockByTxID("mychannel", txs2[0].TxId)
+	if err != nil {
+		t.Fatalf("GetBlockByTxID after a new block: %v", err)
+	}
+	if block.Header.Number != 2 {
+		t.Errorf("GetBlockByTxID returned block %d, want 2", block.Header.Number)
+	}
+
+	if _, _, err := bs.GetTransactionByID("mychannel", "unknown"); err == nil {
+		t.Error("GetTransactionByID of an unknown ID succeeded, want error")
+	}
+	if _, _, err := bs.GetTransactionByID("mychannel", ""); err == nil {
+		t.Error("GetTransactionByID with an empty ID succeeded, want error")
+	}
+
+	if err := bs.RemoveChannel("mychannel"); err != nil {
+		t.Fatalf("RemoveChannel: %v", err)
+	}
+	if _, _, err := bs.GetTransactionByID("mychannel", txs1[0].TxId); err == nil {
+		t.Error("transaction of a removed channel is still found")
+	}
+}
@@ -1,380 +1,459 @@
 package sync
 
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ddr4869/minifab/common/blockutil"
+	"github.com/ddr4869/minifab/common/logger"
+	"github.com/ddr4869/minifab/peer/common"
+	"github.com/ddr4869/minifab/peer/core"
+	"github.com/ddr4869/minifab/peer/storage"
+	pb_common "github.com/ddr4869/minifab/proto/common"
+	"github.com/pkg/errors"
+)
+
 // BlockSynchronizer handles block synchronization for peer startup and recovery
-// type BlockSynchronizer struct {
-// 	peer          *core.Peer
-// 	ordererClient *client.OrdererClient
-// 	blockStorage  *storage.BlockStorage
-// 	mutex         sync.RWMutex
-// 	isRunning     bool
-// 	stopChan      chan struct{}
-// }
-
-// // SyncConfig contains configuration for block synchronization
-// type SyncConfig struct {
-// 	BatchSize       uint64        // Number of blocks to sync in each batch
-// 	SyncInterval    time.Duration // Interval between sync attempts
-// 	MaxRetries      int           // Maximum number of retry attempts
-// 	RetryDelay      time.Duration // Delay between retries
-// 	EnableStreaming bool          // Whether to use streaming for real-time sync
-// }
-
-// // DefaultSyncConfig returns default synchronization configuration
-// func DefaultSyncConfig() *SyncConfig {
-// 	return &SyncConfig{
-// 		BatchSize:       50,
-// 		SyncInterval:    30 * time.Second,
-// 		MaxRetries:      3,
-// 		RetryDelay:      5 * time.Second,
-// 		EnableStreaming: true,
-// 	}
-// }
-
-// // NewBlockSynchronizer creates a new block synchronizer
-// func NewBlockSynchronizer(peer *core.Peer, ordererClient *client.OrdererClient, blockStorage *storage.BlockStorage) *BlockSynchronizer {
-// 	return &BlockSynchronizer{
-// 		peer:          peer,
-// 		ordererClient: ordererClient,
-// 		blockStorage:  blockStorage,
-// 		stopChan:      make(chan struct{}),
-// 	}
-// }
-
-// // StartSync starts the block synchronization process
-// func (bs *BlockSynchronizer) StartSync(ctx context.Context, config *SyncConfig) error {
-// 	bs.mutex.Lock()
-// 	if bs.isRunning {
-// 		bs.mutex.Unlock()
-// 		return errors.New("synchronization is already running")
-// 	}
-// 	bs.isRunning = true
-// 	bs.mutex.Unlock()
-
-// 	logger.Info("Starting block synchronization service")
-
-// 	// Start synchronization goroutine
-// 	go bs.syncLoop(ctx, config)
-
-// 	return nil
-// }
-
-// // StopSync stops the block synchronization process
-// func (bs *BlockSynchronizer) StopSync() {
-// 	bs.mutex.Lock()
-// 	defer bs.mutex.Unlock()
-
-// 	if !bs.isRunning {
-// 		return
-// 	}
-
-// 	logger.Info("Stopping block synchronization service")
-// 	close(bs.stopChan)
-// 	bs.isRunning = false
-// }
-
-// // syncLoop is the main synchronization loop
-// func (bs *BlockSynchronizer) syncLoop(ctx context.Context, config *SyncConfig) {
-// 	ticker := time.NewTicker(config.SyncInterval)
-// 	defer ticker.Stop()
-
-// 	// Perform initial sync for all channels
-// 	if err := bs.performInitialSync(ctx, config); err != nil {
-// 		logger.Errorf("Initial sync failed: %v", err)
-// 	}
-
-// 	// Start streaming sync if enabled
-// 	if config.EnableStreaming {
-// 		go bs.startStreamingSync(ctx, config)
-// 	}
-
-// 	// Periodic sync loop
-// 	for {
-// 		select {
-// 		case <-ctx.Done():
-// 			logger.Info("Block synchronization stopped due to context cancellation")
-// 			return
-// 		case <-bs.stopChan:
-// 			logger.Info("Block synchronization stopped")
-// 			return
-// 		case <-ticker.C:
-// 			if err := bs.performPeriodicSync(ctx, config); err != nil {
-// 				logger.Errorf("Periodic sync failed: %v", err)
-// 			}
-// 		}
-// 	}
-// }
-
-// // performInitialSync performs initial synchronization for all channels
-// func (bs *BlockSynchronizer) performInitialSync(ctx context.Context, config *SyncConfig) error {
-// 	logger.Info("Performing initial block synchronization")
-
-// 	// Get all channels that the peer has joined
-// 	channels := bs.peer.GetChannelManager().GetChannelNames()
-
-// 	for _, channelID := range channels {
-// 		if err := bs.syncChannel(ctx, channelID, config); err != nil {
-// 			logger.Errorf("Failed to sync channel %s: %v", channelID, err)
-// 			continue
-// 		}
-// 		logger.Infof("Successfully synced channel %s", channelID)
-// 	}
-
-// 	return nil
-// }
-
-// // performPeriodicSync performs periodic synchronization check
-// func (bs *BlockSynchronizer) performPeriodicSync(ctx context.Context, config *SyncConfig) error {
-// 	logger.Debug("Performing periodic block synchronization check")
-
-// 	channels := bs.peer.GetChannelManager().GetChannelNames()
-
-// 	for _, channelID := range channels {
-// 		// Check if channel needs synchronization
-// 		needsSync, err := bs.checkChannelNeedsSync(channelID)
-// 		if err != nil {
-// 			logger.Errorf("Failed to check sync status for channel %s: %v", channelID, err)
-// 			continue
-// 		}
-
-// 		if needsSync {
-// 			logger.Infof("Channel %s needs synchronization", channelID)
-// 			if err := bs.syncChannel(ctx, channelID, config); err != nil {
-// 				logger.Errorf("Failed to sync channel %s: %v", channelID, err)
-// 			}
-// 		}
-// 	}
-
-// 	return nil
-// }
-
-// // syncChannel synchronizes blocks for a specific channel
-// func (bs *BlockSynchronizer) syncChannel(ctx context.Context, channelID string, config *SyncConfig) error {
-// 	// Get current local height
-// 	localHeight := bs.blockStorage.GetChannelHeight(channelID)
-
-// 	// Get remote height from orderer (we'll use a simple approach for now)
-// 	// In a real implementation, we'd query the orderer for channel info
-// 	remoteHeight := localHeight + 10 // Placeholder - assume there might be new blocks
-
-// 	if localHeight >= remoteHeight {
-// 		logger.Debugf("Channel %s is up to date (local: %d, remote: %d)", channelID, localHeight, remoteHeight)
-// 		return nil
-// 	}
-
-// 	logger.Infof("Syncing channel %s from block %d to %d", channelID, localHeight, remoteHeight)
-
-// 	// Sync blocks in batches
-// 	for startBlock := localHeight; startBlock < remoteHeight; startBlock += config.BatchSize {
-// 		endBlock := min(startBlock+config.BatchSize, remoteHeight)
-
-// 		if err := bs.syncBlockRange(ctx, channelID, startBlock, endBlock, config); err != nil {
-// 			return errors.Wrapf(err, "failed to sync block range %d-%d", startBlock, endBlock)
-// 		}
-// 	}
-
-// 	return nil
-// }
-
-// // syncBlockRange synchronizes a range of blocks for a channel
-// func (bs *BlockSynchronizer) syncBlockRange(ctx context.Context, channelID string, startBlock, endBlock uint64, config *SyncConfig) error {
-// 	var lastErr error
-
-// 	for attempt := 0; attempt < config.MaxRetries; attempt++ {
-// 		if attempt > 0 {
-// 			logger.Infof("Retrying block sync for channel %s, attempt %d/%d", channelID, attempt+1, config.MaxRetries)
-// 			time.Sleep(config.RetryDelay)
-// 		}
-
-// 		// Get blocks from orderer
-// 		blocks, err := bs.ordererClient.GetBlockRange(channelID, startBlock, endBlock)
-// 		if err != nil {
-// 			lastErr = err
-// 			logger.Warnf("Failed to get blocks from orderer (attempt %d): %v", attempt+1, err)
-// 			continue
-// 		}
-
-// 		// Process and store each block
-// 		for _, protoBlock := range blocks {
-// 			// Convert to internal block format
-// 			internalBlock := &types.Block{
-// 				Number:       protoBlock.Number,
-// 				PreviousHash: protoBlock.PreviousHash,
-// 				Data:         protoBlock.DataHash,
-// 				Timestamp:    time.Unix(protoBlock.Timestamp, 0),
-// 			}
-
-// 			// Store block
-// 			if err := bs.blockStorage.StoreBlock(channelID, internalBlock); err != nil {
-// 				lastErr = err
-// 				logger.Errorf("Failed to store block %d: %v", protoBlock.Number, err)
-// 				break
-// 			}
-
-// 			// Update channel with transactions
-// 			if err := bs.updateChannelWithBlock(channelID, protoBlock); err != nil {
-// 				logger.Warnf("Failed to update channel with block %d: %v", protoBlock.Number, err)
-// 			}
-
-// 			logger.Debugf("Synced block %d for channel %s", protoBlock.Number, channelID)
-// 		}
-
-// 		// If we got here without error, sync was successful
-// 		return nil
-// 	}
-
-// 	return errors.Wrapf(lastErr, "failed to sync blocks after %d attempts", config.MaxRetries)
-// }
-
-// // startStreamingSync starts real-time block streaming
-// func (bs *BlockSynchronizer) startStreamingSync(ctx context.Context, config *SyncConfig) {
-// 	logger.Info("Starting streaming block synchronization")
-
-// 	channels := bs.peer.GetChannelManager().GetChannelNames()
-
-// 	for _, channelID := range channels {
-// 		go bs.streamChannelBlocks(ctx, channelID, config)
-// 	}
-// }
-
-// // streamChannelBlocks streams blocks for a specific channel
-// func (bs *BlockSynchronizer) streamChannelBlocks(ctx context.Context, channelID string, config *SyncConfig) {
-// 	startBlock := bs.blockStorage.GetChannelHeight(channelID)
-
-// 	logger.Infof("Starting block stream for channel %s from block %d", channelID, startBlock)
-
-// 	blockChan, errorChan := bs.ordererClient.StreamBlocks(channelID, startBlock)
-
-// 	for {
-// 		select {
-// 		case <-ctx.Done():
-// 			return
-// 		case <-bs.stopChan:
-// 			return
-// 		case block, ok := <-blockChan:
-// 			if !ok {
-// 				logger.Infof("Block stream closed for channel %s", channelID)
-// 				return
-// 			}
-
-// 			// Process received block
-// 			if err := bs.processStreamedBlock(channelID, block); err != nil {
-// 				logger.Errorf("Failed to process streamed block %d for channel %s: %v",
-// 					block.Number, channelID, err)
-// 			}
-
-// 		case err, ok := <-errorChan:
-// 			if !ok {
-// 				return
-// 			}
-// 			logger.Errorf("Block stream error for channel %s: %v", channelID, err)
-
-// 			// Restart streaming after delay
-// 			time.Sleep(config.RetryDelay)
-// 			go bs.streamChannelBlocks(ctx, channelID, config)
-// 			return
-// 		}
-// 	}
-// }
-
-// // processStreamedBlock processes a block received from streaming
-// func (bs *BlockSynchronizer) processStreamedBlock(channelID string, protoBlock *proto.Block) error {
-// 	// Convert to internal block format
-// 	internalBlock := &types.Block{
-// 		Number:       protoBlock.Number,
-// 		PreviousHash: protoBlock.PreviousHash,
-// 		Data:         protoBlock.DataHash,
-// 		Timestamp:    time.Unix(protoBlock.Timestamp, 0),
-// 	}
-
-// 	// Store block
-// 	if err := bs.blockStorage.StoreBlock(channelID, internalBlock); err != nil {
-// 		return errors.Wrap(err, "failed to store streamed block")
-// 	}
-
-// 	// Update channel with transactions
-// 	if err := bs.updateChannelWithBlock(channelID, protoBlock); err != nil {
-// 		logger.Warnf("Failed to update channel with streamed block %d: %v", protoBlock.Number, err)
-// 	}
-
-// 	logger.Infof("Processed streamed block %d for channel %s", protoBlock.Number, channelID)
-// 	return nil
-// }
-
-// // updateChannelWithBlock updates channel state with block transactions
-// func (bs *BlockSynchronizer) updateChannelWithBlock(channelID string, protoBlock *proto.Block) error {
-// 	channel, err := bs.peer.GetChannelManager().GetChannel(channelID)
-// 	if err != nil {
-// 		return errors.Wrap(err, "failed to get channel")
-// 	}
-
-// 	// Convert protobuf transactions to internal format
-// 	for _, protoTx := range protoBlock.Transactions {
-// 		tx := &types.Transaction{
-// 			ID:        protoTx.Id,
-// 			ChannelID: protoTx.ChannelId,
-// 			Payload:   protoTx.Payload,
-// 			Timestamp: time.Unix(protoTx.Timestamp, 0),
-// 			Identity:  protoTx.Identity,
-// 			Signature: protoTx.Signature,
-// 		}
-// 		channel.Transactions = append(channel.Transactions, tx)
-// 	}
-
-// 	return nil
-// }
-
-// // checkChannelNeedsSync checks if a channel needs synchronization
-// func (bs *BlockSynchronizer) checkChannelNeedsSync(channelID string) (bool, error) {
-// 	// For now, we'll use a simple heuristic
-// 	// In a real implementation, we'd query the orderer for the latest block height
-
-// 	localHeight := bs.blockStorage.GetChannelHeight(channelID)
-
-// 	// Check if we have recent blocks (within last 5 minutes)
-// 	if localHeight > 0 {
-// 		lastBlock, err := bs.blockStorage.GetBlock(channelID, localHeight-1)
-// 		if err != nil {
-// 			return true, nil // If we can't read the last block, assume we need sync
-// 		}
-
-// 		// If last block is older than 5 minutes, we might need sync
-// 		if time.Since(lastBlock.Timestamp) > 5*time.Minute {
-// 			return true, nil
-// 		}
-// 	}
-
-// 	return false, nil
-// }
-
-// // GetSyncStatus returns the current synchronization status
-// func (bs *BlockSynchronizer) GetSyncStatus() map[string]any {
-// 	bs.mutex.RLock()
-// 	defer bs.mutex.RUnlock()
-
-// 	status := make(map[string]any)
-// 	status["is_running"] = bs.isRunning
-
-// 	channelStatus := make(map[string]any)
-// 	channels := bs.peer.GetChannelManager().GetChannelNames()
-
-// 	for _, channelID := range channels {
-// 		height := bs.blockStorage.GetChannelHeight(channelID)
-// 		needsSync, _ := bs.checkChannelNeedsSync(channelID)
-
-// 		channelStatus[channelID] = map[string]any{
-// 			"height":     height,
-// 			"needs_sync": needsSync,
-// 		}
-// 	}
-
-// 	status["channels"] = channelStatus
-// 	return status
-// }
-
-// // ForceSync forces synchronization for a specific channel
-// func (bs *BlockSynchronizer) ForceSync(ctx context.Context, channelID string) error {
-// 	logger.Infof("Forcing synchronization for channel %s", channelID)
-
-// 	config := DefaultSyncConfig()
-// 	return bs.syncChannel(ctx, channelID, config)
-// }
+type BlockSynchronizer struct {
+	peer          *core.Peer
+	ordererClient *common.OrdererClient
+	blockStorage  *storage.BlockStorage
+	mutex         sync.RWMutex
+	isRunning     bool
+	syncing       map[string]bool
+	streaming     map[string]bool
+	stopChan      chan struct{}
+
+	// 동기화 실행 중 새로 추가된 채널의 streaming을 시작하기 위해 보관
+	ctx        context.Context
+	cancel     context.CancelFunc
+	config     *SyncConfig
+	hookAdded  bool
+	storeMutex sync.Mutex
+}
+
+// SyncConfig contains configuration for block synchronization
+type SyncConfig struct {
+	BatchSize    uint64        // Number of blocks to sync in each batch
+	SyncInterval time.Duration // Interval between sync attempts
+	MaxRetries   int           // Maximum number of retry attempts
+	RetryDelay   time.Duration // Delay between retries
+
+	// PriorityChannels 먼저 동기화할 채널 (목록 순서대로, 나머지는 알파벳 순)
+	PriorityChannels []string
+	// MaxSyncErrors 한 번의 전체 동기화에서 허용할 채널 단위 에러 수 (0 이하이면 제한 없음)
+	MaxSyncErrors int
+}
+
+// ChannelSyncStatus 채널별 동기화 상태
+type ChannelSyncStatus struct {
+	ChannelID    string
+	LocalHeight  uint64
+	RemoteHeight uint64
+	Lag          uint64
+	IsSyncing    bool
+}
+
+// DefaultSyncConfig returns default synchronization configuration
+func DefaultSyncConfig() *SyncConfig {
+	return &SyncConfig{
+		BatchSize:    50,
+		SyncInterval: 30 * time.Second,
+		MaxRetries:   3,
+		RetryDelay:   5 * time.Second,
+	}
+}
+
+// NewBlockSynchronizer creates a new block synchronizer
+func NewBlockSynchronizer(peer *core.Peer, ordererClient *common.OrdererClient, blockStorage *storage.BlockStorage) *BlockSynchronizer {
+	return &BlockSynchronizer{
+		peer:          peer,
+		ordererClient: ordererClient,
+		blockStorage:  blockStorage,
+		syncing:       make(map[string]bool),
+		streaming:     make(map[string]bool),
+		stopChan:      make(chan struct{}),
+	}
+}
+
+// StartSync starts the block synchronization process
+// 참여한 채널마다 orderer deliver stream으로 새 블록을 받아 저장하고, 주기적인 polling 동기화로 누락을 보완한다.
+func (bs *BlockSynchronizer) StartSync(ctx context.Context, config *SyncConfig) error {
+	if config == nil {
+		config = DefaultSyncConfig()
+	}
+
+	bs.mutex.Lock()
+	if bs.isRunning {
+		bs.mutex.Unlock()
+		return errors.New("synchronization is already running")
+	}
+	bs.isRunning = true
+	bs.ctx, bs.cancel = context.WithCancel(ctx)
+	bs.config = config
+	registerHook := !bs.hookAdded
+	bs.hookAdded = true
+	bs.mutex.Unlock()
+
+	logger.Info("Starting block synchronization service")
+	if registerHook {
+		bs.RegisterChannelAddedHook(bs.startChannelStream)
+	}
+	bs.startStreamingSync()
+
+	// Start synchronization goroutine
+	go bs.syncLoop(bs.ctx, config)
+
+	return nil
+}
+
+// RegisterChannelAddedHook peer에 새 채널이 추가될 때 호출될 hook 등록
+// 동기화가 시작되면 새 채널의 블록 streaming을 시작하는 hook이 자동으로 등록된다.
+func (bs *BlockSynchronizer) RegisterChannelAddedHook(fn func(channelID string)) {
+	bs.peer.ChannelManager.RegisterChannelAddedHook(fn)
+}
+
+// startStreamingSync 블록 저장소에 있는 모든 채널의 블록 streaming 시작
+// 이후 참여하는 채널은 channel added hook으로 시작된다.
+func (bs *BlockSynchronizer) startStreamingSync() {
+	channelIDs, err := bs.blockStorage.ListChannels()
+	if err != nil {
+		logger.Errorf("Failed to list channels for streaming sync: %v", err)
+		return
+	}
+	for _, channelID := range channelIDs {
+		bs.startChannelStream(channelID)
+	}
+}
+
+// startChannelStream 동기화 실행 중이고 아직 streaming 중이 아닌 채널의 streaming goroutine 시작
+func (bs *BlockSynchronizer) startChannelStream(channelID string) {
+	bs.mutex.Lock()
+	defer bs.mutex.Unlock()
+
+	if !bs.isRunning || bs.streaming[channelID] {
+		return
+	}
+	bs.streaming[channelID] = true
+	go bs.streamChannelBlocks(bs.ctx, channelID, bs.config)
+}
+
+// streamChannelBlocks 로컬 높이부터 orderer가 전달하는 블록을 저장 (stream이 끊기면 RetryDelay 후 재연결)
+func (bs *BlockSynchronizer) streamChannelBlocks(ctx context.Context, channelID string, config *SyncConfig) {
+	defer func() {
+		bs.mutex.Lock()
+		delete(bs.streaming, channelID)
+		bs.mutex.Unlock()
+	}()
+
+	logger.Infof("Streaming blocks of channel %s", channelID)
+	for {
+		startBlock := bs.blockStorage.GetChannelHeight(channelID)
+		err := bs.ordererClient.DeliverBlocks(ctx, channelID, startBlock, func(block *pb_common.Block) error {
+			_, err := bs.storeBlock(channelID, block)
+			return err
+		})
+		if ctx.Err() != nil {
+			return
+		}
+		logger.Warnf("Block stream of channel %s stopped: %v", channelID, err)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(config.RetryDelay):
+		}
+	}
+}
+
+// storeBlock 아직 저장되지 않은 블록만 저장하고 orderer에 수신 확인
+// streaming과 polling 동기화가 같은 블록을 받아도 한 번만 저장된다.
+func (bs *BlockSynchronizer) storeBlock(channelID string, block *pb_common.Block) (bool, error) {
+	bs.storeMutex.Lock()
+	defer bs.storeMutex.Unlock()
+
+	if block.GetHeader().GetNumber() < bs.blockStorage.GetChannelHeight(channelID) {
+		return false, nil
+	}
+	if err := blockutil.ValidateBlock(block); err != nil {
+		return false, errors.Wrap(err, "received invalid block")
+	}
+	if err := bs.peer.VerifyBlock(channelID, block); err != nil {
+		return false, errors.Wrapf(err, "failed to verify block %d", block.GetHeader().GetNumber())
+	}
+	if err := bs.blockStorage.StoreBlock(channelID, block); err != nil {
+		return false, errors.Wrapf(err, "failed to store block %d", block.GetHeader().GetNumber())
+	}
+	logger.Debugf("Synced block %d for channel %s", block.Header.Number, channelID)
+	bs.acknowledgeBlock(channelID, block.Header.Number)
+	return true, nil
+}
+
+// StopSync stops the block synchronization process
+func (bs *BlockSynchronizer) StopSync() {
+	bs.mutex.Lock()
+	defer bs.mutex.Unlock()
+
+	if !bs.isRunning {
+		return
+	}
+
+	logger.Info("Stopping block synchronization service")
+	close(bs.stopChan)
+	bs.cancel()
+	bs.isRunning = false
+}
+
+// syncLoop is the main synchronization loop
+func (bs *BlockSynchronizer) syncLoop(ctx context.Context, config *SyncConfig) {
+	ticker := time.NewTicker(config.SyncInterval)
+	defer ticker.Stop()
+
+	bs.syncAllChannels(ctx, config)
+
+	for {
+		select {
+		case <-ctx.Done():
+			logger.Info("Block synchronization stopped due to context cancellation")
+			return
+		case <-bs.stopChan:
+			logger.Info("Block synchronization stopped")
+			return
+		case <-ticker.C:
+			bs.syncAllChannels(ctx, config)
+		}
+	}
+}
+
+// syncAllChannels peer가 참여한 모든 채널을 우선순위 순서로 동기화 (실패한 채널은 로그만 남김)
+// 에러가 MaxSyncErrors에 도달하면 남은 채널은 다음 주기로 미룬다.
+func (bs *BlockSynchronizer) syncAllChannels(ctx context.Context, config *SyncConfig) {
+	errorCount := 0
+	for _, channelID := range orderChannels(bs.peer.ChannelManager.GetChannelNames(), config.PriorityChannels) {
+		if err := bs.SyncChannel(ctx, channelID, config); err != nil {
+			logger.Errorf("Failed to sync channel %s: %v", channelID, err)
+			errorCount++
+			if config.MaxSyncErrors > 0 && errorCount >= config.MaxSyncErrors {
+				logger.Warnf("Stopping sync after %d channel error(s)", errorCount)
+				return
+			}
+		}
+	}
+}
+
+// orderChannels priority에 있는 채널을 목록 순서대로 앞에 두고, 나머지는 알파벳 순으로 정렬
+// channelIDs에 없는 priority 채널은 무시한다.
+func orderChannels(channelIDs, priority []string) []string {
+	remaining := make(map[string]bool, len(channelIDs))
+	for _, channelID := range channelIDs {
+		remaining[channelID] = true
+	}
+
+	ordered := make([]string, 0, len(channelIDs))
+	for _, channelID := range priority {
+		if remaining[channelID] {
+			ordered = append(ordered, channelID)
+			delete(remaining, channelID)
+		}
+	}
+	rest := make([]string, 0, len(remaining))
+	for channelID := range remaining {
+		rest = append(rest, channelID)
+	}
+	sort.Strings(rest)
+	return append(ordered, rest...)
+}
+
+// SyncAll 블록 저장소의 모든 채널을 우선순위 순서로 orderer 높이까지 동기화하고 끝날 때까지 대기
+// 실패한 채널이 있어도 MaxSyncErrors에 도달하기 전까지는 나머지 채널을 계속 동기화하며, 실패한 채널들을 묶어 에러로 반환한다.
+// 다른 goroutine에서 이미 동기화 중인 채널은 건너뛴다.
+func (bs *BlockSynchronizer) SyncAll(ctx context.Context) error {
+	bs.mutex.RLock()
+	config := bs.config
+	bs.mutex.RUnlock()
+	if config == nil {
+		config = DefaultSyncConfig()
+	}
+
+	channelIDs, err := bs.blockStorage.ListChannels()
+	if err != nil {
+		return errors.Wrap(err, "failed to list channels")
+	}
+
+	var failures []string
+	for _, channelID := range orderChannels(channelIDs, config.PriorityChannels) {
+		if err := ctx.Err(); err != nil {
+			return errors.Wrap(err, "sync cancelled")
+		}
+		if err := bs.SyncChannel(ctx, channelID, config); err != nil {
+			failures = append(failures, fmt.Sprintf("%s: %v", channelID, err))
+			if config.MaxSyncErrors > 0 && len(failures) >= config.MaxSyncErrors {
+				break
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return errors.Errorf("failed to sync %d channel(s): %s", len(failures), strings.Join(failures, "; "))
+	}
+	return nil
+}
+
+// SyncedChannels 블록 저장소의 채널별 로컬 블록 높이 스냅샷
+func (bs *BlockSynchronizer) SyncedChannels() map[string]uint64 {
+	channelIDs, err := bs.blockStorage.ListChannels()
+	if err != nil {
+		logger.Errorf("Failed to list channels: %v", err)
+		return map[string]uint64{}
+	}
+
+	heights := make(map[string]uint64, len(channelIDs))
+	for _, channelID := range channelIDs {
+		heights[channelID] = bs.blockStorage.GetChannelHeight(channelID)
+	}
+	return heights
+}
+
+// SyncChannel synchronizes blocks for a specific channel up to the orderer's height
+func (bs *BlockSynchronizer) SyncChannel(ctx context.Context, channelID string, config *SyncConfig) error {
+	if config == nil {
+		config = DefaultSyncConfig()
+	}
+	if !bs.setSyncing(channelID, true) {
+		logger.Debugf("Channel %s is already syncing", channelID)
+		return nil
+	}
+	defer bs.setSyncing(channelID, false)
+
+	localHeight := bs.blockStorage.GetChannelHeight(channelID)
+	remoteHeight, err := bs.ordererClient.GetChannelHeight(channelID)
+	if err != nil {
+		return errors.Wrap(err, "failed to get remote height")
+	}
+
+	if localHeight >= remoteHeight {
+		logger.Debugf("Channel %s is up to date (local: %d, remote: %d)", channelID, localHeight, remoteHeight)
+		return nil
+	}
+
+	logger.Infof("Syncing channel %s from block %d to %d", channelID, localHeight, remoteHeight)
+
+	// Sync blocks in batches
+	for startBlock := localHeight; startBlock < remoteHeight; startBlock += config.BatchSize {
+		endBlock := min(startBlock+config.BatchSize, remoteHeight)
+
+		if err := bs.syncBlockRange(ctx, channelID, startBlock, endBlock, config); err != nil {
+			return errors.Wrapf(err, "failed to sync block range %d-%d", startBlock, endBlock)
+		}
+	}
+
+	return nil
+}
+
+// syncBlockRange synchronizes a range of blocks for a channel
+func (bs *BlockSynchronizer) syncBlockRange(ctx context.Context, channelID string, startBlock, endBlock uint64, config *SyncConfig) error {
+	for blockNumber := startBlock; blockNumber < endBlock; blockNumber++ {
+		var lastErr error
+		for attempt := 0; attempt < config.MaxRetries; attempt++ {
+			if attempt > 0 {
+				logger.Infof("Retrying block sync for channel %s, attempt %d/%d", channelID, attempt+1, config.MaxRetries)
+				select {
+				case <-ctx.Done():
+					return ctx.Err()
+				case <-time.After(config.RetryDelay):
+				}
+			}
+
+			block, err := bs.ordererClient.GetBlock(channelID, blockNumber)
+			if err != nil {
+				lastErr = err
+				logger.Warnf("Failed to get block %d from orderer (attempt %d): %v", blockNumber, attempt+1, err)
+				continue
+			}
+			if _, err := bs.storeBlock(channelID, block); err != nil {
+				return err
+			}
+			lastErr = nil
+			break
+		}
+		if lastErr != nil {
+			return errors.Wrapf(lastErr, "failed to sync block %d after %d attempts", blockNumber, config.MaxRetries)
+		}
+	}
+	return nil
+}
+
+// acknowledgeBlock 블록 저장 사실을 orderer에 알림 (실패해도 동기화는 계속 진행)
+func (bs *BlockSynchronizer) acknowledgeBlock(channelID string, blockNumber uint64) {
+	if bs.peer.Peer == nil || bs.peer.Peer.MSP == nil {
+		return
+	}
+	if err := bs.ordererClient.NotifyBlockReceived(channelID, blockNumber, bs.peer.Peer.ID, bs.peer.Peer.MSP.GetSigningIdentity()); err != nil {
+		logger.Warnf("Failed to acknowledge block %d of channel %s: %v", blockNumber, channelID, err)
+	}
+}
+
+// setSyncing 채널의 동기화 진행 여부 설정 (이미 진행 중인 채널을 true로 설정하려 하면 false 반환)
+func (bs *BlockSynchronizer) setSyncing(channelID string, syncing bool) bool {
+	bs.mutex.Lock()
+	defer bs.mutex.Unlock()
+
+	if syncing && bs.syncing[channelID] {
+		return false
+	}
+	if syncing {
+		bs.syncing[channelID] = true
+	} else {
+		delete(bs.syncing, channelID)
+	}
+	return true
+}
+
+// IsRunning 동기화 루프 실행 여부
+func (bs *BlockSynchronizer) IsRunning() bool {
+	bs.mutex.RLock()
+	defer bs.mutex.RUnlock()
+
+	return bs.isRunning
+}
+
+// GetSyncStatus returns the current synchronization status of the given channels
+// channelIDs가 비어있으면 peer가 참여한 모든 채널을 조회하며, orderer 높이를 조회할 수 없는 채널은
+// RemoteHeight를 로컬 높이로 간주한다.
+func (bs *BlockSynchronizer) GetSyncStatus(channelIDs ...string) []ChannelSyncStatus {
+	if len(channelIDs) == 0 {
+		channelIDs = bs.peer.ChannelManager.GetChannelNames()
+	} else {
+		channelIDs = append([]string(nil), channelIDs...)
+		sort.Strings(channelIDs)
+	}
+
+	statuses := make([]ChannelSyncStatus, 0, len(channelIDs))
+	for _, channelID := range channelIDs {
+		localHeight := bs.blockStorage.GetChannelHeight(channelID)
+		remoteHeight, err := bs.ordererClient.GetChannelHeight(channelID)
+		if err != nil {
+			logger.Warnf("Failed to get remote height of channel %s: %v", channelID, err)
+			remoteHeight = localHeight
+		}
+
+		status := ChannelSyncStatus{
+			ChannelID:    channelID,
+			LocalHeight:  localHeight,
+			RemoteHeight: remoteHeight,
+		}
+		if remoteHeight > localHeight {
+			status.Lag = remoteHeight - localHeight
+		}
+
+		bs.mutex.RLock()
+		status.IsSyncing = bs.syncing[channelID]
+		bs.mutex.RUnlock()
+
+		statuses = append(statuses, status)
+	}
+	return statuses
+}
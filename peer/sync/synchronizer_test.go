@@ -0,0 +1,193 @@
+package sync
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ddr4869/minifab/common/msp/msptest"
+	"github.com/ddr4869/minifab/config"
+	"github.com/ddr4869/minifab/orderer/orderertest"
+	"github.com/ddr4869/minifab/peer/common"
+	"github.com/ddr4869/minifab/peer/core"
+	"github.com/ddr4869/minifab/peer/storage"
+	"github.com/pkg/errors"
+)
+
+// newTestSynchronizer 채널에 참여하지 않은 peer와 orderer에 연결된 BlockSynchronizer
+func newTestSynchronizer(t *testing.T, orderer *orderertest.Server, org *msptest.Org) *BlockSynchronizer {
+	t.Helper()
+
+	client, err := common.NewOrdererClient(orderer.Address)
+	if err != nil {
+		t.Fatalf("NewOrdererClient: %v", err)
+	}
+	t.Cleanup(func() { client.Close() })
+
+	blockStorage := storage.NewBlockStorageWithPath(t.TempDir())
+	peer := &core.Peer{
+		Peer:           &config.PeerCfg{ID: "peer0", MSPID: org.MSPID, MSP: org.MSP},
+		OrdererClient:  client,
+		BlockStorage:   blockStorage,
+		ChannelManager: core.NewChannelManager(blockStorage),
+	}
+	return NewBlockSynchronizer(peer, client, blockStorage)
+}
+
+// waitForHeight channelID의 로컬 높이가 height가 될 때까지 timeout 동안 대기
+func waitForHeight(t *testing.T, bs *BlockSynchronizer, channelID string, height uint64, timeout time.Duration) {
+	t.Helper()
+
+	deadline := time.Now().Add(timeout)
+	for bs.blockStorage.GetChannelHeight(channelID) < height {
+		if time.Now().After(deadline) {
+			t.Fatalf("channel %s height = %d after %s, want %d", channelID, bs.blockStorage.GetChannelHeight(channelID), timeout, height)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestStartSyncStreamsChannelJoinedLater(t *testing.T) {
+	orderer := orderertest.NewServer(t)
+	org := msptest.NewOrg(t, "Org1MSP")
+	bs := newTestSynchronizer(t, orderer, org)
+
+	var hooked []string
+	bs.RegisterChannelAddedHook(func(channelID string) { hooked = append(hooked, channelID) })
+
+	// polling 동기화는 시작 시 한 번만 실행되므로 이후 블록은 streaming으로만 받는다
+	syncConfig := &SyncConfig{BatchSize: 10, SyncInterval: time.Hour, MaxRetries: 1, RetryDelay: 50 * time.Millisecond}
+	if err := bs.StartSync(context.Background(), syncConfig); err != nil {
+		t.Fatalf("StartSync: %v", err)
+	}
+	defer bs.StopSync()
+
+	genesis := orderer.NewChannel(t, "mychannel", org)
+	if err := bs.peer.ChannelManager.JoinChannelByBlock("mychannel", genesis); err != nil {
+		t.Fatalf("JoinChannelByBlock: %v", err)
+	}
+	if len(hooked) != 1 || hooked[0] != "mychannel" {
+		t.Errorf("registered hook was called with %v, want [mychannel]", hooked)
+	}
+
+	orderer.AppendBlocks(t, "mychannel", 3)
+	waitForHeight(t, bs, "mychannel", 4, 2*time.Second)
+
+	// stream이 유지되는 동안 커밋된 블록도 이어서 저장된다
+	orderer.AppendBlocks(t, "mychannel", 2)
+	waitForHeight(t, bs, "mychannel", 6, 2*time.Second)
+
+	// 이미 참여한 채널에 다시 참여해도 hook은 호출되지 않는다
+	if err := bs.peer.ChannelManager.JoinChannelByBlock("mychannel", genesis); err != nil {
+		t.Fatalf("JoinChannelByBlock: %v", err)
+	}
+	if len(hooked) != 1 {
+		t.Errorf("hook was called %d times, want 1", len(hooked))
+	}
+}
+
+func TestSyncAllReportsSyncedHeights(t *testing.T) {
+	orderer := orderertest.NewServer(t)
+	org := msptest.NewOrg(t, "Org1MSP")
+	bs := newTestSynchronizer(t, orderer, org)
+
+	want := make(map[string]uint64)
+	for i := 0; i < 5; i++ {
+		channelID := fmt.Sprintf("channel%d", i)
+		genesis := orderer.NewChannel(t, channelID, org)
+		if err := bs.peer.ChannelManager.JoinChannelByBlock(channelID, genesis); err != nil {
+			t.Fatalf("JoinChannelByBlock(%s): %v", channelID, err)
+		}
+		orderer.AppendBlocks(t, channelID, i*2)
+		want[channelID] = uint64(i*2 + 1)
+	}
+
+	if err := bs.SyncAll(context.Background()); err != nil {
+		t.Fatalf("SyncAll: %v", err)
+	}
+	if got := bs.SyncedChannels(); !reflect.DeepEqual(got, want) {
+		t.Errorf("SyncedChannels() = %v, want %v", got, want)
+	}
+}
+
+func TestSyncAllCancelled(t *testing.T) {
+	orderer := orderertest.NewServer(t)
+	org := msptest.NewOrg(t, "Org1MSP")
+	bs := newTestSynchronizer(t, orderer, org)
+	if err := bs.peer.ChannelManager.JoinChannelByBlock("mychannel", orderer.NewChannel(t, "mychannel", org)); err != nil {
+		t.Fatalf("JoinChannelByBlock: %v", err)
+	}
+	orderer.AppendBlocks(t, "mychannel", 2)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := bs.SyncAll(ctx); !errors.Is(err, context.Canceled) {
+		t.Fatalf("SyncAll with cancelled context = %v, want context.Canceled", err)
+	}
+	if got := bs.SyncedChannels()["mychannel"]; got != 1 {
+		t.Errorf("mychannel height = %d after cancelled sync, want 1", got)
+	}
+}
+
+func TestOrderChannels(t *testing.T) {
+	channelIDs := []string{"echannel", "bchannel", "dchannel", "achannel", "cchannel"}
+	tests := []struct {
+		name     string
+		priority []string
+		want     []string
+	}{
+		{"no priority", nil, []string{"achannel", "bchannel", "cchannel", "dchannel", "echannel"}},
+		{"priority in list order", []string{"dchannel", "bchannel"}, []string{"dchannel", "bchannel", "achannel", "cchannel", "echannel"}},
+		{"unknown priority channel ignored", []string{"zchannel", "cchannel"}, []string{"cchannel", "achannel", "bchannel", "dchannel", "echannel"}},
+		{"duplicate priority channel", []string{"echannel", "echannel"}, []string{"echannel", "achannel", "bchannel", "cchannel", "dchannel"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := orderChannels(channelIDs, tt.priority); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("orderChannels = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSyncAllSyncsPriorityChannelsFirst(t *testing.T) {
+	orderer := orderertest.NewServer(t)
+	org := msptest.NewOrg(t, "Org1MSP")
+	bs := newTestSynchronizer(t, orderer, org)
+
+	// 다른 orderer에서 만든 채널이라 동기화가 항상 실패하므로, 에러 순서가 동기화 순서가 된다
+	other := orderertest.NewServer(t)
+	for _, channelID := range []string{"channel1", "channel2", "channel3", "channel4", "channel5"} {
+		if err := bs.peer.ChannelManager.JoinChannelByBlock(channelID, other.NewChannel(t, channelID, org)); err != nil {
+			t.Fatalf("JoinChannelByBlock(%s): %v", channelID, err)
+		}
+	}
+	bs.config = &SyncConfig{
+		BatchSize:        10,
+		MaxRetries:       1,
+		RetryDelay:       time.Millisecond,
+		PriorityChannels: []string{"channel4", "channel2"},
+		MaxSyncErrors:    2,
+	}
+
+	err := bs.SyncAll(context.Background())
+	if err == nil {
+		t.Fatal("SyncAll succeeded for channels unknown to the orderer")
+	}
+	message := err.Error()
+	if !strings.Contains(message, "failed to sync 2 channel(s)") {
+		t.Fatalf("SyncAll error = %q, want it to stop after 2 channel errors", message)
+	}
+	first, second := strings.Index(message, "channel4:"), strings.Index(message, "channel2:")
+	if first < 0 || second < first {
+		t.Errorf("SyncAll error = %q, want channel4 then channel2", message)
+	}
+	for _, channelID := range []string{"channel1", "channel3", "channel5"} {
+		if strings.Contains(message, channelID+":") {
+			t.Errorf("SyncAll synced %s before the priority channels finished: %q", channelID, message)
+		}
+	}
+}
@@ -46,6 +46,7 @@ const (
 	Status_BLOCK_VALIDATION_FAILED       Status = 17
 	Status_INVALID_BLOCK                 Status = 18
 	Status_INVALID_TRANSACTION_FORMAT    Status = 19
+	Status_REQUEST_ENTITY_TOO_LARGE      Status = 20
 )
 
 // Enum value maps for Status.
@@ -71,6 +72,7 @@ var (
 		17: "BLOCK_VALIDATION_FAILED",
 		18: "INVALID_BLOCK",
 		19: "INVALID_TRANSACTION_FORMAT",
+		20: "REQUEST_ENTITY_TOO_LARGE",
 	}
 	Status_value = map[string]int32{
 		"OK":                            0,
@@ -93,6 +95,7 @@ var (
 		"BLOCK_VALIDATION_FAILED":       17,
 		"INVALID_BLOCK":                 18,
 		"INVALID_TRANSACTION_FORMAT":    19,
+		"REQUEST_ENTITY_TOO_LARGE":      20,
 	}
 )
 
@@ -477,6 +480,7 @@ type BlockHeader struct {
 	CurrentBlockHash []byte                 `protobuf:"bytes,2,opt,name=current_block_hash,json=currentBlockHash,proto3" json:"current_block_hash,omitempty"`    // 현재 Block Hash
 	PreviousHash     []byte                 `protobuf:"bytes,3,opt,name=previous_hash,json=previousHash,proto3" json:"previous_hash,omitempty"`                  // 이전 Block Header Hash
 	HeaderType       BlockType              `protobuf:"varint,4,opt,name=header_type,json=headerType,proto3,enum=common.BlockType" json:"header_type,omitempty"` // 블록 타입 구분
+	DataHash         []byte                 `protobuf:"bytes,5,opt,name=data_hash,json=dataHash,proto3" json:"data_hash,omitempty"`                              // Block Data 해시
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -539,6 +543,13 @@ func (x *BlockHeader) GetHeaderType() BlockType {
 	return BlockType_BLOCK_TYPE_UNSPECIFIED
 }
 
+func (x *BlockHeader) GetDataHash() []byte {
+	if x != nil {
+		return x.DataHash
+	}
+	return nil
+}
+
 // Block Data - Ordering service에 의해 정렬된 트랜잭션들
 type BlockData struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -660,7 +671,9 @@ type Transaction struct {
 	Payload       []byte                 `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"`       // 트랜잭션 데이터
 	Signature     []byte                 `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`   // 서명 - endorser
 	Identity      *Identity              `protobuf:"bytes,4,opt,name=identity,proto3" json:"identity,omitempty"`
-	Timestamp     int64                  `protobuf:"varint,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"` // 트랜잭션 생성 시간
+	Timestamp     int64                  `protobuf:"varint,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`                       // 트랜잭션 생성 시간
+	Type          MessageType            `protobuf:"varint,6,opt,name=type,proto3,enum=common.MessageType" json:"type,omitempty"`         // 트랜잭션 타입 (설정/일반)
+	ChaincodeId   string                 `protobuf:"bytes,7,opt,name=chaincode_id,json=chaincodeId,proto3" json:"chaincode_id,omitempty"` // 트랜잭션을 실행한 체인코드 이름 (체인코드 트랜잭션이 아니면 비어 있음)
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -730,6 +743,20 @@ func (x *Transaction) GetTimestamp() int64 {
 	return 0
 }
 
+func (x *Transaction) GetType() MessageType {
+	if x != nil {
+		return x.Type
+	}
+	return MessageType_MESSAGE_TYPE_UNSPECIFIED
+}
+
+func (x *Transaction) GetChaincodeId() string {
+	if x != nil {
+		return x.ChaincodeId
+	}
+	return ""
+}
+
 type Identity struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Creator       []byte                 `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
@@ -803,29 +830,32 @@ const file_proto_common_common_proto_rawDesc = "" +
 	"\x05Block\x12+\n" +
 	"\x06header\x18\x01 \x01(\v2\x13.common.BlockHeaderR\x06header\x12%\n" +
 	"\x04data\x18\x02 \x01(\v2\x11.common.BlockDataR\x04data\x121\n" +
-	"\bmetadata\x18\x03 \x01(\v2\x15.common.BlockMetadataR\bmetadata\"\xac\x01\n" +
+	"\bmetadata\x18\x03 \x01(\v2\x15.common.BlockMetadataR\bmetadata\"\xc9\x01\n" +
 	"\vBlockHeader\x12\x16\n" +
 	"\x06number\x18\x01 \x01(\x04R\x06number\x12,\n" +
 	"\x12current_block_hash\x18\x02 \x01(\fR\x10currentBlockHash\x12#\n" +
 	"\rprevious_hash\x18\x03 \x01(\fR\fpreviousHash\x122\n" +
 	"\vheader_type\x18\x04 \x01(\x0e2\x11.common.BlockTypeR\n" +
-	"headerType\"/\n" +
+	"headerType\x12\x1b\n" +
+	"\tdata_hash\x18\x05 \x01(\fR\bdataHash\"/\n" +
 	"\tBlockData\x12\"\n" +
 	"\ftransactions\x18\x01 \x03(\fR\ftransactions\"\xb3\x01\n" +
 	"\rBlockMetadata\x12\x1c\n" +
 	"\tsignature\x18\x01 \x01(\fR\tsignature\x12+\n" +
 	"\x11validation_bitmap\x18\x02 \x01(\fR\x10validationBitmap\x12)\n" +
 	"\x10accumulated_hash\x18\x03 \x01(\fR\x0faccumulatedHash\x12,\n" +
-	"\bidentity\x18\x04 \x01(\v2\x10.common.IdentityR\bidentity\"\xa6\x01\n" +
+	"\bidentity\x18\x04 \x01(\v2\x10.common.IdentityR\bidentity\"\xf2\x01\n" +
 	"\vTransaction\x12\x13\n" +
 	"\x05tx_id\x18\x01 \x01(\tR\x04txId\x12\x18\n" +
 	"\apayload\x18\x02 \x01(\fR\apayload\x12\x1c\n" +
 	"\tsignature\x18\x03 \x01(\fR\tsignature\x12,\n" +
 	"\bidentity\x18\x04 \x01(\v2\x10.common.IdentityR\bidentity\x12\x1c\n" +
-	"\ttimestamp\x18\x05 \x01(\x03R\ttimestamp\";\n" +
+	"\ttimestamp\x18\x05 \x01(\x03R\ttimestamp\x12'\n" +
+	"\x04type\x18\x06 \x01(\x0e2\x13.common.MessageTypeR\x04type\x12!\n" +
+	"\fchaincode_id\x18\a \x01(\tR\vchaincodeId\";\n" +
 	"\bIdentity\x12\x18\n" +
 	"\acreator\x18\x01 \x01(\fR\acreator\x12\x15\n" +
-	"\x06msp_id\x18\x02 \x01(\tR\x05mspId*\xb5\x03\n" +
+	"\x06msp_id\x18\x02 \x01(\tR\x05mspId*\xd3\x03\n" +
 	"\x06Status\x12\x06\n" +
 	"\x02OK\x10\x00\x12\x14\n" +
 	"\x10INVALID_ARGUMENT\x10\x01\x12\r\n" +
@@ -847,7 +877,8 @@ const file_proto_common_common_proto_rawDesc = "" +
 	"\tMSP_ERROR\x10\x10\x12\x1b\n" +
 	"\x17BLOCK_VALIDATION_FAILED\x10\x11\x12\x11\n" +
 	"\rINVALID_BLOCK\x10\x12\x12\x1e\n" +
-	"\x1aINVALID_TRANSACTION_FORMAT\x10\x13*z\n" +
+	"\x1aINVALID_TRANSACTION_FORMAT\x10\x13\x12\x1c\n" +
+	"\x18REQUEST_ENTITY_TOO_LARGE\x10\x14*z\n" +
 	"\vMessageType\x12\x1c\n" +
 	"\x18MESSAGE_TYPE_UNSPECIFIED\x10\x00\x12\x16\n" +
 	"\x12MESSAGE_TYPE_BLOCK\x10\x01\x12\x1c\n" +
@@ -898,11 +929,12 @@ var file_proto_common_common_proto_depIdxs = []int32{
 	2,  // 7: common.BlockHeader.header_type:type_name -> common.BlockType
 	11, // 8: common.BlockMetadata.identity:type_name -> common.Identity
 	11, // 9: common.Transaction.identity:type_name -> common.Identity
-	10, // [10:10] is the sub-list for method output_type
-	10, // [10:10] is the sub-list for method input_type
-	10, // [10:10] is the sub-list for extension type_name
-	10, // [10:10] is the sub-list for extension extendee
-	0,  // [0:10] is the sub-list for field type_name
+	1,  // 10: common.Transaction.type:type_name -> common.MessageType
+	11, // [11:11] is the sub-list for method output_type
+	11, // [11:11] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
 }
 
 func init() { file_proto_common_common_proto_init() }
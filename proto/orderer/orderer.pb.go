@@ -74,6 +74,1032 @@ func (x *BroadcastResponse) GetBlock() *common.Block {
 	return nil
 }
 
+type ListChannelsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Offset        uint64                 `protobuf:"varint,1,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit         uint32                 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"` // 0이면 offset 이후 전체
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListChannelsRequest) Reset() {
+	*x = ListChannelsRequest{}
+	mi := &file_proto_orderer_orderer_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListChannelsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListChannelsRequest) ProtoMessage() {}
+
+func (x *ListChannelsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_orderer_orderer_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListChannelsRequest.ProtoReflect.Descriptor instead.
+func (*ListChannelsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_orderer_orderer_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListChannelsRequest) GetOffset() uint64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *ListChannelsRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListChannelsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        common.Status          `protobuf:"varint,1,opt,name=status,proto3,enum=common.Status" json:"status,omitempty"`
+	Channels      []string               `protobuf:"bytes,2,rep,name=channels,proto3" json:"channels,omitempty"`
+	HasMore       bool                   `protobuf:"varint,3,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListChannelsResponse) Reset() {
+	*x = ListChannelsResponse{}
+	mi := &file_proto_orderer_orderer_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListChannelsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListChannelsResponse) ProtoMessage() {}
+
+func (x *ListChannelsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_orderer_orderer_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListChannelsResponse.ProtoReflect.Descriptor instead.
+func (*ListChannelsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_orderer_orderer_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListChannelsResponse) GetStatus() common.Status {
+	if x != nil {
+		return x.Status
+	}
+	return common.Status(0)
+}
+
+func (x *ListChannelsResponse) GetChannels() []string {
+	if x != nil {
+		return x.Channels
+	}
+	return nil
+}
+
+func (x *ListChannelsResponse) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+type BlockRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChannelId     string                 `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	BlockNumber   uint64                 `protobuf:"varint,2,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BlockRequest) Reset() {
+	*x = BlockRequest{}
+	mi := &file_proto_orderer_orderer_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockRequest) ProtoMessage() {}
+
+func (x *BlockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_orderer_orderer_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockRequest.ProtoReflect.Descriptor instead.
+func (*BlockRequest) Descriptor() ([]byte, []int) {
+	return file_proto_orderer_orderer_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *BlockRequest) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *BlockRequest) GetBlockNumber() uint64 {
+	if x != nil {
+		return x.BlockNumber
+	}
+	return 0
+}
+
+type BlockResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        common.Status          `protobuf:"varint,1,opt,name=status,proto3,enum=common.Status" json:"status,omitempty"`
+	Block         *common.Block          `protobuf:"bytes,2,opt,name=block,proto3" json:"block,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BlockResponse) Reset() {
+	*x = BlockResponse{}
+	mi := &file_proto_orderer_orderer_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlockResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockResponse) ProtoMessage() {}
+
+func (x *BlockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_orderer_orderer_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockResponse.ProtoReflect.Descriptor instead.
+func (*BlockResponse) Descriptor() ([]byte, []int) {
+	return file_proto_orderer_orderer_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *BlockResponse) GetStatus() common.Status {
+	if x != nil {
+		return x.Status
+	}
+	return common.Status(0)
+}
+
+func (x *BlockResponse) GetBlock() *common.Block {
+	if x != nil {
+		return x.Block
+	}
+	return nil
+}
+
+// BlockRangeRequest - [start_block, end_block) 범위의 블록 조회
+type BlockRangeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChannelId     string                 `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	StartBlock    uint64                 `protobuf:"varint,2,opt,name=start_block,json=startBlock,proto3" json:"start_block,omitempty"`
+	EndBlock      uint64                 `protobuf:"varint,3,opt,name=end_block,json=endBlock,proto3" json:"end_block,omitempty"` // 포함하지 않음
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BlockRangeRequest) Reset() {
+	*x = BlockRangeRequest{}
+	mi := &file_proto_orderer_orderer_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlockRangeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockRangeRequest) ProtoMessage() {}
+
+func (x *BlockRangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_orderer_orderer_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockRangeRequest.ProtoReflect.Descriptor instead.
+func (*BlockRangeRequest) Descriptor() ([]byte, []int) {
+	return file_proto_orderer_orderer_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *BlockRangeRequest) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *BlockRangeRequest) GetStartBlock() uint64 {
+	if x != nil {
+		return x.StartBlock
+	}
+	return 0
+}
+
+func (x *BlockRangeRequest) GetEndBlock() uint64 {
+	if x != nil {
+		return x.EndBlock
+	}
+	return 0
+}
+
+type BlockRangeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        common.Status          `protobuf:"varint,1,opt,name=status,proto3,enum=common.Status" json:"status,omitempty"`
+	Blocks        []*common.Block        `protobuf:"bytes,2,rep,name=blocks,proto3" json:"blocks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BlockRangeResponse) Reset() {
+	*x = BlockRangeResponse{}
+	mi := &file_proto_orderer_orderer_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlockRangeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockRangeResponse) ProtoMessage() {}
+
+func (x *BlockRangeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_orderer_orderer_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockRangeResponse.ProtoReflect.Descriptor instead.
+func (*BlockRangeResponse) Descriptor() ([]byte, []int) {
+	return file_proto_orderer_orderer_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *BlockRangeResponse) GetStatus() common.Status {
+	if x != nil {
+		return x.Status
+	}
+	return common.Status(0)
+}
+
+func (x *BlockRangeResponse) GetBlocks() []*common.Block {
+	if x != nil {
+		return x.Blocks
+	}
+	return nil
+}
+
+type ChannelHeightRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChannelId     string                 `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChannelHeightRequest) Reset() {
+	*x = ChannelHeightRequest{}
+	mi := &file_proto_orderer_orderer_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChannelHeightRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelHeightRequest) ProtoMessage() {}
+
+func (x *ChannelHeightRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_orderer_orderer_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelHeightRequest.ProtoReflect.Descriptor instead.
+func (*ChannelHeightRequest) Descriptor() ([]byte, []int) {
+	return file_proto_orderer_orderer_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ChannelHeightRequest) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+type ChannelHeightResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        common.Status          `protobuf:"varint,1,opt,name=status,proto3,enum=common.Status" json:"status,omitempty"`
+	Height        uint64                 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"` // 저장된 블록 개수 (다음 블록 번호)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChannelHeightResponse) Reset() {
+	*x = ChannelHeightResponse{}
+	mi := &file_proto_orderer_orderer_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChannelHeightResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelHeightResponse) ProtoMessage() {}
+
+func (x *ChannelHeightResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_orderer_orderer_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelHeightResponse.ProtoReflect.Descriptor instead.
+func (*ChannelHeightResponse) Descriptor() ([]byte, []int) {
+	return file_proto_orderer_orderer_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ChannelHeightResponse) GetStatus() common.Status {
+	if x != nil {
+		return x.Status
+	}
+	return common.Status(0)
+}
+
+func (x *ChannelHeightResponse) GetHeight() uint64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+type ChannelConfigRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChannelId     string                 `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChannelConfigRequest) Reset() {
+	*x = ChannelConfigRequest{}
+	mi := &file_proto_orderer_orderer_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChannelConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelConfigRequest) ProtoMessage() {}
+
+func (x *ChannelConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_orderer_orderer_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelConfigRequest.ProtoReflect.Descriptor instead.
+func (*ChannelConfigRequest) Descriptor() ([]byte, []int) {
+	return file_proto_orderer_orderer_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ChannelConfigRequest) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+type ChannelConfigResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        common.Status          `protobuf:"varint,1,opt,name=status,proto3,enum=common.Status" json:"status,omitempty"`
+	Config        []byte                 `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"` // 채널 설정 (들여쓰기된 JSON)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChannelConfigResponse) Reset() {
+	*x = ChannelConfigResponse{}
+	mi := &file_proto_orderer_orderer_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChannelConfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelConfigResponse) ProtoMessage() {}
+
+func (x *ChannelConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_orderer_orderer_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelConfigResponse.ProtoReflect.Descriptor instead.
+func (*ChannelConfigResponse) Descriptor() ([]byte, []int) {
+	return file_proto_orderer_orderer_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ChannelConfigResponse) GetStatus() common.Status {
+	if x != nil {
+		return x.Status
+	}
+	return common.Status(0)
+}
+
+func (x *ChannelConfigResponse) GetConfig() []byte {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+type SystemChannelRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SystemChannelRequest) Reset() {
+	*x = SystemChannelRequest{}
+	mi := &file_proto_orderer_orderer_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SystemChannelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemChannelRequest) ProtoMessage() {}
+
+func (x *SystemChannelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_orderer_orderer_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemChannelRequest.ProtoReflect.Descriptor instead.
+func (*SystemChannelRequest) Descriptor() ([]byte, []int) {
+	return file_proto_orderer_orderer_proto_rawDescGZIP(), []int{11}
+}
+
+type SystemChannelResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        common.Status          `protobuf:"varint,1,opt,name=status,proto3,enum=common.Status" json:"status,omitempty"`
+	Config        []byte                 `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"` // 시스템 채널 설정 (SystemChannelInfo JSON)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SystemChannelResponse) Reset() {
+	*x = SystemChannelResponse{}
+	mi := &file_proto_orderer_orderer_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SystemChannelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemChannelResponse) ProtoMessage() {}
+
+func (x *SystemChannelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_orderer_orderer_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemChannelResponse.ProtoReflect.Descriptor instead.
+func (*SystemChannelResponse) Descriptor() ([]byte, []int) {
+	return file_proto_orderer_orderer_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *SystemChannelResponse) GetStatus() common.Status {
+	if x != nil {
+		return x.Status
+	}
+	return common.Status(0)
+}
+
+func (x *SystemChannelResponse) GetConfig() []byte {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+// BlockReceiptNotification - peer가 블록을 저장했음을 orderer에 알림
+type BlockReceiptNotification struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChannelId     string                 `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	BlockNumber   uint64                 `protobuf:"varint,2,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	PeerId        string                 `protobuf:"bytes,3,opt,name=peer_id,json=peerId,proto3" json:"peer_id,omitempty"`
+	Signature     []byte                 `protobuf:"bytes,4,opt,name=signature,proto3" json:"signature,omitempty"` // "<channel_id>:<block_number>:<peer_id>" 서명
+	Identity      *common.Identity       `protobuf:"bytes,5,opt,name=identity,proto3" json:"identity,omitempty"`   // 서명한 peer의 identity
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BlockReceiptNotification) Reset() {
+	*x = BlockReceiptNotification{}
+	mi := &file_proto_orderer_orderer_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlockReceiptNotification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockReceiptNotification) ProtoMessage() {}
+
+func (x *BlockReceiptNotification) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_orderer_orderer_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockReceiptNotification.ProtoReflect.Descriptor instead.
+func (*BlockReceiptNotification) Descriptor() ([]byte, []int) {
+	return file_proto_orderer_orderer_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *BlockReceiptNotification) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *BlockReceiptNotification) GetBlockNumber() uint64 {
+	if x != nil {
+		return x.BlockNumber
+	}
+	return 0
+}
+
+func (x *BlockReceiptNotification) GetPeerId() string {
+	if x != nil {
+		return x.PeerId
+	}
+	return ""
+}
+
+func (x *BlockReceiptNotification) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+func (x *BlockReceiptNotification) GetIdentity() *common.Identity {
+	if x != nil {
+		return x.Identity
+	}
+	return nil
+}
+
+type BlockReceiptAck struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        common.Status          `protobuf:"varint,1,opt,name=status,proto3,enum=common.Status" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BlockReceiptAck) Reset() {
+	*x = BlockReceiptAck{}
+	mi := &file_proto_orderer_orderer_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlockReceiptAck) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockReceiptAck) ProtoMessage() {}
+
+func (x *BlockReceiptAck) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_orderer_orderer_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockReceiptAck.ProtoReflect.Descriptor instead.
+func (*BlockReceiptAck) Descriptor() ([]byte, []int) {
+	return file_proto_orderer_orderer_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *BlockReceiptAck) GetStatus() common.Status {
+	if x != nil {
+		return x.Status
+	}
+	return common.Status(0)
+}
+
+// DeliverRequest - start_block부터 저장된 블록을 보낸 뒤 새로 커밋되는 블록을 계속 전달
+type DeliverRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChannelId     string                 `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	StartBlock    uint64                 `protobuf:"varint,2,opt,name=start_block,json=startBlock,proto3" json:"start_block,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeliverRequest) Reset() {
+	*x = DeliverRequest{}
+	mi := &file_proto_orderer_orderer_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeliverRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeliverRequest) ProtoMessage() {}
+
+func (x *DeliverRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_orderer_orderer_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeliverRequest.ProtoReflect.Descriptor instead.
+func (*DeliverRequest) Descriptor() ([]byte, []int) {
+	return file_proto_orderer_orderer_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *DeliverRequest) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *DeliverRequest) GetStartBlock() uint64 {
+	if x != nil {
+		return x.StartBlock
+	}
+	return 0
+}
+
+// TransactionStreamRequest - 이후 커밋되는 트랜잭션을 실시간으로 받음
+// channel_id, chaincode_id가 비어 있으면 해당 조건으로 거르지 않음
+type TransactionStreamRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChannelId     string                 `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	ChaincodeId   string                 `protobuf:"bytes,2,opt,name=chaincode_id,json=chaincodeId,proto3" json:"chaincode_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransactionStreamRequest) Reset() {
+	*x = TransactionStreamRequest{}
+	mi := &file_proto_orderer_orderer_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransactionStreamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactionStreamRequest) ProtoMessage() {}
+
+func (x *TransactionStreamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_orderer_orderer_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactionStreamRequest.ProtoReflect.Descriptor instead.
+func (*TransactionStreamRequest) Descriptor() ([]byte, []int) {
+	return file_proto_orderer_orderer_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *TransactionStreamRequest) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *TransactionStreamRequest) GetChaincodeId() string {
+	if x != nil {
+		return x.ChaincodeId
+	}
+	return ""
+}
+
+type TransactionEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        common.Status          `protobuf:"varint,1,opt,name=status,proto3,enum=common.Status" json:"status,omitempty"`
+	TxId          string                 `protobuf:"bytes,2,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+	ChannelId     string                 `protobuf:"bytes,3,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Payload       []byte                 `protobuf:"bytes,4,opt,name=payload,proto3" json:"payload,omitempty"`
+	BlockNumber   uint64                 `protobuf:"varint,5,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	Timestamp     int64                  `protobuf:"varint,6,opt,name=timestamp,proto3" json:"timestamp,omitempty"` // 트랜잭션 생성 시간 (Unix 초)
+	ChaincodeId   string                 `protobuf:"bytes,7,opt,name=chaincode_id,json=chaincodeId,proto3" json:"chaincode_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransactionEvent) Reset() {
+	*x = TransactionEvent{}
+	mi := &file_proto_orderer_orderer_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransactionEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactionEvent) ProtoMessage() {}
+
+func (x *TransactionEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_orderer_orderer_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactionEvent.ProtoReflect.Descriptor instead.
+func (*TransactionEvent) Descriptor() ([]byte, []int) {
+	return file_proto_orderer_orderer_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *TransactionEvent) GetStatus() common.Status {
+	if x != nil {
+		return x.Status
+	}
+	return common.Status(0)
+}
+
+func (x *TransactionEvent) GetTxId() string {
+	if x != nil {
+		return x.TxId
+	}
+	return ""
+}
+
+func (x *TransactionEvent) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *TransactionEvent) GetPayload() []byte {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *TransactionEvent) GetBlockNumber() uint64 {
+	if x != nil {
+		return x.BlockNumber
+	}
+	return 0
+}
+
+func (x *TransactionEvent) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *TransactionEvent) GetChaincodeId() string {
+	if x != nil {
+		return x.ChaincodeId
+	}
+	return ""
+}
+
+// EchoRequest - 연결 확인용 요청 (payload를 그대로 돌려받음)
+type EchoRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Payload       []byte                 `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EchoRequest) Reset() {
+	*x = EchoRequest{}
+	mi := &file_proto_orderer_orderer_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EchoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EchoRequest) ProtoMessage() {}
+
+func (x *EchoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_orderer_orderer_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EchoRequest.ProtoReflect.Descriptor instead.
+func (*EchoRequest) Descriptor() ([]byte, []int) {
+	return file_proto_orderer_orderer_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *EchoRequest) GetPayload() []byte {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+type EchoResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        common.Status          `protobuf:"varint,1,opt,name=status,proto3,enum=common.Status" json:"status,omitempty"`
+	Payload       []byte                 `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EchoResponse) Reset() {
+	*x = EchoResponse{}
+	mi := &file_proto_orderer_orderer_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EchoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EchoResponse) ProtoMessage() {}
+
+func (x *EchoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_orderer_orderer_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EchoResponse.ProtoReflect.Descriptor instead.
+func (*EchoResponse) Descriptor() ([]byte, []int) {
+	return file_proto_orderer_orderer_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *EchoResponse) GetStatus() common.Status {
+	if x != nil {
+		return x.Status
+	}
+	return common.Status(0)
+}
+
+func (x *EchoResponse) GetPayload() []byte {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
 var File_proto_orderer_orderer_proto protoreflect.FileDescriptor
 
 const file_proto_orderer_orderer_proto_rawDesc = "" +
@@ -81,9 +1107,91 @@ const file_proto_orderer_orderer_proto_rawDesc = "" +
 	"\x1bproto/orderer/orderer.proto\x12\aorderer\x1a\x19proto/common/common.proto\"`\n" +
 	"\x11BroadcastResponse\x12&\n" +
 	"\x06status\x18\x01 \x01(\x0e2\x0e.common.StatusR\x06status\x12#\n" +
-	"\x05block\x18\x02 \x01(\v2\r.common.BlockR\x05block2U\n" +
+	"\x05block\x18\x02 \x01(\v2\r.common.BlockR\x05block\"C\n" +
+	"\x13ListChannelsRequest\x12\x16\n" +
+	"\x06offset\x18\x01 \x01(\x04R\x06offset\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\rR\x05limit\"u\n" +
+	"\x14ListChannelsResponse\x12&\n" +
+	"\x06status\x18\x01 \x01(\x0e2\x0e.common.StatusR\x06status\x12\x1a\n" +
+	"\bchannels\x18\x02 \x03(\tR\bchannels\x12\x19\n" +
+	"\bhas_more\x18\x03 \x01(\bR\ahasMore\"P\n" +
+	"\fBlockRequest\x12\x1d\n" +
+	"\n" +
+	"channel_id\x18\x01 \x01(\tR\tchannelId\x12!\n" +
+	"\fblock_number\x18\x02 \x01(\x04R\vblockNumber\"\\\n" +
+	"\rBlockResponse\x12&\n" +
+	"\x06status\x18\x01 \x01(\x0e2\x0e.common.StatusR\x06status\x12#\n" +
+	"\x05block\x18\x02 \x01(\v2\r.common.BlockR\x05block\"p\n" +
+	"\x11BlockRangeRequest\x12\x1d\n" +
+	"\n" +
+	"channel_id\x18\x01 \x01(\tR\tchannelId\x12\x1f\n" +
+	"\vstart_block\x18\x02 \x01(\x04R\n" +
+	"startBlock\x12\x1b\n" +
+	"\tend_block\x18\x03 \x01(\x04R\bendBlock\"c\n" +
+	"\x12BlockRangeResponse\x12&\n" +
+	"\x06status\x18\x01 \x01(\x0e2\x0e.common.StatusR\x06status\x12%\n" +
+	"\x06blocks\x18\x02 \x03(\v2\r.common.BlockR\x06blocks\"5\n" +
+	"\x14ChannelHeightRequest\x12\x1d\n" +
+	"\n" +
+	"channel_id\x18\x01 \x01(\tR\tchannelId\"W\n" +
+	"\x15ChannelHeightResponse\x12&\n" +
+	"\x06status\x18\x01 \x01(\x0e2\x0e.common.StatusR\x06status\x12\x16\n" +
+	"\x06height\x18\x02 \x01(\x04R\x06height\"5\n" +
+	"\x14ChannelConfigRequest\x12\x1d\n" +
+	"\n" +
+	"channel_id\x18\x01 \x01(\tR\tchannelId\"W\n" +
+	"\x15ChannelConfigResponse\x12&\n" +
+	"\x06status\x18\x01 \x01(\x0e2\x0e.common.StatusR\x06status\x12\x16\n" +
+	"\x06config\x18\x02 \x01(\fR\x06config\"\x16\n" +
+	"\x14SystemChannelRequest\"W\n" +
+	"\x15SystemChannelResponse\x12&\n" +
+	"\x06status\x18\x01 \x01(\x0e2\x0e.common.StatusR\x06status\x12\x16\n" +
+	"\x06config\x18\x02 \x01(\fR\x06config\"\xc1\x01\n" +
+	"\x18BlockReceiptNotification\x12\x1d\n" +
+	"\n" +
+	"channel_id\x18\x01 \x01(\tR\tchannelId\x12!\n" +
+	"\fblock_number\x18\x02 \x01(\x04R\vblockNumber\x12\x17\n" +
+	"\apeer_id\x18\x03 \x01(\tR\x06peerId\x12\x1c\n" +
+	"\tsignature\x18\x04 \x01(\fR\tsignature\x12,\n" +
+	"\bidentity\x18\x05 \x01(\v2\x10.common.IdentityR\bidentity\"9\n" +
+	"\x0fBlockReceiptAck\x12&\n" +
+	"\x06status\x18\x01 \x01(\x0e2\x0e.common.StatusR\x06status\"P\n" +
+	"\x0eDeliverRequest\x12\x1d\n" +
+	"\n" +
+	"channel_id\x18\x01 \x01(\tR\tchannelId\x12\x1f\n" +
+	"\vstart_block\x18\x02 \x01(\x04R\n" +
+	"startBlock\"\\\n" +
+	"\x18TransactionStreamRequest\x12\x1d\n" +
+	"\n" +
+	"channel_id\x18\x01 \x01(\tR\tchannelId\x12!\n" +
+	"\fchaincode_id\x18\x02 \x01(\tR\vchaincodeId\"\xec\x01\n" +
+	"\x10TransactionEvent\x12&\n" +
+	"\x06status\x18\x01 \x01(\x0e2\x0e.common.StatusR\x06status\x12\x13\n" +
+	"\x05tx_id\x18\x02 \x01(\tR\x04txId\x12\x1d\n" +
+	"\n" +
+	"channel_id\x18\x03 \x01(\tR\tchannelId\x12\x18\n" +
+	"\apayload\x18\x04 \x01(\fR\apayload\x12!\n" +
+	"\fblock_number\x18\x05 \x01(\x04R\vblockNumber\x12\x1c\n" +
+	"\ttimestamp\x18\x06 \x01(\x03R\ttimestamp\x12!\n" +
+	"\fchaincode_id\x18\a \x01(\tR\vchaincodeId\"'\n" +
+	"\vEchoRequest\x12\x18\n" +
+	"\apayload\x18\x01 \x01(\fR\apayload\"P\n" +
+	"\fEchoResponse\x12&\n" +
+	"\x06status\x18\x01 \x01(\x0e2\x0e.common.StatusR\x06status\x12\x18\n" +
+	"\apayload\x18\x02 \x01(\fR\apayload2\x9d\a\n" +
 	"\x0eOrdererService\x12C\n" +
-	"\rCreateChannel\x12\x10.common.Envelope\x1a\x1a.orderer.BroadcastResponse\"\x00(\x010\x01B*Z(github.com/ddr4869/minifab/proto/ordererb\x06proto3"
+	"\rCreateChannel\x12\x10.common.Envelope\x1a\x1a.orderer.BroadcastResponse\"\x00(\x010\x01\x12C\n" +
+	"\x11SubmitTransaction\x12\x10.common.Envelope\x1a\x1a.orderer.BroadcastResponse\"\x00\x12L\n" +
+	"\vGetChannels\x12\x1c.orderer.ListChannelsRequest\x1a\x1d.orderer.ListChannelsResponse\"\x00\x12;\n" +
+	"\bGetBlock\x12\x15.orderer.BlockRequest\x1a\x16.orderer.BlockResponse\"\x00\x12F\n" +
+	"\tGetBlocks\x12\x1a.orderer.BlockRangeRequest\x1a\x1b.orderer.BlockRangeResponse\"\x00\x12S\n" +
+	"\x10GetChannelHeight\x12\x1d.orderer.ChannelHeightRequest\x1a\x1e.orderer.ChannelHeightResponse\"\x00\x12S\n" +
+	"\x10GetChannelConfig\x12\x1d.orderer.ChannelConfigRequest\x1a\x1e.orderer.ChannelConfigResponse\"\x00\x12Y\n" +
+	"\x16GetSystemChannelConfig\x12\x1d.orderer.SystemChannelRequest\x1a\x1e.orderer.SystemChannelResponse\"\x00\x12T\n" +
+	"\x13NotifyBlockReceived\x12!.orderer.BlockReceiptNotification\x1a\x18.orderer.BlockReceiptAck\"\x00\x12D\n" +
+	"\rDeliverBlocks\x12\x17.orderer.DeliverRequest\x1a\x16.orderer.BlockResponse\"\x000\x01\x12V\n" +
+	"\x12StreamTransactions\x12!.orderer.TransactionStreamRequest\x1a\x19.orderer.TransactionEvent\"\x000\x01\x125\n" +
+	"\x04Echo\x12\x14.orderer.EchoRequest\x1a\x15.orderer.EchoResponse\"\x00B*Z(github.com/ddr4869/minifab/proto/ordererb\x06proto3"
 
 var (
 	file_proto_orderer_orderer_proto_rawDescOnce sync.Once
@@ -97,23 +1205,77 @@ func file_proto_orderer_orderer_proto_rawDescGZIP() []byte {
 	return file_proto_orderer_orderer_proto_rawDescData
 }
 
-var file_proto_orderer_orderer_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_proto_orderer_orderer_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
 var file_proto_orderer_orderer_proto_goTypes = []any{
-	(*BroadcastResponse)(nil), // 0: orderer.BroadcastResponse
-	(common.Status)(0),        // 1: common.Status
-	(*common.Block)(nil),      // 2: common.Block
-	(*common.Envelope)(nil),   // 3: common.Envelope
+	(*BroadcastResponse)(nil),        // 0: orderer.BroadcastResponse
+	(*ListChannelsRequest)(nil),      // 1: orderer.ListChannelsRequest
+	(*ListChannelsResponse)(nil),     // 2: orderer.ListChannelsResponse
+	(*BlockRequest)(nil),             // 3: orderer.BlockRequest
+	(*BlockResponse)(nil),            // 4: orderer.BlockResponse
+	(*BlockRangeRequest)(nil),        // 5: orderer.BlockRangeRequest
+	(*BlockRangeResponse)(nil),       // 6: orderer.BlockRangeResponse
+	(*ChannelHeightRequest)(nil),     // 7: orderer.ChannelHeightRequest
+	(*ChannelHeightResponse)(nil),    // 8: orderer.ChannelHeightResponse
+	(*ChannelConfigRequest)(nil),     // 9: orderer.ChannelConfigRequest
+	(*ChannelConfigResponse)(nil),    // 10: orderer.ChannelConfigResponse
+	(*SystemChannelRequest)(nil),     // 11: orderer.SystemChannelRequest
+	(*SystemChannelResponse)(nil),    // 12: orderer.SystemChannelResponse
+	(*BlockReceiptNotification)(nil), // 13: orderer.BlockReceiptNotification
+	(*BlockReceiptAck)(nil),          // 14: orderer.BlockReceiptAck
+	(*DeliverRequest)(nil),           // 15: orderer.DeliverRequest
+	(*TransactionStreamRequest)(nil), // 16: orderer.TransactionStreamRequest
+	(*TransactionEvent)(nil),         // 17: orderer.TransactionEvent
+	(*EchoRequest)(nil),              // 18: orderer.EchoRequest
+	(*EchoResponse)(nil),             // 19: orderer.EchoResponse
+	(common.Status)(0),               // 20: common.Status
+	(*common.Block)(nil),             // 21: common.Block
+	(*common.Identity)(nil),          // 22: common.Identity
+	(*common.Envelope)(nil),          // 23: common.Envelope
 }
 var file_proto_orderer_orderer_proto_depIdxs = []int32{
-	1, // 0: orderer.BroadcastResponse.status:type_name -> common.Status
-	2, // 1: orderer.BroadcastResponse.block:type_name -> common.Block
-	3, // 2: orderer.OrdererService.CreateChannel:input_type -> common.Envelope
-	0, // 3: orderer.OrdererService.CreateChannel:output_type -> orderer.BroadcastResponse
-	3, // [3:4] is the sub-list for method output_type
-	2, // [2:3] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	20, // 0: orderer.BroadcastResponse.status:type_name -> common.Status
+	21, // 1: orderer.BroadcastResponse.block:type_name -> common.Block
+	20, // 2: orderer.ListChannelsResponse.status:type_name -> common.Status
+	20, // 3: orderer.BlockResponse.status:type_name -> common.Status
+	21, // 4: orderer.BlockResponse.block:type_name -> common.Block
+	20, // 5: orderer.BlockRangeResponse.status:type_name -> common.Status
+	21, // 6: orderer.BlockRangeResponse.blocks:type_name -> common.Block
+	20, // 7: orderer.ChannelHeightResponse.status:type_name -> common.Status
+	20, // 8: orderer.ChannelConfigResponse.status:type_name -> common.Status
+	20, // 9: orderer.SystemChannelResponse.status:type_name -> common.Status
+	22, // 10: orderer.BlockReceiptNotification.identity:type_name -> common.Identity
+	20, // 11: orderer.BlockReceiptAck.status:type_name -> common.Status
+	20, // 12: orderer.TransactionEvent.status:type_name -> common.Status
+	20, // 13: orderer.EchoResponse.status:type_name -> common.Status
+	23, // 14: orderer.OrdererService.CreateChannel:input_type -> common.Envelope
+	23, // 15: orderer.OrdererService.SubmitTransaction:input_type -> common.Envelope
+	1,  // 16: orderer.OrdererService.GetChannels:input_type -> orderer.ListChannelsRequest
+	3,  // 17: orderer.OrdererService.GetBlock:input_type -> orderer.BlockRequest
+	5,  // 18: orderer.OrdererService.GetBlocks:input_type -> orderer.BlockRangeRequest
+	7,  // 19: orderer.OrdererService.GetChannelHeight:input_type -> orderer.ChannelHeightRequest
+	9,  // 20: orderer.OrdererService.GetChannelConfig:input_type -> orderer.ChannelConfigRequest
+	11, // 21: orderer.OrdererService.GetSystemChannelConfig:input_type -> orderer.SystemChannelRequest
+	13, // 22: orderer.OrdererService.NotifyBlockReceived:input_type -> orderer.BlockReceiptNotification
+	15, // 23: orderer.OrdererService.DeliverBlocks:input_type -> orderer.DeliverRequest
+	16, // 24: orderer.OrdererService.StreamTransactions:input_type -> orderer.TransactionStreamRequest
+	18, // 25: orderer.OrdererService.Echo:input_type -> orderer.EchoRequest
+	0,  // 26: orderer.OrdererService.CreateChannel:output_type -> orderer.BroadcastResponse
+	0,  // 27: orderer.OrdererService.SubmitTransaction:output_type -> orderer.BroadcastResponse
+	2,  // 28: orderer.OrdererService.GetChannels:output_type -> orderer.ListChannelsResponse
+	4,  // 29: orderer.OrdererService.GetBlock:output_type -> orderer.BlockResponse
+	6,  // 30: orderer.OrdererService.GetBlocks:output_type -> orderer.BlockRangeResponse
+	8,  // 31: orderer.OrdererService.GetChannelHeight:output_type -> orderer.ChannelHeightResponse
+	10, // 32: orderer.OrdererService.GetChannelConfig:output_type -> orderer.ChannelConfigResponse
+	12, // 33: orderer.OrdererService.GetSystemChannelConfig:output_type -> orderer.SystemChannelResponse
+	14, // 34: orderer.OrdererService.NotifyBlockReceived:output_type -> orderer.BlockReceiptAck
+	4,  // 35: orderer.OrdererService.DeliverBlocks:output_type -> orderer.BlockResponse
+	17, // 36: orderer.OrdererService.StreamTransactions:output_type -> orderer.TransactionEvent
+	19, // 37: orderer.OrdererService.Echo:output_type -> orderer.EchoResponse
+	26, // [26:38] is the sub-list for method output_type
+	14, // [14:26] is the sub-list for method input_type
+	14, // [14:14] is the sub-list for extension type_name
+	14, // [14:14] is the sub-list for extension extendee
+	0,  // [0:14] is the sub-list for field type_name
 }
 
 func init() { file_proto_orderer_orderer_proto_init() }
@@ -127,7 +1289,7 @@ func file_proto_orderer_orderer_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_orderer_orderer_proto_rawDesc), len(file_proto_orderer_orderer_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   1,
+			NumMessages:   20,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -20,7 +20,18 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	OrdererService_CreateChannel_FullMethodName = "/orderer.OrdererService/CreateChannel"
+	OrdererService_CreateChannel_FullMethodName          = "/orderer.OrdererService/CreateChannel"
+	OrdererService_SubmitTransaction_FullMethodName      = "/orderer.OrdererService/SubmitTransaction"
+	OrdererService_GetChannels_FullMethodName            = "/orderer.OrdererService/GetChannels"
+	OrdererService_GetBlock_FullMethodName               = "/orderer.OrdererService/GetBlock"
+	OrdererService_GetBlocks_FullMethodName              = "/orderer.OrdererService/GetBlocks"
+	OrdererService_GetChannelHeight_FullMethodName       = "/orderer.OrdererService/GetChannelHeight"
+	OrdererService_GetChannelConfig_FullMethodName       = "/orderer.OrdererService/GetChannelConfig"
+	OrdererService_GetSystemChannelConfig_FullMethodName = "/orderer.OrdererService/GetSystemChannelConfig"
+	OrdererService_NotifyBlockReceived_FullMethodName    = "/orderer.OrdererService/NotifyBlockReceived"
+	OrdererService_DeliverBlocks_FullMethodName          = "/orderer.OrdererService/DeliverBlocks"
+	OrdererService_StreamTransactions_FullMethodName     = "/orderer.OrdererService/StreamTransactions"
+	OrdererService_Echo_FullMethodName                   = "/orderer.OrdererService/Echo"
 )
 
 // OrdererServiceClient is the client API for OrdererService service.
@@ -28,6 +39,17 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type OrdererServiceClient interface {
 	CreateChannel(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[common.Envelope, BroadcastResponse], error)
+	SubmitTransaction(ctx context.Context, in *common.Envelope, opts ...grpc.CallOption) (*BroadcastResponse, error)
+	GetChannels(ctx context.Context, in *ListChannelsRequest, opts ...grpc.CallOption) (*ListChannelsResponse, error)
+	GetBlock(ctx context.Context, in *BlockRequest, opts ...grpc.CallOption) (*BlockResponse, error)
+	GetBlocks(ctx context.Context, in *BlockRangeRequest, opts ...grpc.CallOption) (*BlockRangeResponse, error)
+	GetChannelHeight(ctx context.Context, in *ChannelHeightRequest, opts ...grpc.CallOption) (*ChannelHeightResponse, error)
+	GetChannelConfig(ctx context.Context, in *ChannelConfigRequest, opts ...grpc.CallOption) (*ChannelConfigResponse, error)
+	GetSystemChannelConfig(ctx context.Context, in *SystemChannelRequest, opts ...grpc.CallOption) (*SystemChannelResponse, error)
+	NotifyBlockReceived(ctx context.Context, in *BlockReceiptNotification, opts ...grpc.CallOption) (*BlockReceiptAck, error)
+	DeliverBlocks(ctx context.Context, in *DeliverRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[BlockResponse], error)
+	StreamTransactions(ctx context.Context, in *TransactionStreamRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[TransactionEvent], error)
+	Echo(ctx context.Context, in *EchoRequest, opts ...grpc.CallOption) (*EchoResponse, error)
 }
 
 type ordererServiceClient struct {
@@ -51,11 +73,150 @@ func (c *ordererServiceClient) CreateChannel(ctx context.Context, opts ...grpc.C
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type OrdererService_CreateChannelClient = grpc.BidiStreamingClient[common.Envelope, BroadcastResponse]
 
+func (c *ordererServiceClient) SubmitTransaction(ctx context.Context, in *common.Envelope, opts ...grpc.CallOption) (*BroadcastResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BroadcastResponse)
+	err := c.cc.Invoke(ctx, OrdererService_SubmitTransaction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ordererServiceClient) GetChannels(ctx context.Context, in *ListChannelsRequest, opts ...grpc.CallOption) (*ListChannelsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListChannelsResponse)
+	err := c.cc.Invoke(ctx, OrdererService_GetChannels_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ordererServiceClient) GetBlock(ctx context.Context, in *BlockRequest, opts ...grpc.CallOption) (*BlockResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BlockResponse)
+	err := c.cc.Invoke(ctx, OrdererService_GetBlock_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ordererServiceClient) GetBlocks(ctx context.Context, in *BlockRangeRequest, opts ...grpc.CallOption) (*BlockRangeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BlockRangeResponse)
+	err := c.cc.Invoke(ctx, OrdererService_GetBlocks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ordererServiceClient) GetChannelHeight(ctx context.Context, in *ChannelHeightRequest, opts ...grpc.CallOption) (*ChannelHeightResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChannelHeightResponse)
+	err := c.cc.Invoke(ctx, OrdererService_GetChannelHeight_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ordererServiceClient) GetChannelConfig(ctx context.Context, in *ChannelConfigRequest, opts ...grpc.CallOption) (*ChannelConfigResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChannelConfigResponse)
+	err := c.cc.Invoke(ctx, OrdererService_GetChannelConfig_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ordererServiceClient) GetSystemChannelConfig(ctx context.Context, in *SystemChannelRequest, opts ...grpc.CallOption) (*SystemChannelResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SystemChannelResponse)
+	err := c.cc.Invoke(ctx, OrdererService_GetSystemChannelConfig_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ordererServiceClient) NotifyBlockReceived(ctx context.Context, in *BlockReceiptNotification, opts ...grpc.CallOption) (*BlockReceiptAck, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BlockReceiptAck)
+	err := c.cc.Invoke(ctx, OrdererService_NotifyBlockReceived_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ordererServiceClient) DeliverBlocks(ctx context.Context, in *DeliverRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[BlockResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &OrdererService_ServiceDesc.Streams[1], OrdererService_DeliverBlocks_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[DeliverRequest, BlockResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type OrdererService_DeliverBlocksClient = grpc.ServerStreamingClient[BlockResponse]
+
+func (c *ordererServiceClient) StreamTransactions(ctx context.Context, in *TransactionStreamRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[TransactionEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &OrdererService_ServiceDesc.Streams[2], OrdererService_StreamTransactions_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[TransactionStreamRequest, TransactionEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type OrdererService_StreamTransactionsClient = grpc.ServerStreamingClient[TransactionEvent]
+
+func (c *ordererServiceClient) Echo(ctx context.Context, in *EchoRequest, opts ...grpc.CallOption) (*EchoResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EchoResponse)
+	err := c.cc.Invoke(ctx, OrdererService_Echo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // OrdererServiceServer is the server API for OrdererService service.
 // All implementations must embed UnimplementedOrdererServiceServer
 // for forward compatibility.
 type OrdererServiceServer interface {
 	CreateChannel(grpc.BidiStreamingServer[common.Envelope, BroadcastResponse]) error
+	SubmitTransaction(context.Context, *common.Envelope) (*BroadcastResponse, error)
+	GetChannels(context.Context, *ListChannelsRequest) (*ListChannelsResponse, error)
+	GetBlock(context.Context, *BlockRequest) (*BlockResponse, error)
+	GetBlocks(context.Context, *BlockRangeRequest) (*BlockRangeResponse, error)
+	GetChannelHeight(context.Context, *ChannelHeightRequest) (*ChannelHeightResponse, error)
+	GetChannelConfig(context.Context, *ChannelConfigRequest) (*ChannelConfigResponse, error)
+	GetSystemChannelConfig(context.Context, *SystemChannelRequest) (*SystemChannelResponse, error)
+	NotifyBlockReceived(context.Context, *BlockReceiptNotification) (*BlockReceiptAck, error)
+	DeliverBlocks(*DeliverRequest, grpc.ServerStreamingServer[BlockResponse]) error
+	StreamTransactions(*TransactionStreamRequest, grpc.ServerStreamingServer[TransactionEvent]) error
+	Echo(context.Context, *EchoRequest) (*EchoResponse, error)
 	mustEmbedUnimplementedOrdererServiceServer()
 }
 
@@ -69,6 +230,39 @@ type UnimplementedOrdererServiceServer struct{}
 func (UnimplementedOrdererServiceServer) CreateChannel(grpc.BidiStreamingServer[common.Envelope, BroadcastResponse]) error {
 	return status.Errorf(codes.Unimplemented, "method CreateChannel not implemented")
 }
+func (UnimplementedOrdererServiceServer) SubmitTransaction(context.Context, *common.Envelope) (*BroadcastResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitTransaction not implemented")
+}
+func (UnimplementedOrdererServiceServer) GetChannels(context.Context, *ListChannelsRequest) (*ListChannelsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChannels not implemented")
+}
+func (UnimplementedOrdererServiceServer) GetBlock(context.Context, *BlockRequest) (*BlockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBlock not implemented")
+}
+func (UnimplementedOrdererServiceServer) GetBlocks(context.Context, *BlockRangeRequest) (*BlockRangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBlocks not implemented")
+}
+func (UnimplementedOrdererServiceServer) GetChannelHeight(context.Context, *ChannelHeightRequest) (*ChannelHeightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChannelHeight not implemented")
+}
+func (UnimplementedOrdererServiceServer) GetChannelConfig(context.Context, *ChannelConfigRequest) (*ChannelConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChannelConfig not implemented")
+}
+func (UnimplementedOrdererServiceServer) GetSystemChannelConfig(context.Context, *SystemChannelRequest) (*SystemChannelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSystemChannelConfig not implemented")
+}
+func (UnimplementedOrdererServiceServer) NotifyBlockReceived(context.Context, *BlockReceiptNotification) (*BlockReceiptAck, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NotifyBlockReceived not implemented")
+}
+func (UnimplementedOrdererServiceServer) DeliverBlocks(*DeliverRequest, grpc.ServerStreamingServer[BlockResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method DeliverBlocks not implemented")
+}
+func (UnimplementedOrdererServiceServer) StreamTransactions(*TransactionStreamRequest, grpc.ServerStreamingServer[TransactionEvent]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamTransactions not implemented")
+}
+func (UnimplementedOrdererServiceServer) Echo(context.Context, *EchoRequest) (*EchoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Echo not implemented")
+}
 func (UnimplementedOrdererServiceServer) mustEmbedUnimplementedOrdererServiceServer() {}
 func (UnimplementedOrdererServiceServer) testEmbeddedByValue()                        {}
 
@@ -97,13 +291,234 @@ func _OrdererService_CreateChannel_Handler(srv interface{}, stream grpc.ServerSt
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type OrdererService_CreateChannelServer = grpc.BidiStreamingServer[common.Envelope, BroadcastResponse]
 
+func _OrdererService_SubmitTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(common.Envelope)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrdererServiceServer).SubmitTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrdererService_SubmitTransaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrdererServiceServer).SubmitTransaction(ctx, req.(*common.Envelope))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrdererService_GetChannels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListChannelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrdererServiceServer).GetChannels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrdererService_GetChannels_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrdererServiceServer).GetChannels(ctx, req.(*ListChannelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrdererService_GetBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrdererServiceServer).GetBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrdererService_GetBlock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrdererServiceServer).GetBlock(ctx, req.(*BlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrdererService_GetBlocks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockRangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrdererServiceServer).GetBlocks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrdererService_GetBlocks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrdererServiceServer).GetBlocks(ctx, req.(*BlockRangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrdererService_GetChannelHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChannelHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrdererServiceServer).GetChannelHeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrdererService_GetChannelHeight_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrdererServiceServer).GetChannelHeight(ctx, req.(*ChannelHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrdererService_GetChannelConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChannelConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrdererServiceServer).GetChannelConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrdererService_GetChannelConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrdererServiceServer).GetChannelConfig(ctx, req.(*ChannelConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrdererService_GetSystemChannelConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SystemChannelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrdererServiceServer).GetSystemChannelConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrdererService_GetSystemChannelConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrdererServiceServer).GetSystemChannelConfig(ctx, req.(*SystemChannelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrdererService_NotifyBlockReceived_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockReceiptNotification)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrdererServiceServer).NotifyBlockReceived(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrdererService_NotifyBlockReceived_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrdererServiceServer).NotifyBlockReceived(ctx, req.(*BlockReceiptNotification))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrdererService_DeliverBlocks_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DeliverRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(OrdererServiceServer).DeliverBlocks(m, &grpc.GenericServerStream[DeliverRequest, BlockResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type OrdererService_DeliverBlocksServer = grpc.ServerStreamingServer[BlockResponse]
+
+func _OrdererService_StreamTransactions_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(TransactionStreamRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(OrdererServiceServer).StreamTransactions(m, &grpc.GenericServerStream[TransactionStreamRequest, TransactionEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type OrdererService_StreamTransactionsServer = grpc.ServerStreamingServer[TransactionEvent]
+
+func _OrdererService_Echo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EchoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrdererServiceServer).Echo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrdererService_Echo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrdererServiceServer).Echo(ctx, req.(*EchoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // OrdererService_ServiceDesc is the grpc.ServiceDesc for OrdererService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
 var OrdererService_ServiceDesc = grpc.ServiceDesc{
 	ServiceName: "orderer.OrdererService",
 	HandlerType: (*OrdererServiceServer)(nil),
-	Methods:     []grpc.MethodDesc{},
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SubmitTransaction",
+			Handler:    _OrdererService_SubmitTransaction_Handler,
+		},
+		{
+			MethodName: "GetChannels",
+			Handler:    _OrdererService_GetChannels_Handler,
+		},
+		{
+			MethodName: "GetBlock",
+			Handler:    _OrdererService_GetBlock_Handler,
+		},
+		{
+			MethodName: "GetBlocks",
+			Handler:    _OrdererService_GetBlocks_Handler,
+		},
+		{
+			MethodName: "GetChannelHeight",
+			Handler:    _OrdererService_GetChannelHeight_Handler,
+		},
+		{
+			MethodName: "GetChannelConfig",
+			Handler:    _OrdererService_GetChannelConfig_Handler,
+		},
+		{
+			MethodName: "GetSystemChannelConfig",
+			Handler:    _OrdererService_GetSystemChannelConfig_Handler,
+		},
+		{
+			MethodName: "NotifyBlockReceived",
+			Handler:    _OrdererService_NotifyBlockReceived_Handler,
+		},
+		{
+			MethodName: "Echo",
+			Handler:    _OrdererService_Echo_Handler,
+		},
+	},
 	Streams: []grpc.StreamDesc{
 		{
 			StreamName:    "CreateChannel",
@@ -111,6 +526,16 @@ var OrdererService_ServiceDesc = grpc.ServiceDesc{
 			ServerStreams: true,
 			ClientStreams: true,
 		},
+		{
+			StreamName:    "DeliverBlocks",
+			Handler:       _OrdererService_DeliverBlocks_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamTransactions",
+			Handler:       _OrdererService_StreamTransactions_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "proto/orderer/orderer.proto",
 }
@@ -237,6 +237,181 @@ func (x *JoinChannelResponse) GetCurrentHeight() uint64 {
 	return 0
 }
 
+// SyncStatusRequest - 동기화 상태 조회 요청 (channel_ids가 비어있으면 전체 채널)
+type SyncStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChannelIds    []string               `protobuf:"bytes,1,rep,name=channel_ids,json=channelIds,proto3" json:"channel_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SyncStatusRequest) Reset() {
+	*x = SyncStatusRequest{}
+	mi := &file_proto_peer_peer_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncStatusRequest) ProtoMessage() {}
+
+func (x *SyncStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_peer_peer_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncStatusRequest.ProtoReflect.Descriptor instead.
+func (*SyncStatusRequest) Descriptor() ([]byte, []int) {
+	return file_proto_peer_peer_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SyncStatusRequest) GetChannelIds() []string {
+	if x != nil {
+		return x.ChannelIds
+	}
+	return nil
+}
+
+// ChannelSyncStatus - 채널별 동기화 상태
+type ChannelSyncStatus struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChannelId     string                 `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	LocalHeight   uint64                 `protobuf:"varint,2,opt,name=local_height,json=localHeight,proto3" json:"local_height,omitempty"`
+	RemoteHeight  uint64                 `protobuf:"varint,3,opt,name=remote_height,json=remoteHeight,proto3" json:"remote_height,omitempty"`
+	Lag           uint64                 `protobuf:"varint,4,opt,name=lag,proto3" json:"lag,omitempty"`
+	IsSyncing     bool                   `protobuf:"varint,5,opt,name=is_syncing,json=isSyncing,proto3" json:"is_syncing,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChannelSyncStatus) Reset() {
+	*x = ChannelSyncStatus{}
+	mi := &file_proto_peer_peer_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChannelSyncStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelSyncStatus) ProtoMessage() {}
+
+func (x *ChannelSyncStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_peer_peer_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelSyncStatus.ProtoReflect.Descriptor instead.
+func (*ChannelSyncStatus) Descriptor() ([]byte, []int) {
+	return file_proto_peer_peer_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ChannelSyncStatus) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *ChannelSyncStatus) GetLocalHeight() uint64 {
+	if x != nil {
+		return x.LocalHeight
+	}
+	return 0
+}
+
+func (x *ChannelSyncStatus) GetRemoteHeight() uint64 {
+	if x != nil {
+		return x.RemoteHeight
+	}
+	return 0
+}
+
+func (x *ChannelSyncStatus) GetLag() uint64 {
+	if x != nil {
+		return x.Lag
+	}
+	return 0
+}
+
+func (x *ChannelSyncStatus) GetIsSyncing() bool {
+	if x != nil {
+		return x.IsSyncing
+	}
+	return false
+}
+
+// SyncStatusResponse - 동기화 상태 조회 응답
+type SyncStatusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        common.Status          `protobuf:"varint,1,opt,name=status,proto3,enum=common.Status" json:"status,omitempty"`
+	Channels      []*ChannelSyncStatus   `protobuf:"bytes,2,rep,name=channels,proto3" json:"channels,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SyncStatusResponse) Reset() {
+	*x = SyncStatusResponse{}
+	mi := &file_proto_peer_peer_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncStatusResponse) ProtoMessage() {}
+
+func (x *SyncStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_peer_peer_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncStatusResponse.ProtoReflect.Descriptor instead.
+func (*SyncStatusResponse) Descriptor() ([]byte, []int) {
+	return file_proto_peer_peer_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *SyncStatusResponse) GetStatus() common.Status {
+	if x != nil {
+		return x.Status
+	}
+	return common.Status(0)
+}
+
+func (x *SyncStatusResponse) GetChannels() []*ChannelSyncStatus {
+	if x != nil {
+		return x.Channels
+	}
+	return nil
+}
+
 var File_proto_peer_peer_proto protoreflect.FileDescriptor
 
 const file_proto_peer_peer_proto_rawDesc = "" +
@@ -259,10 +434,25 @@ const file_proto_peer_peer_proto_rawDesc = "" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1d\n" +
 	"\n" +
 	"channel_id\x18\x03 \x01(\tR\tchannelId\x12%\n" +
-	"\x0ecurrent_height\x18\x04 \x01(\x04R\rcurrentHeight2\x93\x01\n" +
+	"\x0ecurrent_height\x18\x04 \x01(\x04R\rcurrentHeight\"4\n" +
+	"\x11SyncStatusRequest\x12\x1f\n" +
+	"\vchannel_ids\x18\x01 \x03(\tR\n" +
+	"channelIds\"\xab\x01\n" +
+	"\x11ChannelSyncStatus\x12\x1d\n" +
+	"\n" +
+	"channel_id\x18\x01 \x01(\tR\tchannelId\x12!\n" +
+	"\flocal_height\x18\x02 \x01(\x04R\vlocalHeight\x12#\n" +
+	"\rremote_height\x18\x03 \x01(\x04R\fremoteHeight\x12\x10\n" +
+	"\x03lag\x18\x04 \x01(\x04R\x03lag\x12\x1d\n" +
+	"\n" +
+	"is_syncing\x18\x05 \x01(\bR\tisSyncing\"q\n" +
+	"\x12SyncStatusResponse\x12&\n" +
+	"\x06status\x18\x01 \x01(\x0e2\x0e.common.StatusR\x06status\x123\n" +
+	"\bchannels\x18\x02 \x03(\v2\x17.peer.ChannelSyncStatusR\bchannels2\xd9\x01\n" +
 	"\vPeerService\x12>\n" +
 	"\fProcessBlock\x12\x10.common.Envelope\x1a\x1a.peer.ProcessBlockResponse\"\x00\x12D\n" +
-	"\vJoinChannel\x12\x18.peer.JoinChannelRequest\x1a\x19.peer.JoinChannelResponse\"\x00B'Z%github.com/ddr4869/minifab/proto/peerb\x06proto3"
+	"\vJoinChannel\x12\x18.peer.JoinChannelRequest\x1a\x19.peer.JoinChannelResponse\"\x00\x12D\n" +
+	"\rGetSyncStatus\x12\x17.peer.SyncStatusRequest\x1a\x18.peer.SyncStatusResponse\"\x00B'Z%github.com/ddr4869/minifab/proto/peerb\x06proto3"
 
 var (
 	file_proto_peer_peer_proto_rawDescOnce sync.Once
@@ -276,28 +466,35 @@ func file_proto_peer_peer_proto_rawDescGZIP() []byte {
 	return file_proto_peer_peer_proto_rawDescData
 }
 
-var file_proto_peer_peer_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_proto_peer_peer_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
 var file_proto_peer_peer_proto_goTypes = []any{
 	(*ProcessBlockResponse)(nil), // 0: peer.ProcessBlockResponse
 	(*JoinChannelRequest)(nil),   // 1: peer.JoinChannelRequest
 	(*JoinChannelResponse)(nil),  // 2: peer.JoinChannelResponse
-	(common.Status)(0),           // 3: common.Status
-	(*common.Block)(nil),         // 4: common.Block
-	(*common.Envelope)(nil),      // 5: common.Envelope
+	(*SyncStatusRequest)(nil),    // 3: peer.SyncStatusRequest
+	(*ChannelSyncStatus)(nil),    // 4: peer.ChannelSyncStatus
+	(*SyncStatusResponse)(nil),   // 5: peer.SyncStatusResponse
+	(common.Status)(0),           // 6: common.Status
+	(*common.Block)(nil),         // 7: common.Block
+	(*common.Envelope)(nil),      // 8: common.Envelope
 }
 var file_proto_peer_peer_proto_depIdxs = []int32{
-	3, // 0: peer.ProcessBlockResponse.status:type_name -> common.Status
-	4, // 1: peer.JoinChannelRequest.genesis_block:type_name -> common.Block
-	3, // 2: peer.JoinChannelResponse.status:type_name -> common.Status
-	5, // 3: peer.PeerService.ProcessBlock:input_type -> common.Envelope
-	1, // 4: peer.PeerService.JoinChannel:input_type -> peer.JoinChannelRequest
-	0, // 5: peer.PeerService.ProcessBlock:output_type -> peer.ProcessBlockResponse
-	2, // 6: peer.PeerService.JoinChannel:output_type -> peer.JoinChannelResponse
-	5, // [5:7] is the sub-list for method output_type
-	3, // [3:5] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	6, // 0: peer.ProcessBlockResponse.status:type_name -> common.Status
+	7, // 1: peer.JoinChannelRequest.genesis_block:type_name -> common.Block
+	6, // 2: peer.JoinChannelResponse.status:type_name -> common.Status
+	6, // 3: peer.SyncStatusResponse.status:type_name -> common.Status
+	4, // 4: peer.SyncStatusResponse.channels:type_name -> peer.ChannelSyncStatus
+	8, // 5: peer.PeerService.ProcessBlock:input_type -> common.Envelope
+	1, // 6: peer.PeerService.JoinChannel:input_type -> peer.JoinChannelRequest
+	3, // 7: peer.PeerService.GetSyncStatus:input_type -> peer.SyncStatusRequest
+	0, // 8: peer.PeerService.ProcessBlock:output_type -> peer.ProcessBlockResponse
+	2, // 9: peer.PeerService.JoinChannel:output_type -> peer.JoinChannelResponse
+	5, // 10: peer.PeerService.GetSyncStatus:output_type -> peer.SyncStatusResponse
+	8, // [8:11] is the sub-list for method output_type
+	5, // [5:8] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_proto_peer_peer_proto_init() }
@@ -311,7 +508,7 @@ func file_proto_peer_peer_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_peer_peer_proto_rawDesc), len(file_proto_peer_peer_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   3,
+			NumMessages:   6,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
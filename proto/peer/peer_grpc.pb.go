@@ -20,8 +20,9 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	PeerService_ProcessBlock_FullMethodName = "/peer.PeerService/ProcessBlock"
-	PeerService_JoinChannel_FullMethodName  = "/peer.PeerService/JoinChannel"
+	PeerService_ProcessBlock_FullMethodName  = "/peer.PeerService/ProcessBlock"
+	PeerService_JoinChannel_FullMethodName   = "/peer.PeerService/JoinChannel"
+	PeerService_GetSyncStatus_FullMethodName = "/peer.PeerService/GetSyncStatus"
 )
 
 // PeerServiceClient is the client API for PeerService service.
@@ -34,6 +35,8 @@ type PeerServiceClient interface {
 	ProcessBlock(ctx context.Context, in *common.Envelope, opts ...grpc.CallOption) (*ProcessBlockResponse, error)
 	// 채널 참여
 	JoinChannel(ctx context.Context, in *JoinChannelRequest, opts ...grpc.CallOption) (*JoinChannelResponse, error)
+	// 블록 동기화 상태 조회
+	GetSyncStatus(ctx context.Context, in *SyncStatusRequest, opts ...grpc.CallOption) (*SyncStatusResponse, error)
 }
 
 type peerServiceClient struct {
@@ -64,6 +67,16 @@ func (c *peerServiceClient) JoinChannel(ctx context.Context, in *JoinChannelRequ
 	return out, nil
 }
 
+func (c *peerServiceClient) GetSyncStatus(ctx context.Context, in *SyncStatusRequest, opts ...grpc.CallOption) (*SyncStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SyncStatusResponse)
+	err := c.cc.Invoke(ctx, PeerService_GetSyncStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PeerServiceServer is the server API for PeerService service.
 // All implementations must embed UnimplementedPeerServiceServer
 // for forward compatibility.
@@ -74,6 +87,8 @@ type PeerServiceServer interface {
 	ProcessBlock(context.Context, *common.Envelope) (*ProcessBlockResponse, error)
 	// 채널 참여
 	JoinChannel(context.Context, *JoinChannelRequest) (*JoinChannelResponse, error)
+	// 블록 동기화 상태 조회
+	GetSyncStatus(context.Context, *SyncStatusRequest) (*SyncStatusResponse, error)
 	mustEmbedUnimplementedPeerServiceServer()
 }
 
@@ -90,6 +105,9 @@ func (UnimplementedPeerServiceServer) ProcessBlock(context.Context, *common.Enve
 func (UnimplementedPeerServiceServer) JoinChannel(context.Context, *JoinChannelRequest) (*JoinChannelResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method JoinChannel not implemented")
 }
+func (UnimplementedPeerServiceServer) GetSyncStatus(context.Context, *SyncStatusRequest) (*SyncStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSyncStatus not implemented")
+}
 func (UnimplementedPeerServiceServer) mustEmbedUnimplementedPeerServiceServer() {}
 func (UnimplementedPeerServiceServer) testEmbeddedByValue()                     {}
 
@@ -147,6 +165,24 @@ func _PeerService_JoinChannel_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PeerService_GetSyncStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SyncStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PeerServiceServer).GetSyncStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PeerService_GetSyncStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PeerServiceServer).GetSyncStatus(ctx, req.(*SyncStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // PeerService_ServiceDesc is the grpc.ServiceDesc for PeerService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -162,6 +198,10 @@ var PeerService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "JoinChannel",
 			Handler:    _PeerService_JoinChannel_Handler,
 		},
+		{
+			MethodName: "GetSyncStatus",
+			Handler:    _PeerService_GetSyncStatus_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/peer/peer.proto",